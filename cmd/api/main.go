@@ -7,13 +7,22 @@ import (
 	"log/slog"
 	"net/http"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
+	// Condominium timezones are resolved at runtime; the alpine image ships no zoneinfo.
+	_ "time/tzdata"
 
 	firebase "firebase.google.com/go/v4"
 	"github.com/Bellorico323/vizen/internal/api"
 	"github.com/Bellorico323/vizen/internal/api/controllers"
 	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/infra/events"
 	"github.com/Bellorico323/vizen/internal/infra/notification"
+	"github.com/Bellorico323/vizen/internal/infra/storage"
+	"github.com/Bellorico323/vizen/internal/jobs"
+	"github.com/Bellorico323/vizen/internal/services"
 	"github.com/Bellorico323/vizen/internal/store/pgstore"
 	"github.com/Bellorico323/vizen/internal/usecases"
 	"github.com/go-chi/chi/v5"
@@ -80,6 +89,21 @@ func main() {
 		panic(err)
 	}
 
+	urlSigner, err := auth.NewURLSigner()
+	if err != nil {
+		panic(err)
+	}
+
+	inviteTokenSigner, err := auth.NewInviteTokenSigner()
+	if err != nil {
+		panic(err)
+	}
+
+	storageService, err := newStorageService(urlSigner)
+	if err != nil {
+		panic(err)
+	}
+
 	queries := pgstore.New(pool)
 
 	credsBase64 := os.Getenv("FIREBASE_CREDENTIALS_BASE64")
@@ -104,6 +128,7 @@ func main() {
 	}
 
 	notiService := notification.NewFireBaseService(messagingClient, queries)
+	visitorEvents := events.NewMemoryBroker()
 
 	signupWithCredentials := usecases.NewSignupWithCredentialsUseCase(pool)
 	signinWithCredentials := usecases.NewSigninUserWithCredentials(queries, tokenService)
@@ -123,23 +148,98 @@ func main() {
 	listAnnouncements := usecases.NewListAnnouncementsUseCase(queries)
 	deleteAnnouncement := usecases.NewDeleteAnnouncementUseCase(queries)
 	createPackage := usecases.NewCreatePackageUseCase(queries, notiService)
+	createPackagesBatch := usecases.NewCreatePackagesBatchUseCase(pool, queries, notiService)
 	getPackage := usecases.NewGetPackageUseCase(queries)
 	listPackages := usecases.NewListPackagesUseCase(queries)
 	withdrawPackage := usecases.NewWithdrawPackageUseCase(queries)
+	uploadPackagePhoto := usecases.NewUploadPackagePhotoUseCase(queries, storageService)
+	getPackagePhoto := usecases.NewGetPackagePhotoUseCase(queries, storageService)
+	releasePackage := usecases.NewReleasePackageUseCase(queries)
+	resetPackagePickupCode := usecases.NewResetPackagePickupCodeUseCase(queries, notiService)
+	returnPackage := usecases.NewReturnPackageUseCase(queries, notiService)
+	notifyPendingPackages := usecases.NewNotifyPendingPackagesUseCase(
+		queries,
+		notiService,
+		envInt32List("PACKAGE_REMINDER_DAYS", []int32{2, 5, 10}),
+		int32(envInt64("PACKAGE_STORAGE_LIMIT_DAYS", 30)),
+	)
+	createPickupAuthorization := usecases.NewCreatePickupAuthorizationUseCase(queries)
+	listPickupAuthorizations := usecases.NewListPickupAuthorizationsUseCase(queries)
+	revokePickupAuthorization := usecases.NewRevokePickupAuthorizationUseCase(queries)
 	createInvite := usecases.NewCreateInviteUseCase(queries)
 	validateInvite := usecases.NewValidateInviteUseCase(pool, notiService)
 	revokeInvite := usecases.NewRevokeInviteUseCase(queries)
 	listInvites := usecases.NewListInvitesUseCase(queries)
-	createCommonArea := usecases.NewCreateCommonAreaUseCase(queries)
+	getInviteQRCode := usecases.NewGetInviteQRCodeUseCase(queries, inviteTokenSigner)
+	shareInvite := usecases.NewShareInviteUseCase(queries, urlSigner, apiPublicURL())
+	getPublicInvite := usecases.NewGetPublicInviteUseCase(queries, urlSigner)
+	listAccessLogs := usecases.NewListAccessLogsUseCase(queries)
+	listPeopleInside := usecases.NewListPeopleInsideUseCase(queries, envDuration("ACCESS_INSIDE_LOOKBACK", 24*time.Hour))
+	registerAccessExit := usecases.NewRegisterAccessExitUseCase(queries)
+	createVisitorRequest := usecases.NewCreateVisitorRequestUseCase(
+		queries,
+		storageService,
+		notiService,
+		visitorEvents,
+		envDuration("VISITOR_APPROVAL_TIMEOUT", 3*time.Minute),
+	)
+	listVisitorRequests := usecases.NewListVisitorRequestsUseCase(queries, storageService)
+	getVisitorRequest := usecases.NewGetVisitorRequestUseCase(queries, storageService)
+	respondVisitorRequest := usecases.NewRespondVisitorRequestUseCase(pool, notiService, visitorEvents)
+	watchVisitorRequests := usecases.NewWatchVisitorRequestsUseCase(queries, visitorEvents)
+	expireVisitorRequests := usecases.NewExpireVisitorRequestsUseCase(queries, visitorEvents)
+	registerPlateEntry := usecases.NewRegisterPlateEntryUseCase(pool, notiService)
+	createVehicle := usecases.NewCreateVehicleUseCase(queries)
+	listVehicles := usecases.NewListVehiclesUseCase(queries)
+	removeVehicle := usecases.NewRemoveVehicleUseCase(queries)
+	lookupPlate := usecases.NewLookupPlateUseCase(queries)
+	getInviteOfflineToken := usecases.NewGetInviteOfflineTokenUseCase(queries, inviteTokenSigner)
+	syncOfflineEntries := usecases.NewSyncOfflineEntriesUseCase(queries, inviteTokenSigner)
+	createBlockedVisitor := usecases.NewCreateBlockedVisitorUseCase(queries)
+	listBlockedVisitors := usecases.NewListBlockedVisitorsUseCase(queries)
+	removeBlockedVisitor := usecases.NewRemoveBlockedVisitorUseCase(queries)
+	createCommonArea := usecases.NewCreateCommonAreaUseCase(pool)
 	listCommonAreas := usecases.NewListCommonAreasUseCase(queries)
+	updateCommonAreaRules := usecases.NewUpdateCommonAreaRulesUseCase(pool)
+	updateCommonArea := usecases.NewUpdateCommonAreaUseCase(pool)
+	archiveCommonArea := usecases.NewArchiveCommonAreaUseCase(pool, notiService)
+	createCommonAreaBlackout := usecases.NewCreateCommonAreaBlackoutUseCase(pool, notiService)
+	listCommonAreaBlackouts := usecases.NewListCommonAreaBlackoutsUseCase(queries)
+	deleteCommonAreaBlackout := usecases.NewDeleteCommonAreaBlackoutUseCase(pool)
 	createBooking := usecases.NewCreateBookingUseCase(pool, queries)
-	editBooking := usecases.NewEditBookingUseCase(queries, notiService)
+	editBooking := usecases.NewEditBookingUseCase(pool, notiService)
+	cancelBooking := usecases.NewCancelBookingUseCase(pool, notiService)
+	releaseBookingDeposit := usecases.NewReleaseBookingDepositUseCase(pool, notiService)
 	listBookings := usecases.NewListBookingsUseCase(queries)
+	joinBookingWaitlist := usecases.NewJoinBookingWaitlistUseCase(queries)
+	listBookingWaitlist := usecases.NewListBookingWaitlistUseCase(queries)
+	acceptWaitlistOffer := usecases.NewAcceptWaitlistOfferUseCase(pool)
+	leaveBookingWaitlist := usecases.NewLeaveBookingWaitlistUseCase(pool, notiService)
+	expireWaitlistOffers := usecases.NewExpireWaitlistOffersUseCase(pool, notiService)
+	expirePendingBookings := usecases.NewExpirePendingBookingsUseCase(pool, notiService)
 	getAreaAvailability := usecases.NewGetAreaAvailabilityUseCase(queries)
+	createCalendarFeed := usecases.NewCreateCalendarFeedUseCase(queries, urlSigner, apiPublicURL())
+	listCalendarFeeds := usecases.NewListCalendarFeedsUseCase(queries, urlSigner, apiPublicURL())
+	revokeCalendarFeed := usecases.NewRevokeCalendarFeedUseCase(queries)
+	getCalendarFeed := usecases.NewGetCalendarFeedUseCase(queries, urlSigner)
 	createBill := usecases.NewCreateBillUseCase(queries)
 	markBillAsPaid := usecases.NewMarkBillASPaidUseCase(queries)
 	cancelBill := usecases.NewCancelBillUseCase(queries)
 	listBills := usecases.NewListBillsUseCase(queries)
+	createPoll := usecases.NewCreatePollUseCase(pool)
+	listPolls := usecases.NewListPollsUseCase(queries)
+	getPoll := usecases.NewGetPollUseCase(queries)
+	votePoll := usecases.NewVotePollUseCase(pool)
+	closePoll := usecases.NewClosePollUseCase(queries, notiService)
+	notifyPollEvents := usecases.NewNotifyPollEventsUseCase(queries, notiService, envDuration("POLL_CLOSING_REMINDER", 24*time.Hour))
+
+	jobs.NewScheduler(
+		jobs.Job{Name: "poll_notifications", Interval: time.Minute, Task: notifyPollEvents},
+		jobs.Job{Name: "package_reminders", Interval: time.Hour, Task: notifyPendingPackages},
+		jobs.Job{Name: "visitor_request_expiry", Interval: 15 * time.Second, Task: expireVisitorRequests},
+		jobs.Job{Name: "booking_waitlist_expiry", Interval: time.Minute, Task: expireWaitlistOffers},
+		jobs.Job{Name: "pending_booking_expiry", Interval: time.Minute, Task: expirePendingBookings},
+	).Start(ctx)
 
 	api := api.Api{
 		Router:       chi.NewMux(),
@@ -199,6 +299,9 @@ func main() {
 		CreatePackageController: &controllers.CreatePackageHandler{
 			CreatePackage: createPackage,
 		},
+		CreatePackagesBatchController: &controllers.CreatePackagesBatchHandler{
+			CreatePackagesBatch: createPackagesBatch,
+		},
 		GetPackageController: &controllers.GetPackageHandler{
 			GetPackage: getPackage,
 		},
@@ -208,6 +311,35 @@ func main() {
 		WithdrawPackageController: &controllers.WithdrawPackageHandler{
 			WithdrawPackage: withdrawPackage,
 		},
+		UploadPackagePhotoController: &controllers.UploadPackagePhotoHandler{
+			UploadPackagePhoto: uploadPackagePhoto,
+			MaxUploadBytes:     envInt64("STORAGE_MAX_UPLOAD_BYTES", 10<<20),
+		},
+		GetPackagePhotoController: &controllers.GetPackagePhotoHandler{
+			GetPackagePhoto: getPackagePhoto,
+		},
+		DownloadFileController: &controllers.DownloadFileHandler{
+			Storage: storageService,
+			Signer:  urlSigner,
+		},
+		ReleasePackageController: &controllers.ReleasePackageHandler{
+			ReleasePackage: releasePackage,
+		},
+		ResetPackagePickupCodeController: &controllers.ResetPackagePickupCodeHandler{
+			ResetPackagePickupCode: resetPackagePickupCode,
+		},
+		ReturnPackageController: &controllers.ReturnPackageHandler{
+			ReturnPackage: returnPackage,
+		},
+		CreatePickupAuthorizationController: &controllers.CreatePickupAuthorizationHandler{
+			CreatePickupAuthorization: createPickupAuthorization,
+		},
+		ListPickupAuthorizationsController: &controllers.ListPickupAuthorizationsHandler{
+			ListPickupAuthorizations: listPickupAuthorizations,
+		},
+		RevokePickupAuthorizationController: &controllers.RevokePickupAuthorizationHandler{
+			RevokePickupAuthorization: revokePickupAuthorization,
+		},
 		CreateInviteController: &controllers.CreateInviteHandler{
 			CreateInvite: createInvite,
 		},
@@ -220,24 +352,139 @@ func main() {
 		ListInvitesController: &controllers.ListInvitesHandler{
 			ListInvites: listInvites,
 		},
+		GetInviteQRCodeController: &controllers.GetInviteQRCodeHandler{
+			GetInviteQRCode: getInviteQRCode,
+		},
+		ShareInviteController: &controllers.ShareInviteHandler{
+			ShareInvite: shareInvite,
+		},
+		GetPublicInviteController: &controllers.GetPublicInviteHandler{
+			GetPublicInvite: getPublicInvite,
+		},
+		ListAccessLogsController: &controllers.ListAccessLogsHandler{
+			ListAccessLogs: listAccessLogs,
+		},
+		ListPeopleInsideController: &controllers.ListPeopleInsideHandler{
+			ListPeopleInside: listPeopleInside,
+		},
+		RegisterAccessExitController: &controllers.RegisterAccessExitHandler{
+			RegisterAccessExit: registerAccessExit,
+		},
+		CreateVisitorRequestController: &controllers.CreateVisitorRequestHandler{
+			CreateVisitorRequest: createVisitorRequest,
+			MaxUploadBytes:       envInt64("STORAGE_MAX_UPLOAD_BYTES", 10<<20),
+		},
+		ListVisitorRequestsController: &controllers.ListVisitorRequestsHandler{
+			ListVisitorRequests: listVisitorRequests,
+		},
+		GetVisitorRequestController: &controllers.GetVisitorRequestHandler{
+			GetVisitorRequest: getVisitorRequest,
+		},
+		RespondVisitorRequestController: &controllers.RespondVisitorRequestHandler{
+			RespondVisitorRequest: respondVisitorRequest,
+		},
+		WatchVisitorRequestsController: &controllers.WatchVisitorRequestsHandler{
+			WatchVisitorRequests: watchVisitorRequests,
+		},
+		RegisterPlateEntryController: &controllers.RegisterPlateEntryHandler{
+			RegisterPlateEntry: registerPlateEntry,
+		},
+		CreateVehicleController: &controllers.CreateVehicleHandler{
+			CreateVehicle: createVehicle,
+		},
+		ListVehiclesController: &controllers.ListVehiclesHandler{
+			ListVehicles: listVehicles,
+		},
+		RemoveVehicleController: &controllers.RemoveVehicleHandler{
+			RemoveVehicle: removeVehicle,
+		},
+		LookupPlateController: &controllers.LookupPlateHandler{
+			LookupPlate: lookupPlate,
+		},
+		GetInviteOfflineTokenController: &controllers.GetInviteOfflineTokenHandler{
+			GetInviteOfflineToken: getInviteOfflineToken,
+		},
+		GetInviteKeysController: &controllers.GetInviteKeysHandler{
+			Signer: inviteTokenSigner,
+		},
+		SyncOfflineEntriesController: &controllers.SyncOfflineEntriesHandler{
+			SyncOfflineEntries: syncOfflineEntries,
+		},
+		CreateBlockedVisitorController: &controllers.CreateBlockedVisitorHandler{
+			CreateBlockedVisitor: createBlockedVisitor,
+		},
+		ListBlockedVisitorsController: &controllers.ListBlockedVisitorsHandler{
+			ListBlockedVisitors: listBlockedVisitors,
+		},
+		RemoveBlockedVisitorController: &controllers.RemoveBlockedVisitorHandler{
+			RemoveBlockedVisitor: removeBlockedVisitor,
+		},
 		CreateCommonAreaController: &controllers.CreateCommonAreaHandler{
 			CreateCommonArea: createCommonArea,
 		},
 		ListCommonAreasController: &controllers.ListCommonAreasHandler{
 			ListCommonAreas: listCommonAreas,
 		},
+		UpdateCommonAreaRulesController: &controllers.UpdateCommonAreaRulesHandler{
+			UpdateCommonAreaRules: updateCommonAreaRules,
+		},
+		UpdateCommonAreaController: &controllers.UpdateCommonAreaHandler{
+			UpdateCommonArea: updateCommonArea,
+		},
+		ArchiveCommonAreaController: &controllers.ArchiveCommonAreaHandler{
+			ArchiveCommonArea: archiveCommonArea,
+		},
+		CreateCommonAreaBlackoutController: &controllers.CreateCommonAreaBlackoutHandler{
+			CreateCommonAreaBlackout: createCommonAreaBlackout,
+		},
+		ListCommonAreaBlackoutsController: &controllers.ListCommonAreaBlackoutsHandler{
+			ListCommonAreaBlackouts: listCommonAreaBlackouts,
+		},
+		DeleteCommonAreaBlackoutController: &controllers.DeleteCommonAreaBlackoutHandler{
+			DeleteCommonAreaBlackout: deleteCommonAreaBlackout,
+		},
 		CreateBookingController: &controllers.CreateBookingsHandler{
 			CreateBooking: createBooking,
 		},
 		EditBookingController: &controllers.EditBookingHandler{
 			EditBooking: editBooking,
 		},
+		CancelBookingController: &controllers.CancelBookingHandler{
+			CancelBooking: cancelBooking,
+		},
+		ReleaseBookingDepositController: &controllers.ReleaseBookingDepositHandler{
+			ReleaseBookingDeposit: releaseBookingDeposit,
+		},
 		ListBookingsController: &controllers.ListBookingsHandler{
 			ListBookings: listBookings,
 		},
+		JoinBookingWaitlistController: &controllers.JoinBookingWaitlistHandler{
+			JoinBookingWaitlist: joinBookingWaitlist,
+		},
+		ListBookingWaitlistController: &controllers.ListBookingWaitlistHandler{
+			ListBookingWaitlist: listBookingWaitlist,
+		},
+		AcceptWaitlistOfferController: &controllers.AcceptWaitlistOfferHandler{
+			AcceptWaitlistOffer: acceptWaitlistOffer,
+		},
+		LeaveBookingWaitlistController: &controllers.LeaveBookingWaitlistHandler{
+			LeaveBookingWaitlist: leaveBookingWaitlist,
+		},
 		GetAreaAvailabilityController: &controllers.GetAreaAvailabilityHandler{
 			GetAreaAvailability: getAreaAvailability,
 		},
+		CreateCalendarFeedController: &controllers.CreateCalendarFeedHandler{
+			CreateCalendarFeed: createCalendarFeed,
+		},
+		ListCalendarFeedsController: &controllers.ListCalendarFeedsHandler{
+			ListCalendarFeeds: listCalendarFeeds,
+		},
+		RevokeCalendarFeedController: &controllers.RevokeCalendarFeedHandler{
+			RevokeCalendarFeed: revokeCalendarFeed,
+		},
+		GetCalendarFeedController: &controllers.GetCalendarFeedHandler{
+			GetCalendarFeed: getCalendarFeed,
+		},
 		CreateBillController: &controllers.CreateBillHandler{
 			CreateBill: createBill,
 		},
@@ -250,6 +497,21 @@ func main() {
 		ListBillsController: &controllers.ListBillsHandler{
 			ListBills: listBills,
 		},
+		CreatePollController: &controllers.CreatePollHandler{
+			CreatePoll: createPoll,
+		},
+		ListPollsController: &controllers.ListPollsHandler{
+			ListPolls: listPolls,
+		},
+		GetPollController: &controllers.GetPollHandler{
+			GetPoll: getPoll,
+		},
+		VotePollController: &controllers.VotePollHandler{
+			VotePoll: votePoll,
+		},
+		ClosePollController: &controllers.ClosePollHandler{
+			ClosePoll: closePoll,
+		},
 	}
 
 	api.BindRoutes()
@@ -264,3 +526,92 @@ func main() {
 		panic(err)
 	}
 }
+
+// envDuration reads a time.Duration (e.g. "24h") from the environment, falling back to def.
+func envDuration(key string, def time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return def
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		slog.Error("invalid duration in environment, using default", "key", key, "value", value, "error", err)
+		return def
+	}
+
+	return d
+}
+
+// envInt64 reads an integer from the environment, falling back to def.
+func envInt64(key string, def int64) int64 {
+	value := os.Getenv(key)
+	if value == "" {
+		return def
+	}
+
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		slog.Error("invalid integer in environment, using default", "key", key, "value", value, "error", err)
+		return def
+	}
+
+	return n
+}
+
+// envInt32List reads a comma-separated list of integers (e.g. "2,5,10") from the environment, falling back to def.
+func envInt32List(key string, def []int32) []int32 {
+	value := os.Getenv(key)
+	if value == "" {
+		return def
+	}
+
+	var list []int32
+	for part := range strings.SplitSeq(value, ",") {
+		n, err := strconv.ParseInt(strings.TrimSpace(part), 10, 32)
+		if err != nil {
+			slog.Error("invalid integer list in environment, using default", "key", key, "value", value, "error", err)
+			return def
+		}
+		list = append(list, int32(n))
+	}
+
+	slices.Sort(list)
+	return list
+}
+
+// newStorageService picks the file storage backend from STORAGE_DRIVER ("local" by default or "s3").
+func newStorageService(signer *auth.URLSigner) (services.StorageService, error) {
+	switch os.Getenv("STORAGE_DRIVER") {
+	case "s3":
+		usePathStyle, _ := strconv.ParseBool(os.Getenv("S3_USE_PATH_STYLE"))
+
+		return storage.NewS3Storage(storage.S3Config{
+			Endpoint:        os.Getenv("S3_ENDPOINT"),
+			Region:          os.Getenv("S3_REGION"),
+			Bucket:          os.Getenv("S3_BUCKET"),
+			AccessKeyID:     os.Getenv("S3_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("S3_SECRET_ACCESS_KEY"),
+			UsePathStyle:    usePathStyle,
+		})
+
+	case "", "local":
+		dir := os.Getenv("STORAGE_LOCAL_DIR")
+		if dir == "" {
+			dir = "./uploads"
+		}
+
+		return storage.NewLocalStorage(dir, apiPublicURL(), signer)
+
+	default:
+		return nil, fmt.Errorf("unknown STORAGE_DRIVER %q", os.Getenv("STORAGE_DRIVER"))
+	}
+}
+
+// apiPublicURL is the externally reachable address of the API, used to build links sent to clients.
+func apiPublicURL() string {
+	if baseURL := os.Getenv("API_PUBLIC_URL"); baseURL != "" {
+		return baseURL
+	}
+	return "http://localhost:3000"
+}
@@ -19,6 +19,26 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/.well-known/jwks.json": {
+            "get": {
+                "description": "Public JSON Web Key Set with the Ed25519 keys that sign offline invite tokens. Gate devices cache it and pick the key by the token kid header. Keys being rotated out stay listed while tokens signed with them are valid.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Invites"
+                ],
+                "summary": "Get Invite Keys",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/auth.JWKS"
+                        }
+                    }
+                }
+            }
+        },
         "/access-requests": {
             "post": {
                 "security": [
@@ -305,21 +325,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/announcements": {
+        "/access_logs": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a paginated list of announcements for a specific condominium.",
+                "description": "Lists gate entries, newest first. Walk-in visitors approved by a resident show up with guestType walk_in and a visitorRequestId instead of an inviteId. Resident vehicles let in by plate show up with guestType resident and a vehicleId. Staff can see the whole condominium and filter by apartment, invite, guest type and date range. Residents must inform their apartmentId and only see their own unit.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Announcements"
+                    "Access Logs"
                 ],
-                "summary": "List Announcements",
+                "summary": "List Access Logs",
                 "parameters": [
                     {
                         "type": "string",
@@ -328,6 +351,36 @@ const docTemplate = `{
                         "in": "query",
                         "required": true
                     },
+                    {
+                        "type": "string",
+                        "description": "Apartment UUID (Required for residents)",
+                        "name": "apartmentId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Invite UUID",
+                        "name": "inviteId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Guest type (guest, service, delivery, walk_in, resident)",
+                        "name": "guestType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entries at or after this instant (RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entries before this instant (RFC3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
                         "description": "Page number (default 1)",
@@ -336,7 +389,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "integer",
-                        "description": "Items per page (default 10)",
+                        "description": "Items per page (default 50)",
                         "name": "limit",
                         "in": "query"
                     }
@@ -345,14 +398,11 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/api_controllers.AnnouncementResponse"
-                            }
+                            "$ref": "#/definitions/api_controllers.ListAccessLogsResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid UUID or parameters",
+                        "description": "Invalid parameters",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
@@ -364,7 +414,7 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "User does not have permission",
+                        "description": "Permission denied",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
@@ -376,14 +426,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/access_logs/inside": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Creates a new announcement for a condominium and sends push notifications to all residents.",
+                "description": "Lists visitors that entered and have no exit registered yet, grouped by unit. Meant for building evacuations. Staff only. Resident vehicles are not listed. Entries older than the configured lookback (24h by default) are ignored.",
                 "consumes": [
                     "application/json"
                 ],
@@ -391,29 +443,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Announcements"
+                    "Access Logs"
                 ],
-                "summary": "Create Announcement",
+                "summary": "List People Inside",
                 "parameters": [
                     {
-                        "description": "Announcement payload",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/api_controllers.CreateAnnouncementRequest"
-                        }
+                        "type": "string",
+                        "description": "Condominium UUID",
+                        "name": "condominiumId",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Announcement created successfully",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.SuccessResponse"
+                            "$ref": "#/definitions/api_controllers.ListPeopleInsideResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid JSON or Empty fields",
+                        "description": "Invalid condominiumId",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
@@ -425,17 +475,11 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "User does not have permission (Must be Admin/Syndic)",
+                        "description": "Permission denied",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
-                    "422": {
-                        "description": "Validation failed",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ValidationErrResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -445,33 +489,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/announcements/{id}": {
-            "delete": {
+        "/access_logs/plate": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Removes an announcement. Requires Admin or Syndic role.",
+                "description": "Lets a plate in when it belongs to a resident vehicle or to an invite usable right now (same rules as token validation, including schedule, entry limits and the condominium blocklist), and records the entry in the access log. Staff only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Announcements"
+                    "Access Logs"
                 ],
-                "summary": "Delete Announcement",
+                "summary": "Register Plate Entry",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Announcement UUID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Plate read at the gate",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.RegisterPlateEntryRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.RegisterPlateEntryResponse"
+                        }
                     },
                     "400": {
-                        "description": "Invalid ID",
+                        "description": "Invalid Payload or plate",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
@@ -483,15 +538,15 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "Permission denied",
+                        "description": "Permission denied, plate not authorized or visitor blocked",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
-                    "404": {
-                        "description": "Announcement not found",
+                    "422": {
+                        "description": "Validation Failed",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ValidationErrResponse"
                         }
                     },
                     "500": {
@@ -503,14 +558,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/apartments": {
+        "/access_logs/sync": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Creates a new apartment. The user must be an **admin** or **syndic** of the condominium.",
+                "description": "Records the entries a gate device let in while offline, using the signed invite tokens it verified. id is generated by the device for each entry: uploading an entry again reports it as duplicate instead of logging it twice, so failed uploads can simply be retried. Entries with an invalid signature, a token from another condominium or a time outside the invite validity window are rejected. Up to 500 entries per request. Staff only.",
                 "consumes": [
                     "application/json"
                 ],
@@ -518,29 +573,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Apartments"
+                    "Access Logs"
                 ],
-                "summary": "Create Apartment",
+                "summary": "Sync Offline Entries",
                 "parameters": [
                     {
-                        "description": "Apartment creation payload",
+                        "description": "Entries recorded offline",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api_controllers.CreateApartmentRequest"
+                            "$ref": "#/definitions/api_controllers.SyncOfflineEntriesRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Apartment successfully created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_controllers.CreateApartmentResponse"
+                            "$ref": "#/definitions/api_controllers.SyncOfflineEntriesResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid JSON payload",
+                        "description": "Invalid Payload",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
@@ -552,25 +607,13 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "User does not have permission",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Condominium not found",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "Apartment already exists in this block",
+                        "description": "Permission denied",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
                     "422": {
-                        "description": "Validation failed",
+                        "description": "Validation Failed",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ValidationErrResponse"
                         }
@@ -584,9 +627,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/logout": {
-            "post": {
-                "description": "Revokes the user session.\nIt checks for the 'refresh_token' in HttpOnly Cookies (Web) OR in the JSON Body (Mobile).\nIf found, the session is deleted from the database and the cookie is cleared.",
+        "/access_logs/{id}/exit": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Registers the moment a visitor left the condominium. Staff only.",
                 "consumes": [
                     "application/json"
                 ],
@@ -594,76 +642,51 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Access Logs"
                 ],
-                "summary": "Logout User",
+                "summary": "Register Exit",
                 "parameters": [
                     {
-                        "description": "Refresh Token (Optional if using Cookies)",
-                        "name": "request",
-                        "in": "body",
-                        "schema": {
-                            "$ref": "#/definitions/api_controllers.LogoutRequest"
-                        }
+                        "type": "string",
+                        "description": "Access log UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content - Successfully logged out"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.RegisterAccessExitResponse"
+                        }
                     },
                     "400": {
-                        "description": "Refresh token not found in Cookie or Body",
+                        "description": "Invalid ID",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "401": {
+                        "description": "User not authenticated",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/auth/refresh": {
-            "post": {
-                "description": "Generates a new access token using a valid refresh token from Cookie (Web) or Body (Mobile)",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Auth"
-                ],
-                "summary": "Refresh Access Token",
-                "parameters": [
-                    {
-                        "description": "Refresh Token (Optional if using Cookies)",
-                        "name": "request",
-                        "in": "body",
-                        "schema": {
-                            "$ref": "#/definitions/api_controllers.RefreshTokenRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Token refreshed successfully",
+                    },
+                    "403": {
+                        "description": "Permission denied",
                         "schema": {
-                            "$ref": "#/definitions/api_controllers.RefreshTokenResponse"
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
-                    "400": {
-                        "description": "Token not found",
+                    "404": {
+                        "description": "Access log not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
-                    "401": {
-                        "description": "Invalid or expired session",
+                    "409": {
+                        "description": "Exit already registered",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
@@ -677,21 +700,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/bills": {
+        "/announcements": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "List bills. Admins see all (or filter). Residents only see their own apartment's bills.",
+                "description": "Get a paginated list of announcements for a specific condominium.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Bills"
+                    "Announcements"
                 ],
-                "summary": "List Bills",
+                "summary": "List Announcements",
                 "parameters": [
                     {
                         "type": "string",
@@ -700,18 +723,6 @@ const docTemplate = `{
                         "in": "query",
                         "required": true
                     },
-                    {
-                        "type": "string",
-                        "description": "Apartment UUID (Required for Residents)",
-                        "name": "apartmentId",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by status (pending, paid, overdue, cancelled)",
-                        "name": "status",
-                        "in": "query"
-                    },
                     {
                         "type": "integer",
                         "description": "Page number (default 1)",
@@ -729,11 +740,32 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_controllers.ListBillsResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api_controllers.AnnouncementResponse"
+                            }
                         }
                     },
-                    "403": {
-                        "description": "Permission denied",
+                    "400": {
+                        "description": "Invalid UUID or parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "User does not have permission",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
@@ -746,7 +778,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Creates a new financial bill (boleto/pix) for a specific apartment. Only Admins/Syndics.",
+                "description": "Creates a new announcement for a condominium and sends push notifications to all residents.",
                 "consumes": [
                     "application/json"
                 ],
@@ -754,47 +786,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Bills"
+                    "Announcements"
                 ],
-                "summary": "Create Bill",
+                "summary": "Create Announcement",
                 "parameters": [
                     {
-                        "description": "Bill Information",
+                        "description": "Announcement payload",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api_controllers.CreateBillRequest"
+                            "$ref": "#/definitions/api_controllers.CreateAnnouncementRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Created",
+                        "description": "Announcement created successfully",
                         "schema": {
-                            "$ref": "#/definitions/api_controllers.CreateBillResponse"
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.SuccessResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid JSON or Logic Error",
+                        "description": "Invalid JSON or Empty fields",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized",
+                        "description": "User not authenticated",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
                     "403": {
-                        "description": "Permission denied",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Apartment not found",
+                        "description": "User does not have permission (Must be Admin/Syndic)",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
@@ -814,32 +840,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/bills/{id}/cancel": {
-            "patch": {
-                "description": "Cancels a pending or overdue bill. Cannot cancel paid bills. Only for Admins/Syndics.// @Security\t   BearerAuth",
-                "consumes": [
-                    "application/json"
+        "/announcements/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Removes an announcement. Requires Admin or Syndic role.",
                 "tags": [
-                    "Bills"
+                    "Announcements"
                 ],
-                "summary": "Cancel Bill",
+                "summary": "Delete Announcement",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Bill UUID",
+                        "description": "Announcement UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Condominium id",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/api_controllers.CancelBillRequest"
-                        }
                     }
                 ],
                 "responses": {
@@ -847,27 +866,27 @@ const docTemplate = `{
                         "description": "No Content"
                     },
                     "400": {
-                        "description": "Invalid JSON or Logic Error",
+                        "description": "Invalid ID",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
-                    "403": {
-                        "description": "Permission denied",
+                    "401": {
+                        "description": "User not authenticated",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
-                    "409": {
-                        "description": "Bill already paid or cancelled",
+                    "403": {
+                        "description": "Permission denied",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
-                    "422": {
-                        "description": "Validation failed",
+                    "404": {
+                        "description": "Announcement not found",
                         "schema": {
-                            "type": "objct"
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
                     "500": {
@@ -879,57 +898,68 @@ const docTemplate = `{
                 }
             }
         },
-        "/bills/{id}/pay": {
-            "patch": {
+        "/apartments": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Manually confirms payment for a bill. Only for Admins/Syndics.",
+                "description": "Creates a new apartment. The user must be an **admin** or **syndic** of the condominium.",
                 "consumes": [
                     "application/json"
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Bills"
+                    "Apartments"
                 ],
-                "summary": "Mark Bill as Paid",
+                "summary": "Create Apartment",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Bill UUID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Condominium id",
+                        "description": "Apartment creation payload",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api_controllers.MarkBillAsPaidRequest"
+                            "$ref": "#/definitions/api_controllers.CreateApartmentRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "Apartment successfully created",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.CreateApartmentResponse"
+                        }
                     },
                     "400": {
-                        "description": "Invalid JSON or Logic Error",
+                        "description": "Invalid JSON payload",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
                     "403": {
-                        "description": "Permission denied",
+                        "description": "User does not have permission",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Condominium not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
                     "409": {
-                        "description": "Bill already paid or cancelled",
+                        "description": "Apartment already exists in this block",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
@@ -937,7 +967,7 @@ const docTemplate = `{
                     "422": {
                         "description": "Validation failed",
                         "schema": {
-                            "type": "objct"
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ValidationErrResponse"
                         }
                     },
                     "500": {
@@ -949,14 +979,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/bookings": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Get a list of bookings. Admin can filter by user. Residents are forced to see their own history unless they use public availability endpoint.",
+        "/auth/logout": {
+            "post": {
+                "description": "Revokes the user session.\nIt checks for the 'refresh_token' in HttpOnly Cookies (Web) OR in the JSON Body (Mobile).\nIf found, the session is deleted from the database and the cookie is cleared.",
                 "consumes": [
                     "application/json"
                 ],
@@ -964,57 +989,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Bookings"
+                    "Auth"
                 ],
-                "summary": "List Bookings",
+                "summary": "Logout User",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Condominium UUID",
-                        "name": "condominiumId",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by User UUID (Optional)",
-                        "name": "userId",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by Common Area UUID (Optional)",
-                        "name": "commonAreaId",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Start Date (ISO 8601 e.g. 2026-01-01T00:00:00Z)",
-                        "name": "from",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "End Date (ISO 8601)",
-                        "name": "to",
-                        "in": "query"
+                        "description": "Refresh Token (Optional if using Cookies)",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.LogoutRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/api_controllers.ListBookingsResponse"
-                        }
+                    "204": {
+                        "description": "No Content - Successfully logged out"
                     },
                     "400": {
-                        "description": "Invalid UUID or Date format",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
+                        "description": "Refresh token not found in Cookie or Body",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
@@ -1026,14 +1019,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/auth/refresh": {
             "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Create a reservation for a common area (e.g. Party Hall). Prevents double booking via locking.",
+                "description": "Generates a new access token using a valid refresh token from Cookie (Web) or Body (Mobile)",
                 "consumes": [
                     "application/json"
                 ],
@@ -1041,35 +1031,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Bookings"
+                    "Auth"
                 ],
-                "summary": "Book Common Area",
+                "summary": "Refresh Access Token",
                 "parameters": [
                     {
-                        "description": "Booking Data",
+                        "description": "Refresh Token (Optional if using Cookies)",
                         "name": "request",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api_controllers.CreateBookingRequest"
+                            "$ref": "#/definitions/api_controllers.RefreshTokenRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "Token refreshed successfully",
                         "schema": {
-                            "$ref": "#/definitions/api_controllers.CreateBookingResponse"
+                            "$ref": "#/definitions/api_controllers.RefreshTokenResponse"
                         }
                     },
-                    "403": {
-                        "description": "Permission denied",
+                    "400": {
+                        "description": "Token not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
-                    "409": {
-                        "description": "Time slot conflict",
+                    "401": {
+                        "description": "Invalid or expired session",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
@@ -1077,48 +1072,106 @@ const docTemplate = `{
                 }
             }
         },
-        "/bookings/{id}/status": {
-            "patch": {
+        "/bills": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Allows an Admin or Syndic to approve/deny a pending booking.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "List bills. Admins see all (or filter). Residents only see their own apartment's bills.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Bookings"
+                    "Bills"
                 ],
-                "summary": "Approve or Reject Booking",
+                "summary": "List Bills",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Booking UUID",
-                        "name": "id",
-                        "in": "path",
+                        "description": "Condominium UUID",
+                        "name": "condominiumId",
+                        "in": "query",
                         "required": true
                     },
                     {
-                        "description": "New Status (confirmed/denied)",
+                        "type": "string",
+                        "description": "Apartment UUID (Required for Residents)",
+                        "name": "apartmentId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by status (pending, paid, overdue, cancelled)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number (default 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page (default 10)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.ListBillsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a new financial bill (boleto/pix) for a specific apartment. Only Admins/Syndics.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bills"
+                ],
+                "summary": "Create Bill",
+                "parameters": [
+                    {
+                        "description": "Bill Information",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api_controllers.EditBookingRequest"
+                            "$ref": "#/definitions/api_controllers.CreateBillRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.CreateBillResponse"
+                        }
                     },
                     "400": {
-                        "description": "Invalid Payload or ID",
+                        "description": "Invalid JSON or Logic Error",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
@@ -1130,25 +1183,25 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "Permission Denied",
+                        "description": "Permission denied",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
                     "404": {
-                        "description": "Booking Not Found",
+                        "description": "Apartment not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict (Booking not pending)",
+                    "422": {
+                        "description": "Validation failed",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ValidationErrResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
@@ -1156,62 +1209,64 @@ const docTemplate = `{
                 }
             }
         },
-        "/common-areas": {
-            "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Creates a new bookable area (e.g., Gym, BBQ). Only Admin/Syndic can perform this action.",
+        "/bills/{id}/cancel": {
+            "patch": {
+                "description": "Cancels a pending or overdue bill. Cannot cancel paid bills. Only for Admins/Syndics.// @Security\t   BearerAuth",
                 "consumes": [
                     "application/json"
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Common Areas"
+                    "Bills"
                 ],
-                "summary": "Create Common Area",
+                "summary": "Cancel Bill",
                 "parameters": [
                     {
-                        "description": "Common Area Data",
+                        "type": "string",
+                        "description": "Bill UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Condominium id",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api_controllers.CreateCommonAreaRequest"
+                            "$ref": "#/definitions/api_controllers.CancelBillRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/api_controllers.CreateCommonAreaResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Invalid JSON or Logic Error",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
                     "403": {
-                        "description": "Forbidden",
+                        "description": "Permission denied",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
                     "409": {
-                        "description": "Conflict (Name already exists)",
+                        "description": "Bill already paid or cancelled",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "type": "objct"
+                        }
+                    },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
@@ -1219,68 +1274,69 @@ const docTemplate = `{
                 }
             }
         },
-        "/common-areas/{id}/availability": {
-            "get": {
+        "/bills/{id}/pay": {
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns a list of occupied time slots for a specific common area within a date range. Used to populate calendars.",
+                "description": "Manually confirms payment for a bill. Only for Admins/Syndics.",
                 "consumes": [
                     "application/json"
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Common Areas"
+                    "Bills"
                 ],
-                "summary": "Get Area Availability",
+                "summary": "Mark Bill as Paid",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Common Area UUID",
+                        "description": "Bill UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Start Date (ISO 8601 e.g. 2026-01-01T00:00:00Z)",
-                        "name": "from",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "End Date (ISO 8601)",
-                        "name": "to",
-                        "in": "query",
-                        "required": true
+                        "description": "Condominium id",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.MarkBillAsPaidRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Invalid JSON or Logic Error",
                         "schema": {
-                            "$ref": "#/definitions/api_controllers.GetAreaAvailabilityResponse"
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
-                    "400": {
-                        "description": "Invalid ID or Date format",
+                    "403": {
+                        "description": "Permission denied",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "409": {
+                        "description": "Bill already paid or cancelled",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "type": "objct"
+                        }
+                    },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
@@ -1288,14 +1344,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/common_areas": {
+        "/blocked_visitors": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Lists all common areas available in the condominium. Open to all members.",
+                "description": "Lists the active entries of the condominium blocklist. Expired and removed entries are omitted. Staff only.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1303,9 +1359,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Common Areas"
+                    "Blocked Visitors"
                 ],
-                "summary": "List Common Areas",
+                "summary": "List Blocked Visitors",
                 "parameters": [
                     {
                         "type": "string",
@@ -1319,26 +1375,42 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_controllers.ListCommonAreasResponse"
+                            "$ref": "#/definitions/api_controllers.ListBlockedVisitorsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid condominiumId",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
                     "403": {
-                        "description": "Forbidden",
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     }
                 }
-            }
-        },
-        "/condominiums": {
+            },
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Creates a new condominium and assigns the current user as admin.",
+                "description": "Adds a visitor to the condominium blocklist. Invites carrying a blocked plate are refused, invites for a blocked name are created with a warning, and blocked guests are refused at the gate while admins are alerted. Without expiresAt the block lasts until removed. Staff only.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1346,29 +1418,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Condominiums"
+                    "Blocked Visitors"
                 ],
-                "summary": "Create Condominium",
+                "summary": "Create Blocked Visitor",
                 "parameters": [
                     {
-                        "description": "Condominium creation payload",
+                        "description": "Blocklist entry",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api_controllers.CreateCondominiumRequest"
+                            "$ref": "#/definitions/api_controllers.CreateBlockedVisitorRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Condominium successfully created",
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/api_controllers.CreateCondominiumResponse"
+                            "$ref": "#/definitions/api_controllers.BlockedVisitorItem"
                         }
                     },
                     "400": {
-                        "description": "Invalid JSON payload",
+                        "description": "Invalid Payload, plate or expiration",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
@@ -1380,19 +1452,13 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "User does not have permission",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "CNPJ already registered",
+                        "description": "Permission denied",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
                     "422": {
-                        "description": "Validation failed",
+                        "description": "Validation Failed",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ValidationErrResponse"
                         }
@@ -1406,14 +1472,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/invites": {
-            "get": {
+        "/blocked_visitors/{id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a list of invites. Residents see only their apartment's invites. Staff can see all.",
+                "description": "Lifts a block. The entry is kept for history with who removed it. Admins and syndics only.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1421,94 +1487,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Invites"
+                    "Blocked Visitors"
                 ],
-                "summary": "List Invites",
+                "summary": "Remove Blocked Visitor",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Condominium UUID",
-                        "name": "condominiumId",
-                        "in": "query",
+                        "description": "Blocked visitor UUID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Apartment UUID (Required for residents)",
-                        "name": "apartmentId",
-                        "in": "query"
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "If true, returns only future/active invites",
-                        "name": "onlyActive",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page number (default 1)",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Items per page (default 20)",
-                        "name": "limit",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/api_controllers.ListInvitesResponse"
-                        }
-                    },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
-                        }
-                    }
-                }
-            },
-            "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Generates a new access invite (QR Code Token). Only residents of the specified apartment can create invites. Dates must be in ISO8601 format.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Invites"
-                ],
-                "summary": "Create Guest Invite",
-                "parameters": [
-                    {
-                        "description": "Invite Creation Data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/api_controllers.CreateInviteRequest"
-                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/api_controllers.CreateInviteResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
-                        "description": "Invalid Payload or Logic Error (e.g., End date before Start date)",
+                        "description": "Invalid ID",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
@@ -1520,23 +1516,17 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "Permission denied (User does not reside in this apartment)",
+                        "description": "Permission denied",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict (Condominium or Apartment not found)",
+                    "404": {
+                        "description": "Blocked visitor not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
-                    "422": {
-                        "description": "Validation Failed",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ValidationErrResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1546,14 +1536,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/invites/validate": {
-            "post": {
+        "/bookings": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Validates a visitor's QR code token. Checks if the token exists, belongs to the condominium, is within the valid time window, and has not been revoked. Records the entry in access logs upon success.",
+                "description": "Get a list of bookings. Admin can filter by user. Residents are forced to see their own history unless they use public availability endpoint.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1561,74 +1551,76 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Invites"
+                    "Bookings"
                 ],
-                "summary": "Validate Access Token (QR Code)",
+                "summary": "List Bookings",
                 "parameters": [
                     {
-                        "description": "Token Validation Data",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/api_controllers.ValidateInviteRequest"
-                        }
+                        "type": "string",
+                        "description": "Condominium UUID",
+                        "name": "condominiumId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by User UUID (Optional)",
+                        "name": "userId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by Common Area UUID (Optional)",
+                        "name": "commonAreaId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start Date (ISO 8601 e.g. 2026-01-01T00:00:00Z)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End Date (ISO 8601)",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_controllers.ValidateInviteResponse"
+                            "$ref": "#/definitions/api_controllers.ListBookingsResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid Payload",
+                        "description": "Invalid UUID or Date format",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
                     "401": {
-                        "description": "User not authenticated",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
-                        }
-                    },
-                    "403": {
-                        "description": "Business Rule Violation (Expired, Revoked, Not Started) or Permission Denied",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Invite/Token not found",
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
-                    "422": {
-                        "description": "Validation Failed",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ValidationErrResponse"
-                        }
-                    },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     }
                 }
-            }
-        },
-        "/invites/{id}/revoke": {
-            "patch": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Cancels an existing invite. Only the user who created the invite can revoke it.",
+                "description": "Create a reservation for a common area (e.g. Party Hall). Prevents double booking via locking: exclusive areas take one booking at a time, areas allowing overlapping bookings take them while the summed headcount fits the capacity. The area rules are checked in the same transaction: opening hours, minimum/maximum duration, how far ahead it can be booked, the cool-down between bookings and the monthly limit per apartment. Archived areas and blackout periods take no bookings.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1636,42 +1628,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Invites"
+                    "Bookings"
                 ],
-                "summary": "Revoke Invite",
+                "summary": "Book Common Area",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Invite UUID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Booking Data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.CreateBookingRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "401": {
-                        "description": "User not authenticated",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                            "$ref": "#/definitions/api_controllers.CreateBookingResponse"
                         }
                     },
-                    "403": {
-                        "description": "Permission denied",
+                    "400": {
+                        "description": "Invalid dates or headcount, outside opening hours, duration out of bounds or too far ahead",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
-                    "404": {
-                        "description": "Invite not found",
+                    "403": {
+                        "description": "Permission denied",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "409": {
+                        "description": "Time slot conflict or area at capacity (the resident can join the waitlist), cool-down or monthly limit reached, area closed by a blackout or archived",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
@@ -1679,24 +1670,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/packages": {
+        "/bookings/waitlist": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retrieve a list of packages. Admins can view all packages for a condominium. Residents must filter by their apartment ID to view their packages.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Lists the waitlist entries the user is still waiting on. Entries with status \"offered\" hold the range until offer_expires_at and can be accepted.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Packages"
+                    "Bookings"
                 ],
-                "summary": "List Packages",
+                "summary": "List Booking Waitlist",
                 "parameters": [
                     {
                         "type": "string",
@@ -1704,50 +1692,29 @@ const docTemplate = `{
                         "name": "condominiumId",
                         "in": "query",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Apartment UUID (Mandatory for Residents)",
-                        "name": "apartmentId",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter by status (pending, withdrawn)",
-                        "name": "status",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/usecases.PackageListItem"
-                            }
+                            "$ref": "#/definitions/api_controllers.ListBookingWaitlistResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid parameters",
+                        "description": "Invalid UUID",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
                     "401": {
-                        "description": "User not authenticated",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
-                        }
-                    },
-                    "403": {
-                        "description": "Permission denied",
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
@@ -1760,7 +1727,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Registers a new package arrival at the concierge. Triggers a push notification to the apartment residents.",
+                "description": "Joins the waitlist for a time range that is taken. When a booking in it is cancelled or denied, the range is offered to the waitlist in joining order and held for a limited time for the resident, who is notified and can accept it.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1768,17 +1735,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Packages"
+                    "Bookings"
                 ],
-                "summary": "Register new package",
+                "summary": "Join Booking Waitlist",
                 "parameters": [
                     {
-                        "description": "Package Creation Data",
+                        "description": "Waitlist Data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api_controllers.CreatePackageRequest"
+                            "$ref": "#/definitions/api_controllers.JoinBookingWaitlistRequest"
                         }
                     }
                 ],
@@ -1786,35 +1753,29 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/api_controllers.CreatePackageResponse"
+                            "$ref": "#/definitions/api_controllers.JoinBookingWaitlistResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid JSON payload",
+                        "description": "Invalid dates or headcount",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
-                    "401": {
-                        "description": "User not authenticated",
+                    "403": {
+                        "description": "Permission denied",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
                     "404": {
-                        "description": "Condominium or Apartment not found",
+                        "description": "Common area not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
-                    "422": {
-                        "description": "Validation failed",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ValidationErrResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
+                    "409": {
+                        "description": "Time range is available, closed by a blackout, or the apartment is already waiting for it",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
@@ -1822,66 +1783,66 @@ const docTemplate = `{
                 }
             }
         },
-        "/packages/{id}": {
-            "get": {
+        "/bookings/waitlist/{id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get detailed information about a package. Admins/Syndics can view any package; Residents can only view packages for their apartments.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Cancels a waiting entry or declines an open offer. A declined offer is passed on to the next entries in line.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Packages"
+                    "Bookings"
                 ],
-                "summary": "Get Package Details",
+                "summary": "Leave Booking Waitlist",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Package UUID",
+                        "description": "Waitlist entry UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/api_controllers.GetPackageResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
-                        "description": "Invalid ID format",
+                        "description": "Invalid ID",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
                     "401": {
-                        "description": "User not authenticated",
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
                     "403": {
-                        "description": "Permission denied",
+                        "description": "User is not a resident of the apartment",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
                     "404": {
-                        "description": "Package not found",
+                        "description": "Waitlist entry not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Entry is no longer active",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
@@ -1889,78 +1850,69 @@ const docTemplate = `{
                 }
             }
         },
-        "/packages/{id}/withdraw": {
-            "patch": {
+        "/bookings/waitlist/{id}/accept": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Marks a package as delivered/withdrawn. Can be performed by Condo Staff (for any package) or the Package Owner (Resident).",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Turns an open waitlist offer into a booking. The booking follows the area rules like any other: it is pending when the area requires approval, and billed right away otherwise.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Packages"
+                    "Bookings"
                 ],
-                "summary": "Withdraw Package",
+                "summary": "Accept Waitlist Offer",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Package UUID",
+                        "description": "Waitlist entry UUID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Condominium ID",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/api_controllers.WithdrawPackageRequest"
-                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.CreateBookingResponse"
+                        }
                     },
                     "400": {
-                        "description": "Invalid ID or Payload",
+                        "description": "Invalid ID, or the booking breaks the area rules",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
                     "401": {
-                        "description": "User not authenticated",
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
                     "403": {
-                        "description": "Permission denied",
+                        "description": "User is not a resident of the apartment",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
                     "404": {
-                        "description": "Package not found",
+                        "description": "Waitlist entry not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
                     "409": {
-                        "description": "Package already withdrawn",
+                        "description": "Offer expired, or the booking conflicts with the area limits",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
@@ -1968,57 +1920,69 @@ const docTemplate = `{
                 }
             }
         },
-        "/users": {
+        "/bookings/{id}/cancel": {
             "post": {
-                "description": "Creates an user and account with credentials",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Allows a resident of the apartment to cancel a pending or confirmed booking before it starts. Cancelling a confirmed booking after the area cancellation deadline is recorded as late and, when the area charges a late cancellation fee, a fine bill is created for the apartment.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Bookings"
                 ],
-                "summary": "Create User",
+                "summary": "Cancel Booking",
                 "parameters": [
                     {
-                        "description": "Signup Credentials",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/api_controllers.SignupRequest"
-                        }
+                        "type": "string",
+                        "description": "Booking UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created user successfully",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/api_controllers.SignupResponse"
+                            "$ref": "#/definitions/api_controllers.CancelBookingResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid JSON payload",
+                        "description": "Invalid ID",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
-                    "409": {
-                        "description": "Email already exists",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
-                    "422": {
-                        "description": "Validation failed",
+                    "403": {
+                        "description": "User is not a resident of the apartment",
                         "schema": {
-                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ValidationErrResponse"
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Booking not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Booking already started or cannot be cancelled",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
@@ -2026,93 +1990,87 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/apartments": {
-            "get": {
+        "/bookings/{id}/deposit/release": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all apartments belonging to the authenticated user. Optionally filter by condominium.",
+                "description": "Records that the paid security deposit of a booking was given back to the apartment, once the booking ended or was cancelled. Part of it can be retained to cover damages, with a reason. Only Admin/Syndic can perform this action.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Bookings"
                 ],
-                "summary": "List User Apartments",
+                "summary": "Release Booking Deposit",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Condominium UUID (Optional)",
-                        "name": "condominiumId",
-                        "in": "query"
+                        "description": "Booking UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Retained amount",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.ReleaseBookingDepositRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/api_controllers.UserApartmentResponse"
-                            }
+                            "$ref": "#/definitions/api_controllers.ReleaseBookingDepositResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid UUID format",
+                        "description": "Invalid ID, payload or retained amount",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
                     "401": {
-                        "description": "User not authenticated",
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "403": {
+                        "description": "Permission denied",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/users/condominiums": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Returns all condominiums the user is part of, sorted by role (Syndic \u003e Admin \u003e Resident).",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Users"
-                ],
-                "summary": "List User Condominiums",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "404": {
+                        "description": "Booking not found",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/usecases.UserCondominiumDTO"
-                            }
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
-                    "401": {
-                        "description": "User not authenticated",
+                    "409": {
+                        "description": "No deposit, deposit not paid, already released or booking not finished",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ValidationErrResponse"
+                        }
+                    },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
@@ -2120,13 +2078,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/devices": {
-            "post": {
+        "/bookings/{id}/status": {
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "Allows an Admin or Syndic to approve/deny a pending booking.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2134,84 +2093,63 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Notifications"
+                    "Bookings"
                 ],
-                "summary": "Register Device token",
+                "summary": "Approve or Reject Booking",
                 "parameters": [
                     {
-                        "description": "Register device payload",
+                        "type": "string",
+                        "description": "Booking UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New Status (confirmed/denied)",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api_controllers.RegisterDeviceReq"
+                            "$ref": "#/definitions/api_controllers.EditBookingRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Device registred successfully",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.SuccessResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
-                        "description": "Invalid JSON or Request not pending",
+                        "description": "Invalid Payload or ID",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
                     "401": {
-                        "description": "User not authenticated",
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
-                    "422": {
-                        "description": "Validation failed",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ValidationErrResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/users/me": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Retrieves detailed information about the currently logged-in user. Requires a valid Bearer Token.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Users"
-                ],
-                "summary": "Get Current User Profile",
-                "responses": {
-                    "200": {
-                        "description": "User profile details",
+                    "403": {
+                        "description": "Permission Denied",
                         "schema": {
-                            "$ref": "#/definitions/api_controllers.UserProfileResponse"
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized / Invalid Token",
+                    "404": {
+                        "description": "Booking Not Found",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
-                    "404": {
-                        "description": "User not found",
+                    "409": {
+                        "description": "Conflict (Booking not pending)",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
@@ -2219,9 +2157,105 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/signin": {
-            "post": {
-                "description": "Authenticates the user and returns the Access Token. Refresh Token is returned in the Body (Mobile) or HttpOnly Cookie (Web).",
+        "/calendar/{code}": {
+            "get": {
+                "description": "Public iCalendar feed opened from a feed URL. Does not require a Bearer token: the code carries a signature and stops working once the feed is revoked. Covers the bookings of the last 90 days onwards, with cancelled and denied bookings marked as cancelled. Times are in UTC and the condominium timezone is advertised through X-WR-TIMEZONE.",
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "Calendar"
+                ],
+                "summary": "Calendar Feed (ICS)",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Signed feed code, optionally ending in .ics",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "iCalendar document",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Calendar feed not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/calendar_feeds": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the user's active iCalendar feeds in a condominium with their subscription URLs.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Calendar"
+                ],
+                "summary": "List Calendar Feeds",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Condominium UUID",
+                        "name": "condominiumId",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.ListCalendarFeedsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid UUID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a secret iCalendar (ICS) URL that calendar apps (Google Calendar, Apple Calendar, Outlook) can subscribe to without a Bearer token. Scope \"user\" covers the user's own bookings; scope \"condominium\" covers every booking of the condominium and is only available to Admin/Syndic. The URL keeps working until the feed is revoked.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2229,25 +2263,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Calendar"
                 ],
-                "summary": "User Login",
+                "summary": "Create Calendar Feed",
                 "parameters": [
                     {
-                        "description": "Login Credentials",
+                        "description": "Feed data",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/api_controllers.SigninWithCredentialsRequest"
+                            "$ref": "#/definitions/api_controllers.CreateCalendarFeedRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Login successful",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/api_controllers.SigninResponse"
+                            "$ref": "#/definitions/api_controllers.CalendarFeedItem"
                         }
                     },
                     "400": {
@@ -2257,13 +2291,19 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "Invalid credentials",
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
                         }
                     },
                     "422": {
-                        "description": "Validation failed",
+                        "description": "Validation Failed",
                         "schema": {
                             "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ValidationErrResponse"
                         }
@@ -2276,50 +2316,5611 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "api_controllers.AccessRequestResponse": {
+        },
+        "/calendar_feeds/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revokes one of the user's iCalendar feeds. Its URL stops working right away; subscribed calendar apps stop receiving updates.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Calendar"
+                ],
+                "summary": "Revoke Calendar Feed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Calendar feed UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Calendar feed not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/common-areas": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a new bookable area (e.g., Gym, BBQ). Only Admin/Syndic can perform this action. Booking rules are optional: openingHours (one entry per weekday, 0 = Sunday, HH:MM in the condominium timezone; weekdays left out are closed, no entries means always open), min/max duration in minutes, how many days ahead it can be booked, the cool-down in minutes required between two bookings, the monthly limit of bookings per apartment, the cancellation policy (hours before the start a resident can still cancel and the fine in cents billed for late cancellations) and the pricing (price in cents per booking or per hour, following pricingUnit, plus an optional security deposit, both billed to the apartment when the booking is confirmed). Areas with allowOverlappingBookings (gym, pool) take overlapping bookings while their headcount fits the capacity, which is then required. With pendingExpiryHours, bookings still waiting for approval that many hours before they start are denied and their slot freed (pendingExpiryAction \"deny\", the default) or escalated to the admins and denied only if still pending when they start (\"escalate\").",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Common Areas"
+                ],
+                "summary": "Create Common Area",
+                "parameters": [
+                    {
+                        "description": "Common Area Data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.CreateCommonAreaRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.CreateCommonAreaResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict (Name already exists)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/common-areas/{id}/availability": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the bookings overlapping a date range (including the ones straddling its edges) the ranges held for waitlist offers (status \"held\"), the blackout periods closing the area and the free slots a new booking can use, at the requested granularity. Free slots respect the opening hours, the blackouts, the cool-down between bookings and the advance booking limit of the area; consecutive slots can be combined into one booking. On areas allowing overlapping bookings a slot is free while it has room for the headcount, and reports the remaining capacity. The range can cover at most 31 days. Open to all members.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Common Areas"
+                ],
+                "summary": "Get Area Availability",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Common Area UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start Date (ISO 8601 e.g. 2026-01-01T00:00:00Z)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End Date (ISO 8601)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Slot granularity in minutes (default 30)",
+                        "name": "slotMinutes",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "People the slots must have room for, on areas allowing overlapping bookings (default 1)",
+                        "name": "headcount",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.GetAreaAvailabilityResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID, date range or slot size",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not a member of the condominium",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Common area not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/common_areas": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists all common areas available in the condominium with their booking rules and opening hours (weekday 0 = Sunday, condominium timezone). Open to all members.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Common Areas"
+                ],
+                "summary": "List Common Areas",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Condominium UUID",
+                        "name": "condominiumId",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.ListCommonAreasResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/common_areas/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the name, capacity and approval requirement of a common area. Like the rules, they apply to new bookings: existing bookings are kept. Areas taking overlapping bookings must keep a capacity. Only Admin/Syndic can perform this action.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Common Areas"
+                ],
+                "summary": "Update Common Area",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Common Area UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Common area data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.UpdateCommonAreaRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.CommonAreaItem"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID or payload",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Common area not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ValidationErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deactivates a common area: it disappears from the listings and takes no new bookings, but its booking history is kept. Bookings not finished yet are cancelled along with their unpaid bills and the waitlist, and each affected user is notified. Only Admin/Syndic can perform this action.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Common Areas"
+                ],
+                "summary": "Archive Common Area",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Common Area UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.ArchiveCommonAreaResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Common area not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/common_areas/{id}/blackouts": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the current and upcoming blackout periods of a common area. Open to all members.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Common Areas"
+                ],
+                "summary": "List Common Area Blackouts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Common Area UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.ListCommonAreaBlackoutsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not a member of the condominium",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Common area not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Closes a common area for a period (maintenance, private events). Pending and confirmed bookings overlapping it are cancelled along with their unpaid bills and the waitlist for the period, and each affected user is notified. New bookings overlapping it are refused. Only Admin/Syndic can perform this action.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Common Areas"
+                ],
+                "summary": "Create Common Area Blackout",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Common Area UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Blackout period",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.CreateCommonAreaBlackoutRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.CreateCommonAreaBlackoutResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID, payload or period",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Common area not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ValidationErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/common_areas/{id}/blackouts/{blackoutId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Reopens a common area for bookings during a blackout. Bookings cancelled by the blackout stay cancelled. Only Admin/Syndic can perform this action.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Common Areas"
+                ],
+                "summary": "Delete Common Area Blackout",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Common Area UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Blackout UUID",
+                        "name": "blackoutId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Common area or blackout not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/common_areas/{id}/rules": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the whole rule set of a common area; omitted limits are disabled and an empty openingHours keeps the area always open. Existing bookings are kept, the rules apply to new bookings; only the pending expiry also covers bookings already waiting for approval. Only Admin/Syndic can perform this action.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Common Areas"
+                ],
+                "summary": "Update Common Area Rules",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Common Area UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Booking rules",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.BookingRulesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.CommonAreaItem"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID, payload or rules",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Common area not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ValidationErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/condominiums": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a new condominium and assigns the current user as admin. The timezone (IANA name, e.g. America/Sao_Paulo) is used to evaluate recurring invites and defaults to America/Sao_Paulo.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Condominiums"
+                ],
+                "summary": "Create Condominium",
+                "parameters": [
+                    {
+                        "description": "Condominium creation payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.CreateCondominiumRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Condominium successfully created",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.CreateCondominiumResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid JSON payload or timezone",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "User does not have permission",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "CNPJ already registered",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ValidationErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/files/{key}": {
+            "get": {
+                "description": "Serves a stored file. Does not require a Bearer token, but the URL must carry a valid, unexpired signature as returned by the API.",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "Files"
+                ],
+                "summary": "Download file",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Object key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Unix expiration timestamp",
+                        "name": "expires",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "URL signature",
+                        "name": "signature",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "403": {
+                        "description": "Invalid or expired signature",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "File not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/g/{code}": {
+            "get": {
+                "description": "Public guest page opened from a shared invite link. Does not require a Bearer token: the short code carries a signature. Returns HTML by default, or JSON when the client sends Accept: application/json or format=json. Only the guest name, unit and validity are exposed; qrCodePayload is the value accepted by POST /invites/validate.",
+                "produces": [
+                    "text/html",
+                    "application/json"
+                ],
+                "tags": [
+                    "Invites"
+                ],
+                "summary": "Public Invite Page",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Signed invite code",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Set to json to force a JSON response",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.PublicInviteResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Invalid link",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Invite not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/invites": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a list of invites. Residents see only their apartment's invites. Staff can see all.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Invites"
+                ],
+                "summary": "List Invites",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Condominium UUID",
+                        "name": "condominiumId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Apartment UUID (Required for residents)",
+                        "name": "apartmentId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "If true, returns only future/active invites",
+                        "name": "onlyActive",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number (default 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page (default 20)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.ListInvitesResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Generates a new access invite (QR Code Token). Only residents of the specified apartment can create invites. Dates must be in ISO8601 format. For recurring visitors (cleaners, nannies, trainers) send weekdays (0 = Sunday ... 6 = Saturday) with dailyStartTime/dailyEndTime (HH:MM, condominium local time); startsAt/endsAt then bound the whole validity period. maxEntries limits how many times the QR code can be used (deliveries are single-use unless informed). guestPlate lets the guest in by plate at the vehicle gate. Invites whose plate is on the condominium blocklist are refused; when only the guest name matches, the invite is created with a warning, since the gate will refuse the guest while the entry is active.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Invites"
+                ],
+                "summary": "Create Guest Invite",
+                "parameters": [
+                    {
+                        "description": "Invite Creation Data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.CreateInviteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.CreateInviteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid Payload or Logic Error (e.g., End date before Start date, invalid recurrence)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied (User does not reside in this apartment) or guest plate blocked",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict (Condominium or Apartment not found)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ValidationErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/invites/validate": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Validates a visitor's QR code token. Checks if the token exists, belongs to the condominium, is within the valid time window, and has not been revoked. Recurring invites are also checked against their weekdays and daily window in the condominium's timezone. Invites with an entry limit are refused once all entries were used; the response carries the remaining entries. Guests matching the condominium blocklist (by name or plate) are refused and the condominium admins are alerted. Records the entry in access logs upon success.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Invites"
+                ],
+                "summary": "Validate Access Token (QR Code)",
+                "parameters": [
+                    {
+                        "description": "Token Validation Data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.ValidateInviteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.ValidateInviteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid Payload",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Business Rule Violation (Expired, Revoked, Not Started, Outside Schedule, Exhausted), blocked visitor or Permission Denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Invite/Token not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ValidationErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/invites/{id}/offline_token": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Issues a compact Ed25519 signed token (JWT, alg EdDSA) carrying the invite ID (sub), condominium (cid), validity window (nbf/exp), entry limit (max) and recurrence (wd/ds/de/tz). Gate devices verify it offline against GET /.well-known/jwks.json and upload the entries later through POST /access_logs/sync. Revocations only reach devices when they are online, so revoked and expired invites get no token. Available to the issuer, residents of the apartment and condominium staff.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Invites"
+                ],
+                "summary": "Get Invite Offline Token",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Invite UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.GetInviteOfflineTokenResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID or invite revoked/expired",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Invite not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/invites/{id}/qrcode": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Renders the invite token as a QR code image. By default the payload is the bare token, so the doorman app can send the scanned value straight to POST /invites/validate. With payload=signed it carries the offline token gate devices verify against the published key set (revoked and expired invites have none). Available to the issuer, residents of the apartment and condominium staff.",
+                "produces": [
+                    "image/png",
+                    "image/svg+xml"
+                ],
+                "tags": [
+                    "Invites"
+                ],
+                "summary": "Get Invite QR Code",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Invite UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Image format: png (default) or svg",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Image size in pixels (128-2048, default 512)",
+                        "name": "size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "QR code content: token (default) or signed",
+                        "name": "payload",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID, format or payload, or invite revoked/expired (signed payload)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Invite not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/invites/{id}/revoke": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Cancels an existing invite. Only the user who created the invite can revoke it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Invites"
+                ],
+                "summary": "Revoke Invite",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Invite UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Invite not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/invites/{id}/share": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a signed short link that can be sent to the guest. The link opens a public page (or JSON with Accept: application/json) with the guest name, unit and validity, plus the QR code to show at the gate. It stops working when the invite expires or is revoked.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Invites"
+                ],
+                "summary": "Share Invite",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Invite UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.ShareInviteResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID, invite revoked or expired",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Invite not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/packages": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieve a list of packages. Condominium staff can view and search all packages for a condominium. Residents must filter by their apartment ID to view their packages.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Packages"
+                ],
+                "summary": "List Packages",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Condominium UUID",
+                        "name": "condominiumId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Apartment UUID (Mandatory for Residents)",
+                        "name": "apartmentId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by status (pending, withdrawn, returned_to_sender)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort order: received_at (default, newest first) or days_waiting (longest waiting first)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by carrier (staff only)",
+                        "name": "carrier",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search by tracking code, partial match (staff only)",
+                        "name": "trackingCode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by size (envelope, small, medium, large, extra_large) (staff only)",
+                        "name": "sizeCategory",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter perishable packages (staff only)",
+                        "name": "isPerishable",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter fragile packages (staff only)",
+                        "name": "isFragile",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by shelf/locker location (staff only)",
+                        "name": "storageLocation",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/usecases.PackageListItem"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Registers a new package arrival at the concierge. Triggers a push notification to the apartment residents. If the tracking code was already registered in the condominium the package is still created, but a warning and the previous package IDs are returned.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Packages"
+                ],
+                "summary": "Register new package",
+                "parameters": [
+                    {
+                        "description": "Package Creation Data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.CreatePackageRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.CreatePackageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid JSON payload",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Condominium or Apartment not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ValidationErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/packages/batch": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Registers up to 100 packages in a single transaction. Each item is reported individually: items with an unknown apartment or invalid size are skipped and returned with an error, the others are created. Residents receive a single push per apartment (e.g. \"3 encomendas chegaram\") instead of one per package. Returns 422 when no item could be created.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Packages"
+                ],
+                "summary": "Register packages in batch",
+                "parameters": [
+                    {
+                        "description": "Packages to register",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.CreatePackagesBatchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.CreatePackagesBatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid JSON payload",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed or no package created",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.CreatePackagesBatchResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/packages/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get detailed information about a package. Admins/Syndics can view any package; Residents can only view packages for their apartments.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Packages"
+                ],
+                "summary": "Get Package Details",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Package UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.GetPackageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Package not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/packages/{id}/photo": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns signed URLs for the package photo and its thumbnail. Only staff and residents of the package apartment can fetch them. URLs expire after a few minutes.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Packages"
+                ],
+                "summary": "Get package photo URLs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Package UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.PackagePhoto"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Package or photo not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Uploads the photo taken at the concierge as multipart/form-data (field \"photo\"). Only JPEG and PNG are accepted; a thumbnail is generated and any previous photo is replaced. Only condominium staff can perform this action.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Packages"
+                ],
+                "summary": "Upload package photo",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Package UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Package photo",
+                        "name": "photo",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.PackagePhoto"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID or missing file",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Package not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "File too large",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported file type",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/packages/{id}/pickup_code": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Generates a new pickup code, clears failed attempts and unlocks the package. The new code is pushed to the apartment residents. Only Admin/Syndic can perform this action.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Packages"
+                ],
+                "summary": "Reset package pickup code",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Package UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Package not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Package already withdrawn or returned",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/packages/{id}/release": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Called by the doorman when the resident is physically at the desk and presents the pickup code. Opens a short window in which the resident can confirm the pickup from the app.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Packages"
+                ],
+                "summary": "Release package at the desk",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Package UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Pickup code",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.ReleasePackageRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.ReleasePackageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID or Payload",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Package not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Package already withdrawn or returned",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Missing or invalid pickup code",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "Pickup code locked",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/packages/{id}/return": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Marks a pending package as returned to sender, usually after it exceeded the storage limit. Residents of the apartment are notified. Only condominium staff can perform this action.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Packages"
+                ],
+                "summary": "Return package to sender",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Package UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Package not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Package already withdrawn or returned",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/packages/{id}/withdraw": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Marks a package as delivered/withdrawn. Staff must inform the pickup code sent to the residents (the package is locked after 5 invalid attempts). Residents can only confirm the pickup while the package is released at the desk. When a third party collects the package, staff informs their name and document; without a pickup code, the document must match an active pickup authorization from the residents.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Packages"
+                ],
+                "summary": "Withdraw Package",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Package UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Condominium ID and pickup code",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.WithdrawPackageRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Invalid ID or Payload",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied, package not released at the desk or no pickup authorization",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Package not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Package already withdrawn or returned",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Missing or invalid pickup code",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "423": {
+                        "description": "Pickup code locked",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pickup_authorizations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the third parties authorized to collect packages of an apartment. Available to residents of the apartment and condominium staff.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Packages"
+                ],
+                "summary": "List Pickup Authorizations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Apartment UUID",
+                        "name": "apartmentId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "If true, returns only authorizations currently valid and not revoked",
+                        "name": "onlyActive",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.ListPickupAuthorizationsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid apartmentId",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Apartment not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Allows a resident to authorize a third party (family member, neighbour, driver) to collect packages at the desk. The authorization can target a single package or every package of the apartment during the validity period.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Packages"
+                ],
+                "summary": "Create Pickup Authorization",
+                "parameters": [
+                    {
+                        "description": "Authorization data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.CreatePickupAuthorizationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.CreatePickupAuthorizationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid Payload or validity period",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied (User does not reside in this apartment)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Apartment or package not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Package already withdrawn",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ValidationErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pickup_authorizations/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revokes a third party pickup authorization. Residents of the apartment and condominium admins can revoke it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Packages"
+                ],
+                "summary": "Revoke Pickup Authorization",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pickup authorization UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Pickup authorization not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Pickup authorization already revoked",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/polls": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a paginated list of polls for a condominium. Available to residents and staff.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Polls"
+                ],
+                "summary": "List Polls",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Condominium UUID",
+                        "name": "condominiumId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "If true, returns only polls currently open for voting",
+                        "name": "onlyOpen",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number (default 1)",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page (default 20)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.ListPollsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid parameters",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a poll for the condominium. Only Admin/Syndic can perform this action. Votes are counted once per apartment, optionally weighted by the apartment voting weight. Residents are notified when the poll opens.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Polls"
+                ],
+                "summary": "Create Poll",
+                "parameters": [
+                    {
+                        "description": "Poll Data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.CreatePollRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.CreatePollResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid payload or period",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ValidationErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/polls/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a poll with its options. Results are included when the poll shows them live, when it is closed, or when the user is an Admin/Syndic. Voters are only listed for named polls.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Polls"
+                ],
+                "summary": "Get Poll",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Poll UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/usecases.PollDetails"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Poll not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/polls/{id}/close": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Closes a poll immediately. Only Admin/Syndic can perform this action. Residents are notified that the results are available.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Polls"
+                ],
+                "summary": "Close Poll",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Poll UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Poll not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Poll already closed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/polls/{id}/votes": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Registers the ballot of an apartment. Each apartment votes once; any resident of the apartment may cast the vote.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Polls"
+                ],
+                "summary": "Vote in Poll",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Poll UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Ballot",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.VotePollRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Invalid payload or options",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "User is not a resident of the apartment",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Poll or apartment not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Apartment already voted or poll not open",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ValidationErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "post": {
+                "description": "Creates an user and account with credentials",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Create User",
+                "parameters": [
+                    {
+                        "description": "Signup Credentials",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.SignupRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created user successfully",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.SignupResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid JSON payload",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Email already exists",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ValidationErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/apartments": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all apartments belonging to the authenticated user. Optionally filter by condominium.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "List User Apartments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Condominium UUID (Optional)",
+                        "name": "condominiumId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/api_controllers.UserApartmentResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid UUID format",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/condominiums": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns all condominiums the user is part of, sorted by role (Syndic \u003e Admin \u003e Resident).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "List User Condominiums",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/usecases.UserCondominiumDTO"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/devices": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Register Device token",
+                "parameters": [
+                    {
+                        "description": "Register device payload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.RegisterDeviceReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Device registred successfully",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid JSON or Request not pending",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ValidationErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retrieves detailed information about the currently logged-in user. Requires a valid Bearer Token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get Current User Profile",
+                "responses": {
+                    "200": {
+                        "description": "User profile details",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.UserProfileResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized / Invalid Token",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/signin": {
+            "post": {
+                "description": "Authenticates the user and returns the Access Token. Refresh Token is returned in the Body (Mobile) or HttpOnly Cookie (Web).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "User Login",
+                "parameters": [
+                    {
+                        "description": "Login Credentials",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.SigninWithCredentialsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Login successful",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.SigninResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid JSON payload",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid credentials",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ValidationErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/vehicles": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists the vehicles registered for an apartment. Available to residents of the apartment and condominium staff.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Vehicles"
+                ],
+                "summary": "List Vehicles",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Apartment UUID",
+                        "name": "apartmentId",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.ListVehiclesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid apartmentId",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Apartment not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Registers a resident vehicle so it can enter by plate. Plates are accepted in the legacy (ABC-1234) or Mercosul (ABC1D23) format; separators are ignored. Available to residents of the apartment and condominium staff.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Vehicles"
+                ],
+                "summary": "Create Vehicle",
+                "parameters": [
+                    {
+                        "description": "Vehicle data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.CreateVehicleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.VehicleItem"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid Payload or plate",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Apartment not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Plate already registered",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ValidationErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/vehicles/lookup": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Shows the resident vehicle registered with a plate and the active invites carrying it. allowedNow tells whether the invite schedule lets the guest in at this moment. Legacy and Mercosul forms of the same plate match each other. Staff only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Vehicles"
+                ],
+                "summary": "Lookup Plate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Condominium UUID",
+                        "name": "condominiumId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Plate (ABC1234 or ABC1D23)",
+                        "name": "plate",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.LookupPlateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid condominiumId or plate",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/vehicles/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes a resident vehicle, so its plate no longer opens the gate. Past entries keep referencing it. Available to residents of the apartment and condominium staff.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Vehicles"
+                ],
+                "summary": "Remove Vehicle",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Vehicle UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Vehicle not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/visitor_requests": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lists walk-in visitor requests created in the last 24 hours, newest first. Staff only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Visitors"
+                ],
+                "summary": "List Visitor Requests",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Condominium UUID",
+                        "name": "condominiumId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Status (pending, approved, denied, expired)",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.ListVisitorRequestsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid condominiumId",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Registers a visitor that arrived without an invite, as multipart/form-data. Residents of the apartment get a push to approve or deny; unanswered requests expire after a few minutes. The photo is optional and must be JPEG or PNG. Visitors whose name or document is on the condominium blocklist are refused and the staff warned. Staff only.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Visitors"
+                ],
+                "summary": "Register Walk-in Visitor",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Apartment UUID",
+                        "name": "apartmentId",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Visitor name",
+                        "name": "visitorName",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Visitor document",
+                        "name": "visitorDocument",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Visitor photo",
+                        "name": "photo",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.VisitorRequestItem"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid payload",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied or visitor blocked",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Apartment not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "File too large",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported file type",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/visitor_requests/events": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Server-Sent Events stream with the walk-in requests of a condominium. Each event is named visitor_request.created or visitor_request.updated and carries the request as JSON (photoUrl is not signed, fetch the request to get it). Staff only.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "Visitors"
+                ],
+                "summary": "Watch Visitor Requests",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Condominium UUID",
+                        "name": "condominiumId",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Event payload",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.VisitorRequestItem"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid condominiumId",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/visitor_requests/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a walk-in visitor request with a short-lived photo URL. Available to staff and residents of the target apartment, so the app can show who is at the door.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Visitors"
+                ],
+                "summary": "Get Visitor Request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Visitor request UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.VisitorRequestItem"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID format",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Visitor request not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/visitor_requests/{id}/decision": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lets a resident of the target apartment answer a walk-in request. Approving registers the entry in the access log. Visitors blocked in the meantime cannot be approved. The front desk is updated live.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Visitors"
+                ],
+                "summary": "Approve or Deny Visitor",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Visitor request UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Decision (approve/deny)",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.RespondVisitorRequestRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/api_controllers.VisitorRequestItem"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid Payload or ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "User not authenticated",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied or visitor blocked",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Visitor request not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Already answered",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "410": {
+                        "description": "Request expired",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation Failed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ValidationErrResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_api_common.ErrResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "api_controllers.AccessLogItem": {
+            "type": "object",
+            "properties": {
+                "apartmentId": {
+                    "type": "string"
+                },
+                "apartmentNumber": {
+                    "type": "string"
+                },
+                "authorizedBy": {
+                    "type": "string"
+                },
+                "block": {
+                    "type": "string"
+                },
+                "enteredAt": {
+                    "type": "string"
+                },
+                "exitRegisteredBy": {
+                    "type": "string"
+                },
+                "exitedAt": {
+                    "type": "string"
+                },
+                "guestName": {
+                    "type": "string"
+                },
+                "guestType": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "inviteId": {
+                    "type": "string"
+                },
+                "plate": {
+                    "type": "string"
+                },
+                "syncedAt": {
+                    "description": "SyncedAt is set for entries a gate device recorded offline and uploaded later.",
+                    "type": "string"
+                },
+                "vehicleId": {
+                    "type": "string"
+                },
+                "visitorRequestId": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.AccessRequestResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "userEmail": {
+                    "type": "string"
+                },
+                "userName": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.AnnouncementResponse": {
+            "type": "object",
+            "properties": {
+                "authorName": {
+                    "type": "string"
+                },
+                "content": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.ApproveAccessRequestReq": {
+            "type": "object",
+            "required": [
+                "accessRequestId"
+            ],
+            "properties": {
+                "accessRequestId": {
+                    "type": "string"
+                },
+                "isResponsible": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "api_controllers.ApproveAccessRequestResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.ArchiveCommonAreaResponse": {
+            "type": "object",
+            "properties": {
+                "cancelledBookings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_store_pgstore.Booking"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.BlockedVisitorItem": {
+            "type": "object",
+            "properties": {
+                "condominiumId": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "createdBy": {
+                    "type": "string"
+                },
+                "document": {
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "plate": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.BookingRulesRequest": {
+            "type": "object",
+            "properties": {
+                "allowOverlappingBookings": {
+                    "description": "Takes overlapping bookings while their headcount fits the capacity (gym, pool).",
+                    "type": "boolean"
+                },
+                "cancellationDeadlineHours": {
+                    "description": "Hours before the start after which a cancellation is late, and the fine billed for it.",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "cooldownMinutes": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "depositCents": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "lateCancellationFeeCents": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "maxAdvanceDays": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "maxBookingsPerMonth": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "maxDurationMinutes": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "minDurationMinutes": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "openingHours": {
+                    "type": "array",
+                    "maxItems": 7,
+                    "items": {
+                        "$ref": "#/definitions/api_controllers.OpeningHoursRequest"
+                    }
+                },
+                "pendingExpiryAction": {
+                    "type": "string",
+                    "enum": [
+                        "deny",
+                        "escalate"
+                    ]
+                },
+                "pendingExpiryHours": {
+                    "description": "Hours before the start at which a booking still waiting for approval is denied or escalated\nto the admins.",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "priceCents": {
+                    "description": "Price charged per booking or per hour of use, and the security deposit billed apart.",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "pricingUnit": {
+                    "type": "string",
+                    "enum": [
+                        "booking",
+                        "hour"
+                    ]
+                }
+            }
+        },
+        "api_controllers.CalendarFeedItem": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "scope": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.CancelBillRequest": {
+            "type": "object",
+            "required": [
+                "condominiumId"
+            ],
+            "properties": {
+                "condominiumId": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.CancelBookingResponse": {
+            "type": "object",
+            "properties": {
+                "booking": {
+                    "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_store_pgstore.Booking"
+                },
+                "fineBill": {
+                    "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_store_pgstore.Bill"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.CommonAreaItem": {
+            "type": "object",
+            "properties": {
+                "allow_overlapping_bookings": {
+                    "type": "boolean"
+                },
+                "archived_at": {
+                    "type": "string"
+                },
+                "cancellation_deadline_hours": {
+                    "type": "integer"
+                },
+                "capacity": {
+                    "type": "integer"
+                },
+                "condominium_id": {
+                    "type": "string"
+                },
+                "cooldown_minutes": {
+                    "type": "integer"
+                },
+                "deposit_cents": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "late_cancellation_fee_cents": {
+                    "type": "integer"
+                },
+                "max_advance_days": {
+                    "type": "integer"
+                },
+                "max_bookings_per_month": {
+                    "type": "integer"
+                },
+                "max_duration_minutes": {
+                    "type": "integer"
+                },
+                "min_duration_minutes": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "opening_hours": {
+                    "description": "OpeningHours is empty when the area is always open.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_controllers.OpeningHoursItem"
+                    }
+                },
+                "pending_expiry_action": {
+                    "type": "string"
+                },
+                "pending_expiry_hours": {
+                    "type": "integer"
+                },
+                "price_cents": {
+                    "type": "integer"
+                },
+                "pricing_unit": {
+                    "type": "string"
+                },
+                "requires_approval": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "api_controllers.CreateAccessRequestReq": {
+            "type": "object",
+            "required": [
+                "apartmentId",
+                "type",
+                "userId"
+            ],
+            "properties": {
+                "apartmentId": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "owner",
+                        "tenant",
+                        "dependent"
+                    ]
+                },
+                "userId": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.CreateAccessRequestResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "requestId": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.CreateAnnouncementRequest": {
+            "type": "object",
+            "required": [
+                "condominiumId",
+                "content",
+                "title"
+            ],
+            "properties": {
+                "condominiumId": {
+                    "type": "string"
+                },
+                "content": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.CreateApartmentRequest": {
+            "type": "object",
+            "required": [
+                "condominiumId",
+                "number"
+            ],
+            "properties": {
+                "block": {
+                    "type": "string"
+                },
+                "condominiumId": {
+                    "type": "string"
+                },
+                "number": {
+                    "type": "string",
+                    "minLength": 1
+                },
+                "votingWeight": {
+                    "type": "number"
+                }
+            }
+        },
+        "api_controllers.CreateApartmentResponse": {
+            "type": "object",
+            "properties": {
+                "apartmentId": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.CreateBillRequest": {
+            "type": "object",
+            "required": [
+                "apartmentId",
+                "billType",
+                "condominiumId",
+                "dueDate",
+                "valueInCents"
+            ],
+            "properties": {
+                "apartmentId": {
+                    "type": "string"
+                },
+                "billType": {
+                    "type": "string",
+                    "enum": [
+                        "rent",
+                        "condominium_fee",
+                        "water",
+                        "electricity",
+                        "gas",
+                        "fine"
+                    ]
+                },
+                "condominiumId": {
+                    "type": "string"
+                },
+                "digitableLine": {
+                    "type": "string"
+                },
+                "dueDate": {
+                    "type": "string"
+                },
+                "pixCode": {
+                    "type": "string"
+                },
+                "valueInCents": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "api_controllers.CreateBillResponse": {
+            "type": "object",
+            "properties": {
+                "apartmentId": {
+                    "type": "string"
+                },
+                "billType": {
+                    "type": "string"
+                },
+                "condominiumId": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "digitableLine": {
+                    "type": "string"
+                },
+                "dueDate": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "paidAt": {
+                    "type": "string"
+                },
+                "pixCode": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "valueInCents": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api_controllers.CreateBlockedVisitorRequest": {
+            "type": "object",
+            "required": [
+                "condominiumId",
+                "name",
+                "reason"
+            ],
+            "properties": {
+                "condominiumId": {
+                    "type": "string"
+                },
+                "document": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "plate": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.CreateBookingRequest": {
+            "type": "object",
+            "required": [
+                "apartmentId",
+                "commonAreaId",
+                "condominiumId",
+                "endsAt",
+                "startsAt"
+            ],
+            "properties": {
+                "apartmentId": {
+                    "type": "string"
+                },
+                "commonAreaId": {
+                    "type": "string"
+                },
+                "condominiumId": {
+                    "type": "string"
+                },
+                "endsAt": {
+                    "type": "string"
+                },
+                "headcount": {
+                    "description": "Headcount is how many people the booking covers (defaults to 1).",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "startsAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.CreateBookingResponse": {
+            "type": "object",
+            "properties": {
+                "booking": {
+                    "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_store_pgstore.Booking"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.CreateCalendarFeedRequest": {
+            "type": "object",
+            "required": [
+                "condominiumId",
+                "scope"
+            ],
+            "properties": {
+                "condominiumId": {
+                    "type": "string"
+                },
+                "scope": {
+                    "type": "string",
+                    "enum": [
+                        "user",
+                        "condominium"
+                    ]
+                }
+            }
+        },
+        "api_controllers.CreateCommonAreaBlackoutRequest": {
+            "type": "object",
+            "required": [
+                "endsAt",
+                "startsAt"
+            ],
+            "properties": {
+                "endsAt": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "startsAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.CreateCommonAreaBlackoutResponse": {
+            "type": "object",
+            "properties": {
+                "blackout": {
+                    "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_store_pgstore.CommonAreaBlackout"
+                },
+                "cancelledBookings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_store_pgstore.Booking"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.CreateCommonAreaRequest": {
+            "type": "object",
+            "required": [
+                "condominiumId",
+                "name"
+            ],
+            "properties": {
+                "allowOverlappingBookings": {
+                    "description": "Takes overlapping bookings while their headcount fits the capacity (gym, pool).",
+                    "type": "boolean"
+                },
+                "cancellationDeadlineHours": {
+                    "description": "Hours before the start after which a cancellation is late, and the fine billed for it.",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "capacity": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "condominiumId": {
+                    "type": "string"
+                },
+                "cooldownMinutes": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "depositCents": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "lateCancellationFeeCents": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "maxAdvanceDays": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "maxBookingsPerMonth": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "maxDurationMinutes": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "minDurationMinutes": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "name": {
+                    "type": "string",
+                    "minLength": 3
+                },
+                "openingHours": {
+                    "type": "array",
+                    "maxItems": 7,
+                    "items": {
+                        "$ref": "#/definitions/api_controllers.OpeningHoursRequest"
+                    }
+                },
+                "pendingExpiryAction": {
+                    "type": "string",
+                    "enum": [
+                        "deny",
+                        "escalate"
+                    ]
+                },
+                "pendingExpiryHours": {
+                    "description": "Hours before the start at which a booking still waiting for approval is denied or escalated\nto the admins.",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "priceCents": {
+                    "description": "Price charged per booking or per hour of use, and the security deposit billed apart.",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "pricingUnit": {
+                    "type": "string",
+                    "enum": [
+                        "booking",
+                        "hour"
+                    ]
+                },
+                "requiredApproval": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "api_controllers.CreateCommonAreaResponse": {
+            "type": "object",
+            "properties": {
+                "commonArea": {
+                    "$ref": "#/definitions/api_controllers.CommonAreaItem"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.CreateCondominiumRequest": {
+            "type": "object",
+            "required": [
+                "address",
+                "cnpj",
+                "name",
+                "planType"
+            ],
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "cnpj": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "planType": {
+                    "type": "string",
+                    "enum": [
+                        "basic",
+                        "pro",
+                        "enterprise"
+                    ]
+                },
+                "timezone": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.CreateCondominiumResponse": {
+            "type": "object",
+            "properties": {
+                "condominiumId": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.CreateInviteRequest": {
+            "type": "object",
+            "required": [
+                "apartmentId",
+                "condominiumId",
+                "endsAt",
+                "guestName",
+                "startsAt"
+            ],
+            "properties": {
+                "apartmentId": {
+                    "type": "string"
+                },
+                "condominiumId": {
+                    "type": "string"
+                },
+                "dailyEndTime": {
+                    "type": "string"
+                },
+                "dailyStartTime": {
+                    "type": "string"
+                },
+                "endsAt": {
+                    "type": "string"
+                },
+                "guestName": {
+                    "type": "string",
+                    "minLength": 1
+                },
+                "guestPlate": {
+                    "type": "string"
+                },
+                "guestType": {
+                    "type": "string",
+                    "enum": [
+                        "guest",
+                        "service",
+                        "delivery"
+                    ]
+                },
+                "maxEntries": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "startsAt": {
+                    "type": "string"
+                },
+                "weekdays": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "api_controllers.CreateInviteResponse": {
+            "type": "object",
+            "properties": {
+                "invite": {
+                    "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_store_pgstore.Invite"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "warning": {
+                    "description": "Warning is set when the guest name matches the condominium blocklist.",
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.CreatePackageRequest": {
+            "type": "object",
+            "required": [
+                "apartmentId",
+                "condominiumId"
+            ],
+            "properties": {
+                "apartmentId": {
+                    "type": "string"
+                },
+                "carrier": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "condominiumId": {
+                    "type": "string"
+                },
+                "isFragile": {
+                    "type": "boolean"
+                },
+                "isPerishable": {
+                    "type": "boolean"
+                },
+                "photoUrl": {
+                    "type": "string"
+                },
+                "recipientName": {
+                    "type": "string"
+                },
+                "sizeCategory": {
+                    "type": "string",
+                    "enum": [
+                        "envelope",
+                        "small",
+                        "medium",
+                        "large",
+                        "extra_large"
+                    ]
+                },
+                "storageLocation": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "trackingCode": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "api_controllers.CreatePackageResponse": {
+            "type": "object",
+            "properties": {
+                "duplicateOf": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "packageId": {
+                    "type": "string"
+                },
+                "warning": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.CreatePackagesBatchItem": {
+            "type": "object",
+            "required": [
+                "apartmentId"
+            ],
+            "properties": {
+                "apartmentId": {
+                    "type": "string"
+                },
+                "carrier": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "isFragile": {
+                    "type": "boolean"
+                },
+                "isPerishable": {
+                    "type": "boolean"
+                },
+                "photoUrl": {
+                    "type": "string"
+                },
+                "recipientName": {
+                    "type": "string"
+                },
+                "sizeCategory": {
+                    "type": "string",
+                    "enum": [
+                        "envelope",
+                        "small",
+                        "medium",
+                        "large",
+                        "extra_large"
+                    ]
+                },
+                "storageLocation": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "trackingCode": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "api_controllers.CreatePackagesBatchItemResponse": {
+            "type": "object",
+            "properties": {
+                "duplicateOf": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "error": {
+                    "type": "string"
+                },
+                "index": {
+                    "type": "integer"
+                },
+                "packageId": {
+                    "type": "string"
+                },
+                "warning": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.CreatePackagesBatchRequest": {
+            "type": "object",
+            "required": [
+                "condominiumId",
+                "items"
+            ],
+            "properties": {
+                "condominiumId": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/api_controllers.CreatePackagesBatchItem"
+                    }
+                }
+            }
+        },
+        "api_controllers.CreatePackagesBatchResponse": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_controllers.CreatePackagesBatchItemResponse"
+                    }
+                }
+            }
+        },
+        "api_controllers.CreatePickupAuthorizationRequest": {
+            "type": "object",
+            "required": [
+                "apartmentId",
+                "document",
+                "name",
+                "validUntil"
+            ],
+            "properties": {
+                "apartmentId": {
+                    "type": "string"
+                },
+                "document": {
+                    "type": "string",
+                    "minLength": 1
+                },
+                "name": {
+                    "type": "string",
+                    "minLength": 1
+                },
+                "packageId": {
+                    "type": "string"
+                },
+                "validFrom": {
+                    "type": "string"
+                },
+                "validUntil": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.CreatePickupAuthorizationResponse": {
+            "type": "object",
+            "properties": {
+                "authorization": {
+                    "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_store_pgstore.PackagePickupAuthorization"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.CreatePollRequest": {
+            "type": "object",
+            "required": [
+                "closesAt",
+                "condominiumId",
+                "options",
+                "title"
+            ],
+            "properties": {
+                "choiceType": {
+                    "type": "string",
+                    "enum": [
+                        "single",
+                        "multiple"
+                    ]
+                },
+                "closesAt": {
+                    "type": "string"
+                },
+                "condominiumId": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "isAnonymous": {
+                    "type": "boolean"
+                },
+                "isWeighted": {
+                    "type": "boolean"
+                },
+                "opensAt": {
+                    "type": "string"
+                },
+                "options": {
+                    "type": "array",
+                    "minItems": 2,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "resultsVisibility": {
+                    "type": "string",
+                    "enum": [
+                        "live",
+                        "after_close"
+                    ]
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 150,
+                    "minLength": 3
+                }
+            }
+        },
+        "api_controllers.CreatePollResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "poll": {
+                    "$ref": "#/definitions/usecases.PollDetails"
+                }
+            }
+        },
+        "api_controllers.CreateVehicleRequest": {
+            "type": "object",
+            "required": [
+                "apartmentId",
+                "color",
+                "model",
+                "plate"
+            ],
+            "properties": {
+                "apartmentId": {
+                    "type": "string"
+                },
+                "color": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "model": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "plate": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.EditBookingRequest": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "confirmed",
+                        "denied"
+                    ]
+                }
+            }
+        },
+        "api_controllers.GetAreaAvailabilityResponse": {
+            "type": "object",
+            "properties": {
+                "blackouts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_store_pgstore.CommonAreaBlackout"
+                    }
+                },
+                "bookings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_store_pgstore.GetAreaAvailabilityRow"
+                    }
+                },
+                "freeSlots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_controllers.TimeSlotItem"
+                    }
+                },
+                "slotMinutes": {
+                    "type": "integer"
+                },
+                "timezone": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.GetInviteOfflineTokenResponse": {
+            "type": "object",
+            "properties": {
+                "expiresAt": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.GetPackageResponse": {
+            "type": "object",
+            "properties": {
+                "apartmentId": {
+                    "type": "string"
+                },
+                "apartmentNumber": {
+                    "type": "string"
+                },
+                "block": {
+                    "type": "string"
+                },
+                "carrier": {
+                    "type": "string"
+                },
+                "collectedByDocument": {
+                    "type": "string"
+                },
+                "collectedByName": {
+                    "type": "string"
+                },
+                "condominiumId": {
+                    "type": "string"
+                },
+                "daysWaiting": {
+                    "type": "integer"
+                },
+                "hasPhoto": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isFragile": {
+                    "type": "boolean"
+                },
+                "isPerishable": {
+                    "type": "boolean"
+                },
+                "photoUrl": {
+                    "type": "string"
+                },
+                "pickupAuthorizationId": {
+                    "type": "string"
+                },
+                "receivedAt": {
+                    "type": "string"
+                },
+                "receivedBy": {
+                    "type": "string"
+                },
+                "receivedByName": {
+                    "type": "string"
+                },
+                "recipientName": {
+                    "type": "string"
+                },
+                "sizeCategory": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "storageLocation": {
+                    "type": "string"
+                },
+                "trackingCode": {
+                    "type": "string"
+                },
+                "withdrawnAt": {
+                    "type": "string"
+                },
+                "withdrawnBy": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.JoinBookingWaitlistRequest": {
+            "type": "object",
+            "required": [
+                "apartmentId",
+                "commonAreaId",
+                "condominiumId",
+                "endsAt",
+                "startsAt"
+            ],
+            "properties": {
+                "apartmentId": {
+                    "type": "string"
+                },
+                "commonAreaId": {
+                    "type": "string"
+                },
+                "condominiumId": {
+                    "type": "string"
+                },
+                "endsAt": {
+                    "type": "string"
+                },
+                "headcount": {
+                    "description": "Headcount is how many people the booking would cover (defaults to 1).",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "startsAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.JoinBookingWaitlistResponse": {
+            "type": "object",
+            "properties": {
+                "entry": {
+                    "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_store_pgstore.BookingWaitlistEntry"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.ListAccessLogsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_controllers.AccessLogItem"
+                    }
+                }
+            }
+        },
+        "api_controllers.ListBillsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "apartmentId": {
+                                "type": "string"
+                            },
+                            "billType": {
+                                "type": "string"
+                            },
+                            "condominiumId": {
+                                "type": "string"
+                            },
+                            "createdAt": {
+                                "type": "string"
+                            },
+                            "digitableLine": {
+                                "type": "string"
+                            },
+                            "dueDate": {
+                                "type": "string"
+                            },
+                            "id": {
+                                "type": "string"
+                            },
+                            "paidAt": {
+                                "type": "string"
+                            },
+                            "pixCode": {
+                                "type": "string"
+                            },
+                            "status": {
+                                "type": "string"
+                            },
+                            "updatedAt": {
+                                "type": "string"
+                            },
+                            "valueInCents": {
+                                "type": "integer"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "api_controllers.ListBlockedVisitorsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_controllers.BlockedVisitorItem"
+                    }
+                }
+            }
+        },
+        "api_controllers.ListBookingWaitlistResponse": {
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_store_pgstore.ListUserWaitlistEntriesRow"
+                    }
+                }
+            }
+        },
+        "api_controllers.ListBookingsResponse": {
+            "type": "object",
+            "properties": {
+                "bookings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_store_pgstore.ListBookingsRow"
+                    }
+                }
+            }
+        },
+        "api_controllers.ListCalendarFeedsResponse": {
+            "type": "object",
+            "properties": {
+                "feeds": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_controllers.CalendarFeedItem"
+                    }
+                }
+            }
+        },
+        "api_controllers.ListCommonAreaBlackoutsResponse": {
+            "type": "object",
+            "properties": {
+                "blackouts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_store_pgstore.CommonAreaBlackout"
+                    }
+                }
+            }
+        },
+        "api_controllers.ListCommonAreasResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_controllers.CommonAreaItem"
+                    }
+                }
+            }
+        },
+        "api_controllers.ListInvitesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "apartmentNumber": {
+                                "type": "string"
+                            },
+                            "block": {
+                                "type": "string"
+                            },
+                            "dailyEndTime": {
+                                "type": "string"
+                            },
+                            "dailyStartTime": {
+                                "type": "string"
+                            },
+                            "endsAt": {
+                                "type": "string"
+                            },
+                            "entriesCount": {
+                                "type": "integer"
+                            },
+                            "guestName": {
+                                "type": "string"
+                            },
+                            "guestPlate": {
+                                "type": "string"
+                            },
+                            "guestType": {
+                                "type": "string"
+                            },
+                            "id": {
+                                "type": "string"
+                            },
+                            "maxEntries": {
+                                "type": "integer"
+                            },
+                            "remainingEntries": {
+                                "type": "integer"
+                            },
+                            "revokedAt": {
+                                "type": "string"
+                            },
+                            "startsAt": {
+                                "type": "string"
+                            },
+                            "token": {
+                                "type": "string"
+                            },
+                            "weekdays": {
+                                "type": "array",
+                                "items": {
+                                    "type": "integer"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "api_controllers.ListPeopleInsideResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_controllers.AccessLogItem"
+                    }
+                },
+                "generatedAt": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api_controllers.ListPickupAuthorizationsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_store_pgstore.PackagePickupAuthorization"
+                    }
+                }
+            }
+        },
+        "api_controllers.ListPollsResponse": {
+            "type": "object",
+            "properties": {
+                "polls": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/usecases.PollListItem"
+                    }
+                }
+            }
+        },
+        "api_controllers.ListVehiclesResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_controllers.VehicleItem"
+                    }
+                }
+            }
+        },
+        "api_controllers.ListVisitorRequestsResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_controllers.VisitorRequestItem"
+                    }
+                }
+            }
+        },
+        "api_controllers.LogoutRequest": {
+            "type": "object",
+            "properties": {
+                "refreshToken": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.LookupPlateResponse": {
+            "type": "object",
+            "properties": {
+                "invites": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_controllers.PlateInviteItem"
+                    }
+                },
+                "plate": {
+                    "type": "string"
+                },
+                "vehicle": {
+                    "$ref": "#/definitions/api_controllers.PlateVehicle"
+                }
+            }
+        },
+        "api_controllers.MarkBillAsPaidRequest": {
+            "type": "object",
+            "required": [
+                "condominiumId"
+            ],
+            "properties": {
+                "condominiumId": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.MembershipResponse": {
+            "type": "object",
+            "properties": {
+                "condominiumId": {
+                    "type": "string"
+                },
+                "condominiumName": {
+                    "type": "string"
+                },
+                "role": {
+                    "description": "admin, syndic",
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.OfflineEntryRequest": {
+            "type": "object",
+            "required": [
+                "enteredAt",
+                "id",
+                "token"
+            ],
+            "properties": {
+                "enteredAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.OfflineEntryResultItem": {
+            "type": "object",
+            "properties": {
+                "accessLogId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "Status is accepted, duplicate or rejected.",
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.OpeningHoursItem": {
+            "type": "object",
+            "properties": {
+                "closes_at": {
+                    "type": "string"
+                },
+                "opens_at": {
+                    "type": "string"
+                },
+                "weekday": {
+                    "type": "integer"
+                }
+            }
+        },
+        "api_controllers.OpeningHoursRequest": {
+            "type": "object",
+            "required": [
+                "closesAt",
+                "opensAt"
+            ],
+            "properties": {
+                "closesAt": {
+                    "type": "string"
+                },
+                "opensAt": {
+                    "type": "string"
+                },
+                "weekday": {
+                    "type": "integer",
+                    "maximum": 6,
+                    "minimum": 0
+                }
+            }
+        },
+        "api_controllers.PlateInviteItem": {
+            "type": "object",
+            "properties": {
+                "allowedNow": {
+                    "type": "boolean"
+                },
+                "apartmentId": {
+                    "type": "string"
+                },
+                "apartmentNumber": {
+                    "type": "string"
+                },
+                "block": {
+                    "type": "string"
+                },
+                "endsAt": {
+                    "type": "string"
+                },
+                "guestName": {
+                    "type": "string"
+                },
+                "guestPlate": {
+                    "type": "string"
+                },
+                "guestType": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "residentName": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.PlateVehicle": {
             "type": "object",
             "properties": {
-                "createdAt": {
+                "apartmentId": {
+                    "type": "string"
+                },
+                "apartmentNumber": {
+                    "type": "string"
+                },
+                "block": {
+                    "type": "string"
+                },
+                "color": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
+                "model": {
+                    "type": "string"
+                },
+                "plate": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.PublicInviteResponse": {
+            "type": "object",
+            "properties": {
+                "apartmentNumber": {
+                    "type": "string"
+                },
+                "block": {
+                    "type": "string"
+                },
+                "dailyEndTime": {
+                    "type": "string"
+                },
+                "dailyStartTime": {
+                    "type": "string"
+                },
+                "endsAt": {
+                    "type": "string"
+                },
+                "guestName": {
+                    "type": "string"
+                },
+                "guestType": {
+                    "type": "string"
+                },
+                "qrCodePayload": {
+                    "type": "string"
+                },
+                "startsAt": {
+                    "type": "string"
+                },
                 "status": {
                     "type": "string"
                 },
-                "userEmail": {
+                "timezone": {
                     "type": "string"
                 },
-                "userName": {
+                "weekdays": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "api_controllers.RefreshTokenRequest": {
+            "type": "object",
+            "properties": {
+                "refreshToken": {
                     "type": "string"
                 }
             }
         },
-        "api_controllers.AnnouncementResponse": {
+        "api_controllers.RefreshTokenResponse": {
             "type": "object",
             "properties": {
-                "authorName": {
+                "accessToken": {
                     "type": "string"
                 },
-                "content": {
+                "refreshToken": {
                     "type": "string"
+                }
+            }
+        },
+        "api_controllers.RegisterAccessExitResponse": {
+            "type": "object",
+            "properties": {
+                "accessLog": {
+                    "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_store_pgstore.AccessLog"
                 },
-                "createdAt": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.RegisterDeviceReq": {
+            "type": "object",
+            "required": [
+                "fcmToken"
+            ],
+            "properties": {
+                "fcmToken": {
                     "type": "string"
                 },
-                "id": {
+                "platform": {
+                    "type": "string",
+                    "enum": [
+                        "android",
+                        "ios",
+                        "web"
+                    ]
+                }
+            }
+        },
+        "api_controllers.RegisterPlateEntryRequest": {
+            "type": "object",
+            "required": [
+                "condominiumId",
+                "plate"
+            ],
+            "properties": {
+                "condominiumId": {
                     "type": "string"
                 },
-                "title": {
+                "plate": {
                     "type": "string"
                 }
             }
         },
-        "api_controllers.ApproveAccessRequestReq": {
+        "api_controllers.RegisterPlateEntryResponse": {
+            "type": "object",
+            "properties": {
+                "invite": {
+                    "$ref": "#/definitions/api_controllers.PlateInviteItem"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "plate": {
+                    "type": "string"
+                },
+                "remainingEntries": {
+                    "description": "RemainingEntries is null for resident vehicles and invites without an entry limit.",
+                    "type": "integer"
+                },
+                "vehicle": {
+                    "$ref": "#/definitions/api_controllers.PlateVehicle"
+                }
+            }
+        },
+        "api_controllers.RejectAccessRequestReq": {
             "type": "object",
             "required": [
                 "accessRequestId"
@@ -2327,811 +7928,815 @@ const docTemplate = `{
             "properties": {
                 "accessRequestId": {
                     "type": "string"
+                }
+            }
+        },
+        "api_controllers.RejectAccessRequestResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.ReleaseBookingDepositRequest": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "retainedCents": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "api_controllers.ReleaseBookingDepositResponse": {
+            "type": "object",
+            "properties": {
+                "booking": {
+                    "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_store_pgstore.Booking"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.ReleasePackageRequest": {
+            "type": "object",
+            "properties": {
+                "pickupCode": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.ReleasePackageResponse": {
+            "type": "object",
+            "properties": {
+                "releaseUntil": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.ResidenceResponse": {
+            "type": "object",
+            "properties": {
+                "block": {
+                    "type": "string"
+                },
+                "condominiumId": {
+                    "type": "string"
+                },
+                "condominiumName": {
+                    "type": "string"
                 },
                 "isResponsible": {
+                    "description": "Pode abrir chamados?",
                     "type": "boolean"
+                },
+                "number": {
+                    "type": "string"
+                },
+                "type": {
+                    "description": "owner, tenant",
+                    "type": "string"
                 }
             }
         },
-        "api_controllers.ApproveAccessRequestResponse": {
+        "api_controllers.RespondVisitorRequestRequest": {
+            "type": "object",
+            "required": [
+                "decision"
+            ],
+            "properties": {
+                "decision": {
+                    "type": "string",
+                    "enum": [
+                        "approve",
+                        "deny"
+                    ]
+                }
+            }
+        },
+        "api_controllers.ShareInviteResponse": {
+            "type": "object",
+            "properties": {
+                "expiresAt": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "api_controllers.SigninResponse": {
             "type": "object",
             "properties": {
+                "accessToken": {
+                    "type": "string"
+                },
                 "message": {
                     "type": "string"
+                },
+                "refreshToken": {
+                    "type": "string"
                 }
             }
         },
-        "api_controllers.CancelBillRequest": {
+        "api_controllers.SigninWithCredentialsRequest": {
             "type": "object",
             "required": [
-                "condominiumId"
+                "email",
+                "password"
             ],
             "properties": {
-                "condominiumId": {
+                "email": {
                     "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 6
                 }
             }
         },
-        "api_controllers.CreateAccessRequestReq": {
+        "api_controllers.SignupRequest": {
             "type": "object",
             "required": [
-                "apartmentId",
-                "type",
-                "userId"
+                "email",
+                "name",
+                "password"
             ],
             "properties": {
-                "apartmentId": {
+                "avatar_url": {
                     "type": "string"
                 },
-                "type": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
                     "type": "string",
-                    "enum": [
-                        "owner",
-                        "tenant",
-                        "dependent"
-                    ]
+                    "maxLength": 100,
+                    "minLength": 3
                 },
-                "userId": {
-                    "type": "string"
+                "password": {
+                    "type": "string",
+                    "minLength": 6
                 }
             }
         },
-        "api_controllers.CreateAccessRequestResponse": {
+        "api_controllers.SignupResponse": {
             "type": "object",
             "properties": {
                 "message": {
                     "type": "string"
                 },
-                "requestId": {
+                "userId": {
                     "type": "string"
                 }
             }
         },
-        "api_controllers.CreateAnnouncementRequest": {
+        "api_controllers.SyncOfflineEntriesRequest": {
             "type": "object",
             "required": [
                 "condominiumId",
-                "content",
-                "title"
+                "entries"
             ],
             "properties": {
                 "condominiumId": {
                     "type": "string"
                 },
-                "content": {
-                    "type": "string"
-                },
-                "title": {
-                    "type": "string"
+                "entries": {
+                    "type": "array",
+                    "maxItems": 500,
+                    "items": {
+                        "$ref": "#/definitions/api_controllers.OfflineEntryRequest"
+                    }
                 }
             }
         },
-        "api_controllers.CreateApartmentRequest": {
+        "api_controllers.SyncOfflineEntriesResponse": {
             "type": "object",
-            "required": [
-                "condominiumId",
-                "number"
-            ],
             "properties": {
-                "block": {
-                    "type": "string"
+                "accepted": {
+                    "type": "integer"
                 },
-                "condominiumId": {
-                    "type": "string"
+                "duplicates": {
+                    "type": "integer"
                 },
-                "number": {
-                    "type": "string",
-                    "minLength": 1
+                "rejected": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_controllers.OfflineEntryResultItem"
+                    }
                 }
             }
         },
-        "api_controllers.CreateApartmentResponse": {
+        "api_controllers.TimeSlotItem": {
             "type": "object",
             "properties": {
-                "apartmentId": {
+                "endsAt": {
                     "type": "string"
                 },
-                "message": {
+                "remainingCapacity": {
+                    "description": "RemainingCapacity is only set for areas allowing overlapping bookings.",
+                    "type": "integer"
+                },
+                "startsAt": {
                     "type": "string"
                 }
             }
         },
-        "api_controllers.CreateBillRequest": {
+        "api_controllers.UpdateCommonAreaRequest": {
             "type": "object",
             "required": [
-                "apartmentId",
-                "billType",
-                "condominiumId",
-                "dueDate",
-                "valueInCents"
+                "name"
             ],
             "properties": {
-                "apartmentId": {
-                    "type": "string"
+                "capacity": {
+                    "type": "integer",
+                    "minimum": 1
                 },
-                "billType": {
+                "name": {
                     "type": "string",
-                    "enum": [
-                        "rent",
-                        "condominium_fee",
-                        "water",
-                        "electricity",
-                        "gas",
-                        "fine"
-                    ]
-                },
-                "condominiumId": {
-                    "type": "string"
-                },
-                "digitableLine": {
-                    "type": "string"
-                },
-                "dueDate": {
-                    "type": "string"
-                },
-                "pixCode": {
-                    "type": "string"
+                    "minLength": 3
                 },
-                "valueInCents": {
-                    "type": "integer",
-                    "minimum": 0
+                "requiredApproval": {
+                    "type": "boolean"
                 }
             }
         },
-        "api_controllers.CreateBillResponse": {
+        "api_controllers.UserApartmentResponse": {
             "type": "object",
             "properties": {
                 "apartmentId": {
                     "type": "string"
                 },
-                "billType": {
+                "block": {
                     "type": "string"
                 },
                 "condominiumId": {
                     "type": "string"
                 },
-                "createdAt": {
-                    "type": "string"
-                },
-                "digitableLine": {
-                    "type": "string"
-                },
-                "dueDate": {
-                    "type": "string"
-                },
-                "id": {
+                "condominiumName": {
                     "type": "string"
                 },
-                "paidAt": {
-                    "type": "string"
+                "isResponsible": {
+                    "type": "boolean"
                 },
-                "pixCode": {
+                "number": {
                     "type": "string"
                 },
-                "status": {
+                "type": {
                     "type": "string"
-                },
-                "valueInCents": {
-                    "type": "integer"
                 }
             }
         },
-        "api_controllers.CreateBookingRequest": {
+        "api_controllers.UserProfileResponse": {
             "type": "object",
-            "required": [
-                "apartmentId",
-                "commonAreaId",
-                "condominiumId",
-                "endsAt",
-                "startsAt"
-            ],
             "properties": {
-                "apartmentId": {
+                "avatarUrl": {
                     "type": "string"
                 },
-                "commonAreaId": {
+                "email": {
                     "type": "string"
                 },
-                "condominiumId": {
+                "id": {
                     "type": "string"
                 },
-                "endsAt": {
-                    "type": "string"
+                "memberships": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_controllers.MembershipResponse"
+                    }
                 },
-                "startsAt": {
+                "name": {
                     "type": "string"
-                }
-            }
-        },
-        "api_controllers.CreateBookingResponse": {
-            "type": "object",
-            "properties": {
-                "booking": {
-                    "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_store_pgstore.Booking"
                 },
-                "message": {
-                    "type": "string"
+                "residences": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/api_controllers.ResidenceResponse"
+                    }
                 }
             }
         },
-        "api_controllers.CreateCommonAreaRequest": {
+        "api_controllers.ValidateInviteRequest": {
             "type": "object",
             "required": [
                 "condominiumId",
-                "name"
+                "token"
             ],
             "properties": {
-                "capacity": {
-                    "type": "integer",
-                    "minimum": 1
-                },
                 "condominiumId": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string",
-                    "minLength": 3
-                },
-                "requiredApproval": {
-                    "type": "boolean"
+                "token": {
+                    "type": "string"
                 }
             }
         },
-        "api_controllers.CreateCommonAreaResponse": {
+        "api_controllers.ValidateInviteResponse": {
             "type": "object",
             "properties": {
-                "commonArea": {
-                    "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_store_pgstore.CommonArea"
+                "invite": {
+                    "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_store_pgstore.GetInviteByTokenRow"
                 },
                 "message": {
                     "type": "string"
+                },
+                "remainingEntries": {
+                    "description": "RemainingEntries is null when the invite has no entry limit.",
+                    "type": "integer"
                 }
             }
         },
-        "api_controllers.CreateCondominiumRequest": {
+        "api_controllers.VehicleItem": {
             "type": "object",
-            "required": [
-                "address",
-                "cnpj",
-                "name",
-                "planType"
-            ],
             "properties": {
-                "address": {
+                "apartmentId": {
                     "type": "string"
                 },
-                "cnpj": {
+                "color": {
                     "type": "string"
                 },
-                "name": {
+                "createdAt": {
                     "type": "string"
                 },
-                "planType": {
-                    "type": "string",
-                    "enum": [
-                        "basic",
-                        "pro",
-                        "enterprise"
-                    ]
+                "createdBy": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "model": {
+                    "type": "string"
+                },
+                "plate": {
+                    "type": "string"
                 }
             }
         },
-        "api_controllers.CreateCondominiumResponse": {
+        "api_controllers.VisitorRequestItem": {
             "type": "object",
             "properties": {
+                "apartmentId": {
+                    "type": "string"
+                },
                 "condominiumId": {
                     "type": "string"
                 },
-                "message": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "decidedAt": {
+                    "type": "string"
+                },
+                "decidedBy": {
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "photoUrl": {
+                    "type": "string"
+                },
+                "requestedBy": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "visitorDocument": {
+                    "type": "string"
+                },
+                "visitorName": {
                     "type": "string"
                 }
             }
         },
-        "api_controllers.CreateInviteRequest": {
+        "api_controllers.VotePollRequest": {
             "type": "object",
             "required": [
                 "apartmentId",
-                "condominiumId",
-                "endsAt",
-                "guestName",
-                "startsAt"
+                "optionIds"
             ],
             "properties": {
                 "apartmentId": {
                     "type": "string"
                 },
-                "condominiumId": {
-                    "type": "string"
-                },
-                "endsAt": {
-                    "type": "string"
-                },
-                "guestName": {
-                    "type": "string",
-                    "minLength": 1
-                },
-                "guestType": {
-                    "type": "string",
-                    "enum": [
-                        "guest",
-                        "service",
-                        "delivery"
-                    ]
-                },
-                "startsAt": {
-                    "type": "string"
+                "optionIds": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "api_controllers.CreateInviteResponse": {
+        "api_controllers.WithdrawPackageRequest": {
             "type": "object",
             "properties": {
-                "invite": {
-                    "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_store_pgstore.Invite"
+                "collectorDocument": {
+                    "type": "string"
+                },
+                "collectorName": {
+                    "type": "string"
                 },
-                "message": {
+                "condominiumId": {
+                    "type": "string"
+                },
+                "pickupCode": {
                     "type": "string"
                 }
             }
         },
-        "api_controllers.CreatePackageRequest": {
+        "auth.JWK": {
             "type": "object",
-            "required": [
-                "apartmentId",
-                "condominiumId"
-            ],
             "properties": {
-                "apartmentId": {
+                "alg": {
                     "type": "string"
                 },
-                "condominiumId": {
+                "crv": {
                     "type": "string"
                 },
-                "photoUrl": {
+                "kid": {
                     "type": "string"
                 },
-                "recipientName": {
+                "kty": {
+                    "type": "string"
+                },
+                "use": {
+                    "type": "string"
+                },
+                "x": {
                     "type": "string"
                 }
             }
         },
-        "api_controllers.CreatePackageResponse": {
+        "auth.JWKS": {
+            "type": "object",
+            "properties": {
+                "keys": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/auth.JWK"
+                    }
+                }
+            }
+        },
+        "github_com_Bellorico323_vizen_internal_api_common.ErrResponse": {
             "type": "object",
             "properties": {
                 "message": {
                     "type": "string"
-                },
-                "packageId": {
-                    "type": "string"
                 }
             }
         },
-        "api_controllers.EditBookingRequest": {
+        "github_com_Bellorico323_vizen_internal_api_common.SuccessResponse": {
             "type": "object",
-            "required": [
-                "status"
-            ],
             "properties": {
-                "status": {
-                    "type": "string",
-                    "enum": [
-                        "confirmed",
-                        "denied"
-                    ]
+                "message": {
+                    "type": "string"
                 }
             }
         },
-        "api_controllers.GetAreaAvailabilityResponse": {
+        "github_com_Bellorico323_vizen_internal_api_common.ValidationErrResponse": {
             "type": "object",
             "properties": {
-                "bookings": {
+                "errors": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_store_pgstore.GetAreaAvailabilityRow"
+                        "$ref": "#/definitions/validator.ErrorResponse"
                     }
+                },
+                "message": {
+                    "type": "string"
                 }
             }
         },
-        "api_controllers.GetPackageResponse": {
+        "github_com_Bellorico323_vizen_internal_store_pgstore.AccessLog": {
             "type": "object",
             "properties": {
-                "apartmentId": {
+                "apartment_id": {
                     "type": "string"
                 },
-                "apartmentNumber": {
+                "authorized_by": {
                     "type": "string"
                 },
-                "block": {
+                "condominium_id": {
                     "type": "string"
                 },
-                "condominiumId": {
+                "entered_at": {
                     "type": "string"
                 },
-                "id": {
+                "exit_registered_by": {
                     "type": "string"
                 },
-                "photoUrl": {
+                "exited_at": {
                     "type": "string"
                 },
-                "receivedAt": {
+                "id": {
                     "type": "string"
                 },
-                "receivedBy": {
+                "invite_id": {
                     "type": "string"
                 },
-                "receivedByName": {
+                "offline_entry_id": {
                     "type": "string"
                 },
-                "recipientName": {
+                "plate": {
                     "type": "string"
                 },
-                "status": {
+                "synced_at": {
                     "type": "string"
                 },
-                "withdrawnAt": {
+                "vehicle_id": {
                     "type": "string"
                 },
-                "withdrawnBy": {
+                "visitor_request_id": {
                     "type": "string"
                 }
             }
         },
-        "api_controllers.ListBillsResponse": {
-            "type": "object",
-            "properties": {
-                "data": {
-                    "type": "array",
-                    "items": {
-                        "type": "object",
-                        "properties": {
-                            "apartmentId": {
-                                "type": "string"
-                            },
-                            "billType": {
-                                "type": "string"
-                            },
-                            "condominiumId": {
-                                "type": "string"
-                            },
-                            "createdAt": {
-                                "type": "string"
-                            },
-                            "digitableLine": {
-                                "type": "string"
-                            },
-                            "dueDate": {
-                                "type": "string"
-                            },
-                            "id": {
-                                "type": "string"
-                            },
-                            "paidAt": {
-                                "type": "string"
-                            },
-                            "pixCode": {
-                                "type": "string"
-                            },
-                            "status": {
-                                "type": "string"
-                            },
-                            "updatedAt": {
-                                "type": "string"
-                            },
-                            "valueInCents": {
-                                "type": "integer"
-                            }
-                        }
-                    }
-                }
-            }
-        },
-        "api_controllers.ListBookingsResponse": {
-            "type": "object",
-            "properties": {
-                "bookings": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_store_pgstore.ListBookingsRow"
-                    }
-                }
-            }
-        },
-        "api_controllers.ListCommonAreasResponse": {
-            "type": "object",
-            "properties": {
-                "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_store_pgstore.CommonArea"
-                    }
-                }
-            }
-        },
-        "api_controllers.ListInvitesResponse": {
-            "type": "object",
-            "properties": {
-                "data": {
-                    "type": "array",
-                    "items": {
-                        "type": "object",
-                        "properties": {
-                            "apartmentNumber": {
-                                "type": "string"
-                            },
-                            "block": {
-                                "type": "string"
-                            },
-                            "endsAt": {
-                                "type": "string"
-                            },
-                            "guestName": {
-                                "type": "string"
-                            },
-                            "guestType": {
-                                "type": "string"
-                            },
-                            "id": {
-                                "type": "string"
-                            },
-                            "revokedAt": {
-                                "type": "string"
-                            },
-                            "startsAt": {
-                                "type": "string"
-                            },
-                            "token": {
-                                "type": "string"
-                            }
-                        }
-                    }
-                }
-            }
-        },
-        "api_controllers.LogoutRequest": {
+        "github_com_Bellorico323_vizen_internal_store_pgstore.Bill": {
             "type": "object",
             "properties": {
-                "refreshToken": {
+                "apartment_id": {
+                    "type": "string"
+                },
+                "bill_type": {
+                    "type": "string"
+                },
+                "condominium_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "digitable_line": {
+                    "type": "string"
+                },
+                "due_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "paid_at": {
+                    "type": "string"
+                },
+                "pix_code": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
                     "type": "string"
+                },
+                "value_in_cents": {
+                    "type": "integer"
                 }
             }
         },
-        "api_controllers.MarkBillAsPaidRequest": {
+        "github_com_Bellorico323_vizen_internal_store_pgstore.Booking": {
             "type": "object",
-            "required": [
-                "condominiumId"
-            ],
             "properties": {
-                "condominiumId": {
+                "apartment_id": {
+                    "type": "string"
+                },
+                "cancellation_fine_bill_id": {
+                    "type": "string"
+                },
+                "cancelled_at": {
+                    "type": "string"
+                },
+                "cancelled_by": {
+                    "type": "string"
+                },
+                "common_area_id": {
+                    "type": "string"
+                },
+                "condominium_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "deposit_bill_id": {
+                    "type": "string"
+                },
+                "deposit_released_at": {
+                    "type": "string"
+                },
+                "deposit_released_by": {
+                    "type": "string"
+                },
+                "deposit_retained_cents": {
+                    "type": "integer"
+                },
+                "deposit_retention_reason": {
+                    "type": "string"
+                },
+                "ends_at": {
+                    "type": "string"
+                },
+                "escalated_at": {
+                    "type": "string"
+                },
+                "exclusive": {
+                    "type": "boolean"
+                },
+                "fee_bill_id": {
+                    "type": "string"
+                },
+                "headcount": {
+                    "type": "integer"
+                },
+                "id": {
                     "type": "string"
-                }
-            }
-        },
-        "api_controllers.MembershipResponse": {
-            "type": "object",
-            "properties": {
-                "condominiumId": {
+                },
+                "late_cancellation": {
+                    "type": "boolean"
+                },
+                "starts_at": {
                     "type": "string"
                 },
-                "condominiumName": {
+                "status": {
                     "type": "string"
                 },
-                "role": {
-                    "description": "admin, syndic",
+                "updated_at": {
                     "type": "string"
-                }
-            }
-        },
-        "api_controllers.RefreshTokenRequest": {
-            "type": "object",
-            "properties": {
-                "refreshToken": {
+                },
+                "user_id": {
                     "type": "string"
                 }
             }
         },
-        "api_controllers.RefreshTokenResponse": {
+        "github_com_Bellorico323_vizen_internal_store_pgstore.BookingWaitlistEntry": {
             "type": "object",
             "properties": {
-                "accessToken": {
+                "apartment_id": {
                     "type": "string"
                 },
-                "refreshToken": {
+                "booking_id": {
                     "type": "string"
-                }
-            }
-        },
-        "api_controllers.RegisterDeviceReq": {
-            "type": "object",
-            "required": [
-                "fcmToken"
-            ],
-            "properties": {
-                "fcmToken": {
+                },
+                "common_area_id": {
                     "type": "string"
                 },
-                "platform": {
-                    "type": "string",
-                    "enum": [
-                        "android",
-                        "ios",
-                        "web"
-                    ]
-                }
-            }
-        },
-        "api_controllers.RejectAccessRequestReq": {
-            "type": "object",
-            "required": [
-                "accessRequestId"
-            ],
-            "properties": {
-                "accessRequestId": {
+                "condominium_id": {
                     "type": "string"
-                }
-            }
-        },
-        "api_controllers.RejectAccessRequestResponse": {
-            "type": "object",
-            "properties": {
-                "message": {
+                },
+                "created_at": {
                     "type": "string"
-                }
-            }
-        },
-        "api_controllers.ResidenceResponse": {
-            "type": "object",
-            "properties": {
-                "block": {
+                },
+                "ends_at": {
                     "type": "string"
                 },
-                "condominiumId": {
+                "headcount": {
+                    "type": "integer"
+                },
+                "id": {
                     "type": "string"
                 },
-                "condominiumName": {
+                "offer_expires_at": {
                     "type": "string"
                 },
-                "isResponsible": {
-                    "description": "Pode abrir chamados?",
-                    "type": "boolean"
+                "offered_at": {
+                    "type": "string"
                 },
-                "number": {
+                "starts_at": {
                     "type": "string"
                 },
-                "type": {
-                    "description": "owner, tenant",
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
                     "type": "string"
                 }
             }
         },
-        "api_controllers.SigninResponse": {
+        "github_com_Bellorico323_vizen_internal_store_pgstore.CommonAreaBlackout": {
             "type": "object",
             "properties": {
-                "accessToken": {
+                "common_area_id": {
                     "type": "string"
                 },
-                "message": {
+                "condominium_id": {
                     "type": "string"
                 },
-                "refreshToken": {
+                "created_at": {
                     "type": "string"
-                }
-            }
-        },
-        "api_controllers.SigninWithCredentialsRequest": {
-            "type": "object",
-            "required": [
-                "email",
-                "password"
-            ],
-            "properties": {
-                "email": {
+                },
+                "created_by": {
                     "type": "string"
                 },
-                "password": {
-                    "type": "string",
-                    "minLength": 6
-                }
-            }
-        },
-        "api_controllers.SignupRequest": {
-            "type": "object",
-            "required": [
-                "email",
-                "name",
-                "password"
-            ],
-            "properties": {
-                "avatar_url": {
+                "ends_at": {
                     "type": "string"
                 },
-                "email": {
+                "id": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 100,
-                    "minLength": 3
+                "reason": {
+                    "type": "string"
                 },
-                "password": {
-                    "type": "string",
-                    "minLength": 6
+                "starts_at": {
+                    "type": "string"
                 }
             }
         },
-        "api_controllers.SignupResponse": {
+        "github_com_Bellorico323_vizen_internal_store_pgstore.GetAreaAvailabilityRow": {
             "type": "object",
             "properties": {
-                "message": {
+                "ends_at": {
                     "type": "string"
                 },
-                "userId": {
+                "headcount": {
+                    "type": "integer"
+                },
+                "starts_at": {
+                    "type": "string"
+                },
+                "status": {
                     "type": "string"
                 }
             }
         },
-        "api_controllers.UserApartmentResponse": {
+        "github_com_Bellorico323_vizen_internal_store_pgstore.GetInviteByTokenRow": {
             "type": "object",
             "properties": {
-                "apartmentId": {
+                "apartment_id": {
+                    "type": "string"
+                },
+                "apartment_number": {
                     "type": "string"
                 },
                 "block": {
                     "type": "string"
                 },
-                "condominiumId": {
+                "condominium_id": {
                     "type": "string"
                 },
-                "condominiumName": {
+                "condominium_timezone": {
                     "type": "string"
                 },
-                "isResponsible": {
-                    "type": "boolean"
+                "created_at": {
+                    "type": "string"
                 },
-                "number": {
+                "daily_end_minute": {
+                    "type": "integer"
+                },
+                "daily_start_minute": {
+                    "type": "integer"
+                },
+                "ends_at": {
                     "type": "string"
                 },
-                "type": {
+                "guest_name": {
                     "type": "string"
-                }
-            }
-        },
-        "api_controllers.UserProfileResponse": {
-            "type": "object",
-            "properties": {
-                "avatarUrl": {
+                },
+                "guest_plate": {
                     "type": "string"
                 },
-                "email": {
+                "guest_type": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "memberships": {
+                "issued_by": {
+                    "type": "string"
+                },
+                "max_entries": {
+                    "type": "integer"
+                },
+                "recurrence_weekdays": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/api_controllers.MembershipResponse"
+                        "type": "integer"
                     }
                 },
-                "name": {
+                "resident_name": {
                     "type": "string"
                 },
-                "residences": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/api_controllers.ResidenceResponse"
-                    }
-                }
-            }
-        },
-        "api_controllers.ValidateInviteRequest": {
-            "type": "object",
-            "required": [
-                "condominiumId",
-                "token"
-            ],
-            "properties": {
-                "condominiumId": {
+                "revoked_at": {
+                    "type": "string"
+                },
+                "starts_at": {
                     "type": "string"
                 },
                 "token": {
@@ -3139,64 +8744,109 @@ const docTemplate = `{
                 }
             }
         },
-        "api_controllers.ValidateInviteResponse": {
+        "github_com_Bellorico323_vizen_internal_store_pgstore.GetPollResultsRow": {
             "type": "object",
             "properties": {
-                "invite": {
-                    "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_store_pgstore.GetInviteByTokenRow"
+                "label": {
+                    "type": "string"
                 },
-                "message": {
+                "option_id": {
                     "type": "string"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "votes": {
+                    "type": "integer"
+                },
+                "weighted_votes": {
+                    "type": "number"
                 }
             }
         },
-        "api_controllers.WithdrawPackageRequest": {
+        "github_com_Bellorico323_vizen_internal_store_pgstore.Invite": {
             "type": "object",
             "properties": {
-                "condominiumId": {
+                "apartment_id": {
                     "type": "string"
-                }
-            }
-        },
-        "github_com_Bellorico323_vizen_internal_api_common.ErrResponse": {
-            "type": "object",
-            "properties": {
-                "message": {
+                },
+                "condominium_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "daily_end_minute": {
+                    "type": "integer"
+                },
+                "daily_start_minute": {
+                    "type": "integer"
+                },
+                "ends_at": {
+                    "type": "string"
+                },
+                "guest_name": {
+                    "type": "string"
+                },
+                "guest_plate": {
                     "type": "string"
-                }
-            }
-        },
-        "github_com_Bellorico323_vizen_internal_api_common.SuccessResponse": {
-            "type": "object",
-            "properties": {
-                "message": {
+                },
+                "guest_type": {
                     "type": "string"
-                }
-            }
-        },
-        "github_com_Bellorico323_vizen_internal_api_common.ValidationErrResponse": {
-            "type": "object",
-            "properties": {
-                "errors": {
+                },
+                "id": {
+                    "type": "string"
+                },
+                "issued_by": {
+                    "type": "string"
+                },
+                "max_entries": {
+                    "type": "integer"
+                },
+                "recurrence_weekdays": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/validator.ErrorResponse"
+                        "type": "integer"
                     }
                 },
-                "message": {
+                "revoked_at": {
+                    "type": "string"
+                },
+                "starts_at": {
+                    "type": "string"
+                },
+                "token": {
                     "type": "string"
                 }
             }
         },
-        "github_com_Bellorico323_vizen_internal_store_pgstore.Booking": {
+        "github_com_Bellorico323_vizen_internal_store_pgstore.ListBookingsRow": {
             "type": "object",
             "properties": {
+                "apartment_block": {
+                    "type": "string"
+                },
                 "apartment_id": {
                     "type": "string"
                 },
+                "apartment_number": {
+                    "type": "string"
+                },
+                "cancellation_fine_bill_id": {
+                    "type": "string"
+                },
+                "cancelled_at": {
+                    "type": "string"
+                },
+                "cancelled_by": {
+                    "type": "string"
+                },
                 "common_area_id": {
                     "type": "string"
                 },
+                "common_area_name": {
+                    "type": "string"
+                },
                 "condominium_id": {
                     "type": "string"
                 },
@@ -3206,12 +8856,42 @@ const docTemplate = `{
                 "deleted_at": {
                     "type": "string"
                 },
+                "deposit_bill_id": {
+                    "type": "string"
+                },
+                "deposit_released_at": {
+                    "type": "string"
+                },
+                "deposit_released_by": {
+                    "type": "string"
+                },
+                "deposit_retained_cents": {
+                    "type": "integer"
+                },
+                "deposit_retention_reason": {
+                    "type": "string"
+                },
                 "ends_at": {
                     "type": "string"
                 },
+                "escalated_at": {
+                    "type": "string"
+                },
+                "exclusive": {
+                    "type": "boolean"
+                },
+                "fee_bill_id": {
+                    "type": "string"
+                },
+                "headcount": {
+                    "type": "integer"
+                },
                 "id": {
                     "type": "string"
                 },
+                "late_cancellation": {
+                    "type": "boolean"
+                },
                 "starts_at": {
                     "type": "string"
                 },
@@ -3223,53 +8903,48 @@ const docTemplate = `{
                 },
                 "user_id": {
                     "type": "string"
+                },
+                "user_name": {
+                    "type": "string"
                 }
             }
         },
-        "github_com_Bellorico323_vizen_internal_store_pgstore.CommonArea": {
+        "github_com_Bellorico323_vizen_internal_store_pgstore.ListPollVotersRow": {
             "type": "object",
             "properties": {
-                "capacity": {
-                    "type": "integer"
-                },
-                "condominium_id": {
+                "apartment_id": {
                     "type": "string"
                 },
-                "id": {
+                "apartment_number": {
                     "type": "string"
                 },
-                "name": {
+                "block": {
                     "type": "string"
                 },
-                "requires_approval": {
-                    "type": "boolean"
-                }
-            }
-        },
-        "github_com_Bellorico323_vizen_internal_store_pgstore.GetAreaAvailabilityRow": {
-            "type": "object",
-            "properties": {
-                "ends_at": {
+                "created_at": {
                     "type": "string"
                 },
-                "starts_at": {
+                "option_id": {
                     "type": "string"
                 },
-                "status": {
+                "voter_name": {
                     "type": "string"
                 }
             }
         },
-        "github_com_Bellorico323_vizen_internal_store_pgstore.GetInviteByTokenRow": {
+        "github_com_Bellorico323_vizen_internal_store_pgstore.ListUserWaitlistEntriesRow": {
             "type": "object",
             "properties": {
                 "apartment_id": {
                     "type": "string"
                 },
-                "apartment_number": {
+                "booking_id": {
                     "type": "string"
                 },
-                "block": {
+                "common_area_id": {
+                    "type": "string"
+                },
+                "common_area_name": {
                     "type": "string"
                 },
                 "condominium_id": {
@@ -3281,86 +8956,83 @@ const docTemplate = `{
                 "ends_at": {
                     "type": "string"
                 },
-                "guest_name": {
-                    "type": "string"
+                "headcount": {
+                    "type": "integer"
                 },
-                "guest_type": {
+                "id": {
                     "type": "string"
                 },
-                "id": {
+                "offer_expires_at": {
                     "type": "string"
                 },
-                "issued_by": {
+                "offered_at": {
                     "type": "string"
                 },
-                "resident_name": {
+                "starts_at": {
                     "type": "string"
                 },
-                "revoked_at": {
+                "status": {
                     "type": "string"
                 },
-                "starts_at": {
+                "updated_at": {
                     "type": "string"
                 },
-                "token": {
+                "user_id": {
                     "type": "string"
                 }
             }
         },
-        "github_com_Bellorico323_vizen_internal_store_pgstore.Invite": {
+        "github_com_Bellorico323_vizen_internal_store_pgstore.PackagePickupAuthorization": {
             "type": "object",
             "properties": {
                 "apartment_id": {
                     "type": "string"
                 },
-                "condominium_id": {
+                "authorized_document": {
                     "type": "string"
                 },
-                "created_at": {
+                "authorized_name": {
                     "type": "string"
                 },
-                "ends_at": {
+                "condominium_id": {
                     "type": "string"
                 },
-                "guest_name": {
+                "created_at": {
                     "type": "string"
                 },
-                "guest_type": {
+                "created_by": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "issued_by": {
+                "package_id": {
                     "type": "string"
                 },
                 "revoked_at": {
                     "type": "string"
                 },
-                "starts_at": {
+                "valid_from": {
                     "type": "string"
                 },
-                "token": {
+                "valid_until": {
                     "type": "string"
                 }
             }
         },
-        "github_com_Bellorico323_vizen_internal_store_pgstore.ListBookingsRow": {
+        "github_com_Bellorico323_vizen_internal_store_pgstore.Poll": {
             "type": "object",
             "properties": {
-                "apartment_block": {
-                    "type": "string"
-                },
-                "apartment_id": {
+                "choice_type": {
                     "type": "string"
                 },
-                "apartment_number": {
+                "closed_at": {
                     "type": "string"
                 },
-                "common_area_id": {
+                "closes_at": {
                     "type": "string"
                 },
-                "common_area_name": {
+                "closing_notified_at": {
                     "type": "string"
                 },
                 "condominium_id": {
@@ -3369,29 +9041,52 @@ const docTemplate = `{
                 "created_at": {
                     "type": "string"
                 },
-                "deleted_at": {
+                "created_by": {
                     "type": "string"
                 },
-                "ends_at": {
+                "description": {
                     "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "starts_at": {
+                "is_anonymous": {
+                    "type": "boolean"
+                },
+                "is_weighted": {
+                    "type": "boolean"
+                },
+                "open_notified_at": {
                     "type": "string"
                 },
-                "status": {
+                "opens_at": {
+                    "type": "string"
+                },
+                "results_visibility": {
+                    "type": "string"
+                },
+                "title": {
                     "type": "string"
                 },
                 "updated_at": {
                     "type": "string"
+                }
+            }
+        },
+        "github_com_Bellorico323_vizen_internal_store_pgstore.PollOption": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
                 },
-                "user_id": {
+                "label": {
                     "type": "string"
                 },
-                "user_name": {
+                "poll_id": {
                     "type": "string"
+                },
+                "position": {
+                    "type": "integer"
                 }
             }
         },
@@ -3404,12 +9099,27 @@ const docTemplate = `{
                 "block": {
                     "type": "string"
                 },
+                "carrier": {
+                    "type": "string"
+                },
+                "daysWaiting": {
+                    "type": "integer"
+                },
                 "id": {
                     "type": "string"
                 },
+                "isFragile": {
+                    "type": "boolean"
+                },
+                "isPerishable": {
+                    "type": "boolean"
+                },
                 "photoUrl": {
                     "type": "string"
                 },
+                "pickupCode": {
+                    "type": "string"
+                },
                 "receivedAt": {
                     "type": "string"
                 },
@@ -3419,8 +9129,122 @@ const docTemplate = `{
                 "recipientName": {
                     "type": "string"
                 },
+                "sizeCategory": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "storageLocation": {
+                    "type": "string"
+                },
+                "trackingCode": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecases.PackagePhoto": {
+            "type": "object",
+            "properties": {
+                "expiresAt": {
+                    "type": "string"
+                },
+                "photoUrl": {
+                    "type": "string"
+                },
+                "thumbnailUrl": {
+                    "type": "string"
+                }
+            }
+        },
+        "usecases.PollDetails": {
+            "type": "object",
+            "properties": {
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_store_pgstore.PollOption"
+                    }
+                },
+                "poll": {
+                    "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_store_pgstore.Poll"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_store_pgstore.GetPollResultsRow"
+                    }
+                },
+                "resultsVisible": {
+                    "type": "boolean"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "totalBallots": {
+                    "type": "integer"
+                },
+                "voters": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_Bellorico323_vizen_internal_store_pgstore.ListPollVotersRow"
+                    }
+                }
+            }
+        },
+        "usecases.PollListItem": {
+            "type": "object",
+            "properties": {
+                "choice_type": {
+                    "type": "string"
+                },
+                "closed_at": {
+                    "type": "string"
+                },
+                "closes_at": {
+                    "type": "string"
+                },
+                "closing_notified_at": {
+                    "type": "string"
+                },
+                "condominium_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "is_anonymous": {
+                    "type": "boolean"
+                },
+                "is_weighted": {
+                    "type": "boolean"
+                },
+                "open_notified_at": {
+                    "type": "string"
+                },
+                "opens_at": {
+                    "type": "string"
+                },
+                "results_visibility": {
+                    "type": "string"
+                },
                 "status": {
                     "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
                 }
             }
         },
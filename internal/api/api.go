@@ -29,21 +29,74 @@ type Api struct {
 	ListAnnouncementsController         *controllers.ListAnnouncementsHandler
 	DeleteAnnouncementController        *controllers.DeleteAnnouncementHandler
 	CreatePackageController             *controllers.CreatePackageHandler
+	CreatePackagesBatchController       *controllers.CreatePackagesBatchHandler
 	GetPackageController                *controllers.GetPackageHandler
 	ListPackagesController              *controllers.ListPackagesHandler
 	WithdrawPackageController           *controllers.WithdrawPackageHandler
+	UploadPackagePhotoController        *controllers.UploadPackagePhotoHandler
+	GetPackagePhotoController           *controllers.GetPackagePhotoHandler
+	DownloadFileController              *controllers.DownloadFileHandler
+	ReleasePackageController            *controllers.ReleasePackageHandler
+	ResetPackagePickupCodeController    *controllers.ResetPackagePickupCodeHandler
+	ReturnPackageController             *controllers.ReturnPackageHandler
+	CreatePickupAuthorizationController *controllers.CreatePickupAuthorizationHandler
+	ListPickupAuthorizationsController  *controllers.ListPickupAuthorizationsHandler
+	RevokePickupAuthorizationController *controllers.RevokePickupAuthorizationHandler
 	CreateInviteController              *controllers.CreateInviteHandler
 	ValidateInviteController            *controllers.ValidateInviteHandler
 	RevokeInviteController              *controllers.RevokeInviteHandler
 	ListInvitesController               *controllers.ListInvitesHandler
+	GetInviteQRCodeController           *controllers.GetInviteQRCodeHandler
+	ShareInviteController               *controllers.ShareInviteHandler
+	GetPublicInviteController           *controllers.GetPublicInviteHandler
+	ListAccessLogsController            *controllers.ListAccessLogsHandler
+	ListPeopleInsideController          *controllers.ListPeopleInsideHandler
+	RegisterAccessExitController        *controllers.RegisterAccessExitHandler
+	CreateVisitorRequestController      *controllers.CreateVisitorRequestHandler
+	ListVisitorRequestsController       *controllers.ListVisitorRequestsHandler
+	GetVisitorRequestController         *controllers.GetVisitorRequestHandler
+	RespondVisitorRequestController     *controllers.RespondVisitorRequestHandler
+	WatchVisitorRequestsController      *controllers.WatchVisitorRequestsHandler
+	RegisterPlateEntryController        *controllers.RegisterPlateEntryHandler
+	CreateVehicleController             *controllers.CreateVehicleHandler
+	ListVehiclesController              *controllers.ListVehiclesHandler
+	RemoveVehicleController             *controllers.RemoveVehicleHandler
+	LookupPlateController               *controllers.LookupPlateHandler
+	GetInviteOfflineTokenController     *controllers.GetInviteOfflineTokenHandler
+	GetInviteKeysController             *controllers.GetInviteKeysHandler
+	SyncOfflineEntriesController        *controllers.SyncOfflineEntriesHandler
+	CreateBlockedVisitorController      *controllers.CreateBlockedVisitorHandler
+	ListBlockedVisitorsController       *controllers.ListBlockedVisitorsHandler
+	RemoveBlockedVisitorController      *controllers.RemoveBlockedVisitorHandler
 	CreateCommonAreaController          *controllers.CreateCommonAreaHandler
 	ListCommonAreasController           *controllers.ListCommonAreasHandler
+	UpdateCommonAreaRulesController     *controllers.UpdateCommonAreaRulesHandler
+	UpdateCommonAreaController          *controllers.UpdateCommonAreaHandler
+	ArchiveCommonAreaController         *controllers.ArchiveCommonAreaHandler
+	CreateCommonAreaBlackoutController  *controllers.CreateCommonAreaBlackoutHandler
+	ListCommonAreaBlackoutsController   *controllers.ListCommonAreaBlackoutsHandler
+	DeleteCommonAreaBlackoutController  *controllers.DeleteCommonAreaBlackoutHandler
 	CreateBookingController             *controllers.CreateBookingsHandler
 	EditBookingController               *controllers.EditBookingHandler
+	CancelBookingController             *controllers.CancelBookingHandler
+	ReleaseBookingDepositController     *controllers.ReleaseBookingDepositHandler
 	ListBookingsController              *controllers.ListBookingsHandler
+	JoinBookingWaitlistController       *controllers.JoinBookingWaitlistHandler
+	ListBookingWaitlistController       *controllers.ListBookingWaitlistHandler
+	AcceptWaitlistOfferController       *controllers.AcceptWaitlistOfferHandler
+	LeaveBookingWaitlistController      *controllers.LeaveBookingWaitlistHandler
 	GetAreaAvailabilityController       *controllers.GetAreaAvailabilityHandler
+	CreateCalendarFeedController        *controllers.CreateCalendarFeedHandler
+	ListCalendarFeedsController         *controllers.ListCalendarFeedsHandler
+	RevokeCalendarFeedController        *controllers.RevokeCalendarFeedHandler
+	GetCalendarFeedController           *controllers.GetCalendarFeedHandler
 	CreateBillController                *controllers.CreateBillHandler
 	MarkBillAsPaidController            *controllers.MarkBillAsPaidHandler
 	CancelBillController                *controllers.CancelBillHandler
 	ListBillsController                 *controllers.ListBillsHandler
+	CreatePollController                *controllers.CreatePollHandler
+	ListPollsController                 *controllers.ListPollsHandler
+	GetPollController                   *controllers.GetPollHandler
+	VotePollController                  *controllers.VotePollHandler
+	ClosePollController                 *controllers.ClosePollHandler
 }
@@ -0,0 +1,102 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type AcceptWaitlistOfferHandler struct {
+	AcceptWaitlistOffer usecases.AcceptWaitlistOfferUC
+}
+
+// Handle books the range held for a waitlist entry
+// @Summary      Accept Waitlist Offer
+// @Description  Turns an open waitlist offer into a booking. The booking follows the area rules like any other: it is pending when the area requires approval, and billed right away otherwise.
+// @Tags         Bookings
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Waitlist entry UUID"
+// @Success      201  {object}  controllers.CreateBookingResponse
+// @Failure      400  {object}  common.ErrResponse  "Invalid ID, or the booking breaks the area rules"
+// @Failure      401  {object}  common.ErrResponse  "Unauthorized"
+// @Failure      403  {object}  common.ErrResponse  "User is not a resident of the apartment"
+// @Failure      404  {object}  common.ErrResponse  "Waitlist entry not found"
+// @Failure      409  {object}  common.ErrResponse  "Offer expired, or the booking conflicts with the area limits"
+// @Failure      500  {object}  common.ErrResponse  "Internal Server Error"
+// @Router       /bookings/waitlist/{id}/accept [post]
+func (h *AcceptWaitlistOfferHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	entryID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid waitlist entry ID format",
+		})
+		return
+	}
+
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	booking, err := h.AcceptWaitlistOffer.Exec(r.Context(), usecases.AcceptWaitlistOfferReq{
+		UserID:  userID,
+		EntryID: entryID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrWaitlistEntryNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Waitlist entry not found",
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Only residents of the apartment can accept this offer",
+			})
+		case errors.Is(err, usecases.ErrInvalidHeadcount),
+			errors.Is(err, usecases.ErrBookingOutsideOpeningHours),
+			errors.Is(err, usecases.ErrBookingTooShort),
+			errors.Is(err, usecases.ErrBookingTooLong),
+			errors.Is(err, usecases.ErrBookingTooFarAhead):
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: err.Error(),
+			})
+		case errors.Is(err, usecases.ErrWaitlistOfferExpired),
+			errors.Is(err, usecases.ErrTimeSlotTaken),
+			errors.Is(err, usecases.ErrAreaAtCapacity),
+			errors.Is(err, usecases.ErrBookingCooldown),
+			errors.Is(err, usecases.ErrBookingMonthlyLimit),
+			errors.Is(err, usecases.ErrAreaBlackedOut),
+			errors.Is(err, usecases.ErrCommonAreaArchived):
+			jsonutils.EncodeJson(w, r, http.StatusConflict, common.ErrResponse{
+				Message: err.Error(),
+			})
+		default:
+			slog.Error("failed to accept waitlist offer", "error", err, "waitlistEntryId", entryID)
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to accept waitlist offer",
+			})
+		}
+		return
+	}
+
+	message := "Booking confirmed successfully"
+	if booking.Status == "pending" {
+		message = "Booking request received. Waiting for syndic approval."
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusCreated, CreateBookingResponse{
+		Message: message,
+		Booking: booking,
+	})
+}
@@ -0,0 +1,89 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type ArchiveCommonAreaHandler struct {
+	ArchiveCommonArea usecases.ArchiveCommonAreaUC
+}
+
+type ArchiveCommonAreaResponse struct {
+	Message           string            `json:"message"`
+	CancelledBookings []pgstore.Booking `json:"cancelledBookings"`
+}
+
+// Handle archives a common area
+// @Summary      Archive Common Area
+// @Description  Deactivates a common area: it disappears from the listings and takes no new bookings, but its booking history is kept. Bookings not finished yet are cancelled along with their unpaid bills and the waitlist, and each affected user is notified. Only Admin/Syndic can perform this action.
+// @Tags         Common Areas
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Common Area UUID"
+// @Success      200  {object}  controllers.ArchiveCommonAreaResponse
+// @Failure      400  {object}  common.ErrResponse  "Invalid ID"
+// @Failure      401  {object}  common.ErrResponse  "User not authenticated"
+// @Failure      403  {object}  common.ErrResponse  "Permission denied"
+// @Failure      404  {object}  common.ErrResponse  "Common area not found"
+// @Failure      500  {object}  common.ErrResponse  "Internal server error"
+// @Router       /common_areas/{id} [delete]
+func (h *ArchiveCommonAreaHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	areaID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid common area ID format",
+		})
+		return
+	}
+
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	result, err := h.ArchiveCommonArea.Exec(r.Context(), usecases.ArchiveCommonAreaReq{
+		UserID:       userID,
+		CommonAreaID: areaID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrCommonAreaNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Common area not found",
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Only admins or syndics can archive common areas",
+			})
+		default:
+			slog.Error("failed to archive common area", "error", err, "commonAreaId", areaID)
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to archive common area",
+			})
+		}
+		return
+	}
+
+	cancelled := result.CancelledBookings
+	if cancelled == nil {
+		cancelled = []pgstore.Booking{}
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusOK, ArchiveCommonAreaResponse{
+		Message:           "Common area archived",
+		CancelledBookings: cancelled,
+	})
+}
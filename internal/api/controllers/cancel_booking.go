@@ -0,0 +1,97 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type CancelBookingHandler struct {
+	CancelBooking usecases.CancelBookingUC
+}
+
+type CancelBookingResponse struct {
+	Message  string          `json:"message"`
+	Booking  pgstore.Booking `json:"booking"`
+	FineBill *pgstore.Bill   `json:"fineBill,omitempty"`
+}
+
+// Handle cancels a booking on behalf of a resident
+// @Summary      Cancel Booking
+// @Description  Allows a resident of the apartment to cancel a pending or confirmed booking before it starts. Cancelling a confirmed booking after the area cancellation deadline is recorded as late and, when the area charges a late cancellation fee, a fine bill is created for the apartment.
+// @Tags         Bookings
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Booking UUID"
+// @Success      200  {object}  controllers.CancelBookingResponse
+// @Failure      400  {object}  common.ErrResponse  "Invalid ID"
+// @Failure      401  {object}  common.ErrResponse  "Unauthorized"
+// @Failure      403  {object}  common.ErrResponse  "User is not a resident of the apartment"
+// @Failure      404  {object}  common.ErrResponse  "Booking not found"
+// @Failure      409  {object}  common.ErrResponse  "Booking already started or cannot be cancelled"
+// @Failure      500  {object}  common.ErrResponse  "Internal Server Error"
+// @Router       /bookings/{id}/cancel [post]
+func (h *CancelBookingHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	bookingID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid booking ID format",
+		})
+		return
+	}
+
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	result, err := h.CancelBooking.Exec(r.Context(), usecases.CancelBookingReq{
+		UserID:    userID,
+		BookingID: bookingID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrBookingNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Booking not found",
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Only residents of the apartment can cancel this booking",
+			})
+		case errors.Is(err, usecases.ErrBookingNotCancellable),
+			errors.Is(err, usecases.ErrBookingAlreadyStarted):
+			jsonutils.EncodeJson(w, r, http.StatusConflict, common.ErrResponse{
+				Message: err.Error(),
+			})
+		default:
+			slog.Error("failed to cancel booking", "error", err, "bookingId", bookingID)
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to cancel booking",
+			})
+		}
+		return
+	}
+
+	message := "Booking cancelled"
+	if result.Booking.LateCancellation {
+		message = "Booking cancelled after the cancellation deadline"
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusOK, CancelBookingResponse{
+		Message:  message,
+		Booking:  result.Booking,
+		FineBill: result.FineBill,
+	})
+}
@@ -0,0 +1,78 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type ClosePollHandler struct {
+	ClosePoll usecases.ClosePollUC
+}
+
+// Handle closes a poll before its scheduled end
+// @Summary      Close Poll
+// @Description  Closes a poll immediately. Only Admin/Syndic can perform this action. Residents are notified that the results are available.
+// @Tags         Polls
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Poll UUID"
+// @Success      204  "No Content"
+// @Failure      400  {object}  common.ErrResponse "Invalid ID format"
+// @Failure      401  {object}  common.ErrResponse "User not authenticated"
+// @Failure      403  {object}  common.ErrResponse "Permission denied"
+// @Failure      404  {object}  common.ErrResponse "Poll not found"
+// @Failure      409  {object}  common.ErrResponse "Poll already closed"
+// @Failure      500  {object}  common.ErrResponse "Internal server error"
+// @Router       /polls/{id}/close [patch]
+func (h *ClosePollHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	pollID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid poll ID format",
+		})
+		return
+	}
+
+	err = h.ClosePoll.Exec(r.Context(), usecases.ClosePollReq{
+		PollID: pollID,
+		UserID: userID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrPollNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Poll not found",
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Only admins or syndics can close polls",
+			})
+		case errors.Is(err, usecases.ErrPollAlreadyClosed):
+			jsonutils.EncodeJson(w, r, http.StatusConflict, common.ErrResponse{
+				Message: err.Error(),
+			})
+		default:
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to close poll",
+			})
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
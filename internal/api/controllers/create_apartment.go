@@ -22,6 +22,7 @@ type CreateApartmentRequest struct {
 	CondominiumID uuid.UUID `json:"condominiumId" validate:"required"`
 	Block         string    `json:"block,omitempty" validate:"omitempty"`
 	Number        string    `json:"number" validate:"required,min=1"`
+	VotingWeight  *float64  `json:"votingWeight,omitempty" validate:"omitempty,gt=0"`
 }
 
 type CreateApartmentResponse struct {
@@ -75,6 +76,7 @@ func (h *CreateApartmentHandler) Handle(w http.ResponseWriter, r *http.Request)
 		CondominiumID: data.CondominiumID,
 		Block:         data.Block,
 		Number:        data.Number,
+		VotingWeight:  data.VotingWeight,
 		UserID:        userId,
 	}
 
@@ -93,6 +95,11 @@ func (h *CreateApartmentHandler) Handle(w http.ResponseWriter, r *http.Request)
 				Message: "Condominium not found",
 			})
 
+		case errors.Is(err, usecases.ErrInvalidVotingWeight):
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: err.Error(),
+			})
+
 		default:
 			var pgErr *pgconn.PgError
 			if errors.As(err, &pgErr) && pgErr.Code == "23505" {
@@ -0,0 +1,101 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/Bellorico323/vizen/internal/validator"
+	"github.com/google/uuid"
+)
+
+type CreateBlockedVisitorHandler struct {
+	CreateBlockedVisitor usecases.CreateBlockedVisitorUC
+}
+
+type CreateBlockedVisitorRequest struct {
+	CondominiumID uuid.UUID  `json:"condominiumId" validate:"required,uuid4"`
+	Name          string     `json:"name" validate:"required,max=255"`
+	Document      *string    `json:"document" validate:"omitempty,max=50"`
+	Plate         *string    `json:"plate"`
+	Reason        string     `json:"reason" validate:"required"`
+	ExpiresAt     *time.Time `json:"expiresAt"`
+}
+
+// Handle adds a visitor to the condominium blocklist
+// @Summary      Create Blocked Visitor
+// @Description  Adds a visitor to the condominium blocklist. Invites carrying a blocked plate are refused, invites for a blocked name are created with a warning, and blocked guests are refused at the gate while admins are alerted. Without expiresAt the block lasts until removed. Staff only.
+// @Tags         Blocked Visitors
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body      controllers.CreateBlockedVisitorRequest true "Blocklist entry"
+// @Success      201     {object}  controllers.BlockedVisitorItem
+// @Failure      400     {object}  common.ErrResponse            "Invalid Payload, plate or expiration"
+// @Failure      401     {object}  common.ErrResponse            "User not authenticated"
+// @Failure      403     {object}  common.ErrResponse            "Permission denied"
+// @Failure      422     {object}  common.ValidationErrResponse  "Validation Failed"
+// @Failure      500     {object}  common.ErrResponse            "Internal server error"
+// @Router       /blocked_visitors [post]
+func (h *CreateBlockedVisitorHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	data, err := jsonutils.DecodeJson[CreateBlockedVisitorRequest](r)
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid JSON payload",
+		})
+		return
+	}
+
+	if validationErrors := validator.ValidateStruct(data); len(validationErrors) > 0 {
+		jsonutils.EncodeJson(w, r, http.StatusUnprocessableEntity, common.ValidationErrResponse{
+			Message: "Validation failed",
+			Errors:  validationErrors,
+		})
+		return
+	}
+
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	entry, err := h.CreateBlockedVisitor.Exec(r.Context(), usecases.CreateBlockedVisitorReq{
+		CondominiumID: data.CondominiumID,
+		UserID:        userID,
+		Name:          data.Name,
+		Document:      data.Document,
+		Plate:         data.Plate,
+		Reason:        data.Reason,
+		ExpiresAt:     data.ExpiresAt,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrBlockReasonRequired),
+			errors.Is(err, usecases.ErrBlockExpiryInPast),
+			errors.Is(err, usecases.ErrInvalidPlate):
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: err.Error(),
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Only condominium staff can block visitors",
+			})
+		default:
+			slog.Error("failed to create blocked visitor", "error", err, "condominiumId", data.CondominiumID)
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to block visitor",
+			})
+		}
+		return
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusCreated, toBlockedVisitorItem(entry))
+}
@@ -25,6 +25,8 @@ type CreateBookingRequest struct {
 	CommonAreaID  uuid.UUID `json:"commonAreaId" validate:"required"`
 	StartsAt      time.Time `json:"startsAt" validate:"required"`
 	EndsAt        time.Time `json:"endsAt" validate:"required,gtfield=StartsAt"`
+	// Headcount is how many people the booking covers (defaults to 1).
+	Headcount int32 `json:"headcount" validate:"omitempty,min=1"`
 }
 
 type CreateBookingResponse struct {
@@ -34,14 +36,15 @@ type CreateBookingResponse struct {
 
 // Handle creates a new booking
 // @Summary      Book Common Area
-// @Description  Create a reservation for a common area (e.g. Party Hall). Prevents double booking via locking.
+// @Description  Create a reservation for a common area (e.g. Party Hall). Prevents double booking via locking: exclusive areas take one booking at a time, areas allowing overlapping bookings take them while the summed headcount fits the capacity. The area rules are checked in the same transaction: opening hours, minimum/maximum duration, how far ahead it can be booked, the cool-down between bookings and the monthly limit per apartment. Archived areas and blackout periods take no bookings.
 // @Tags         Bookings
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        request body      controllers.CreateBookingRequest true "Booking Data"
 // @Success      201     {object}  controllers.CreateBookingResponse
-// @Failure      409     {object}  common.ErrResponse "Time slot conflict"
+// @Failure      400     {object}  common.ErrResponse "Invalid dates or headcount, outside opening hours, duration out of bounds or too far ahead"
+// @Failure      409     {object}  common.ErrResponse "Time slot conflict or area at capacity (the resident can join the waitlist), cool-down or monthly limit reached, area closed by a blackout or archived"
 // @Failure      403     {object}  common.ErrResponse "Permission denied"
 // @Router       /bookings [post]
 func (h *CreateBookingsHandler) Handle(w http.ResponseWriter, r *http.Request) {
@@ -69,6 +72,7 @@ func (h *CreateBookingsHandler) Handle(w http.ResponseWriter, r *http.Request) {
 		CommonAreaID:  data.CommonAreaID,
 		StartsAt:      data.StartsAt,
 		EndsAt:        data.EndsAt,
+		Headcount:     data.Headcount,
 	})
 
 	if err != nil {
@@ -86,16 +90,38 @@ func (h *CreateBookingsHandler) Handle(w http.ResponseWriter, r *http.Request) {
 				Message: "This time slot is already booked by another resident.",
 			})
 
+		case errors.Is(err, usecases.ErrAreaAtCapacity):
+			jsonutils.EncodeJson(w, r, http.StatusConflict, common.ErrResponse{
+				Message: err.Error(),
+			})
+
 		case errors.Is(err, usecases.ErrNoPermission):
 			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
 				Message: "You must be a resident of the apartment to create a booking.",
 			})
 
-		case errors.Is(err, usecases.ErrInvalidBookingDate):
+		case errors.Is(err, usecases.ErrInvalidBookingDate),
+			errors.Is(err, usecases.ErrInvalidHeadcount):
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: err.Error(),
+			})
+
+		case errors.Is(err, usecases.ErrBookingOutsideOpeningHours),
+			errors.Is(err, usecases.ErrBookingTooShort),
+			errors.Is(err, usecases.ErrBookingTooLong),
+			errors.Is(err, usecases.ErrBookingTooFarAhead):
 			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
 				Message: err.Error(),
 			})
 
+		case errors.Is(err, usecases.ErrBookingCooldown),
+			errors.Is(err, usecases.ErrBookingMonthlyLimit),
+			errors.Is(err, usecases.ErrAreaBlackedOut),
+			errors.Is(err, usecases.ErrCommonAreaArchived):
+			jsonutils.EncodeJson(w, r, http.StatusConflict, common.ErrResponse{
+				Message: err.Error(),
+			})
+
 		default:
 			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
 				Message: "Failed to create booking",
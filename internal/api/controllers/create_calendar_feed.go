@@ -0,0 +1,109 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/Bellorico323/vizen/internal/validator"
+	"github.com/google/uuid"
+)
+
+type CreateCalendarFeedHandler struct {
+	CreateCalendarFeed usecases.CreateCalendarFeedUC
+}
+
+type CreateCalendarFeedRequest struct {
+	CondominiumID uuid.UUID `json:"condominiumId" validate:"required"`
+	Scope         string    `json:"scope" validate:"required,oneof=user condominium"`
+}
+
+// CalendarFeedItem is a feed and the secret URL to subscribe to. Anyone holding the URL can read
+// the feed until it is revoked.
+type CalendarFeedItem struct {
+	ID        uuid.UUID `json:"id"`
+	Scope     string    `json:"scope"`
+	URL       string    `json:"url"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func toCalendarFeedItem(link usecases.CalendarFeedLink) CalendarFeedItem {
+	return CalendarFeedItem{
+		ID:        link.Feed.ID,
+		Scope:     link.Feed.Scope,
+		URL:       link.URL,
+		CreatedAt: link.Feed.CreatedAt,
+	}
+}
+
+// Handle creates an iCalendar feed URL
+// @Summary      Create Calendar Feed
+// @Description  Creates a secret iCalendar (ICS) URL that calendar apps (Google Calendar, Apple Calendar, Outlook) can subscribe to without a Bearer token. Scope "user" covers the user's own bookings; scope "condominium" covers every booking of the condominium and is only available to Admin/Syndic. The URL keeps working until the feed is revoked.
+// @Tags         Calendar
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body      controllers.CreateCalendarFeedRequest true "Feed data"
+// @Success      201     {object}  controllers.CalendarFeedItem
+// @Failure      400     {object}  common.ErrResponse            "Invalid JSON payload"
+// @Failure      401     {object}  common.ErrResponse            "User not authenticated"
+// @Failure      403     {object}  common.ErrResponse            "Permission denied"
+// @Failure      422     {object}  common.ValidationErrResponse  "Validation Failed"
+// @Failure      500     {object}  common.ErrResponse            "Internal server error"
+// @Router       /calendar_feeds [post]
+func (h *CreateCalendarFeedHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	data, err := jsonutils.DecodeJson[CreateCalendarFeedRequest](r)
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid JSON payload",
+		})
+		return
+	}
+
+	if validationErrors := validator.ValidateStruct(data); len(validationErrors) > 0 {
+		jsonutils.EncodeJson(w, r, http.StatusUnprocessableEntity, common.ValidationErrResponse{
+			Message: "Validation failed",
+			Errors:  validationErrors,
+		})
+		return
+	}
+
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	link, err := h.CreateCalendarFeed.Exec(r.Context(), usecases.CreateCalendarFeedReq{
+		UserID:        userID,
+		CondominiumID: data.CondominiumID,
+		Scope:         data.Scope,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrInvalidCalendarFeedScope):
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: err.Error(),
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "You cannot subscribe to this calendar",
+			})
+		default:
+			slog.Error("failed to create calendar feed", "error", err, "userId", userID)
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to create calendar feed",
+			})
+		}
+		return
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusCreated, toCalendarFeedItem(link))
+}
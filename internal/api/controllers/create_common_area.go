@@ -7,7 +7,6 @@ import (
 	"github.com/Bellorico323/vizen/internal/api/common"
 	"github.com/Bellorico323/vizen/internal/auth"
 	"github.com/Bellorico323/vizen/internal/jsonutils"
-	"github.com/Bellorico323/vizen/internal/store/pgstore"
 	"github.com/Bellorico323/vizen/internal/usecases"
 	"github.com/Bellorico323/vizen/internal/validator"
 	"github.com/google/uuid"
@@ -18,21 +17,78 @@ type CreateCommonAreaHandler struct {
 	CreateCommonArea usecases.CreateCommonAreaUC
 }
 
+type OpeningHoursRequest struct {
+	Weekday  int16  `json:"weekday" validate:"min=0,max=6"`
+	OpensAt  string `json:"opensAt" validate:"required"`
+	ClosesAt string `json:"closesAt" validate:"required"`
+}
+
+// BookingRulesRequest is the rule set of a common area. Omitted limits are disabled.
+type BookingRulesRequest struct {
+	OpeningHours        []OpeningHoursRequest `json:"openingHours" validate:"omitempty,max=7,dive"`
+	MinDurationMinutes  *int32                `json:"minDurationMinutes" validate:"omitempty,min=1"`
+	MaxDurationMinutes  *int32                `json:"maxDurationMinutes" validate:"omitempty,min=1"`
+	MaxAdvanceDays      *int32                `json:"maxAdvanceDays" validate:"omitempty,min=1"`
+	CooldownMinutes     int32                 `json:"cooldownMinutes" validate:"min=0"`
+	MaxBookingsPerMonth *int32                `json:"maxBookingsPerMonth" validate:"omitempty,min=1"`
+	// Hours before the start after which a cancellation is late, and the fine billed for it.
+	CancellationDeadlineHours *int32 `json:"cancellationDeadlineHours" validate:"omitempty,min=1"`
+	LateCancellationFeeCents  *int64 `json:"lateCancellationFeeCents" validate:"omitempty,min=1"`
+	// Price charged per booking or per hour of use, and the security deposit billed apart.
+	PriceCents   *int64 `json:"priceCents" validate:"omitempty,min=1"`
+	PricingUnit  string `json:"pricingUnit" validate:"omitempty,oneof=booking hour"`
+	DepositCents *int64 `json:"depositCents" validate:"omitempty,min=1"`
+	// Takes overlapping bookings while their headcount fits the capacity (gym, pool).
+	AllowOverlappingBookings bool `json:"allowOverlappingBookings"`
+	// Hours before the start at which a booking still waiting for approval is denied or escalated
+	// to the admins.
+	PendingExpiryHours  *int32 `json:"pendingExpiryHours" validate:"omitempty,min=1"`
+	PendingExpiryAction string `json:"pendingExpiryAction" validate:"omitempty,oneof=deny escalate"`
+}
+
+func (b BookingRulesRequest) toBookingRules() usecases.BookingRules {
+	rules := usecases.BookingRules{
+		OpeningHours:              make([]usecases.OpeningHours, len(b.OpeningHours)),
+		MinDurationMinutes:        b.MinDurationMinutes,
+		MaxDurationMinutes:        b.MaxDurationMinutes,
+		MaxAdvanceDays:            b.MaxAdvanceDays,
+		CooldownMinutes:           b.CooldownMinutes,
+		MaxBookingsPerMonth:       b.MaxBookingsPerMonth,
+		CancellationDeadlineHours: b.CancellationDeadlineHours,
+		LateCancellationFeeCents:  b.LateCancellationFeeCents,
+		PriceCents:                b.PriceCents,
+		PricingUnit:               b.PricingUnit,
+		DepositCents:              b.DepositCents,
+		AllowOverlappingBookings:  b.AllowOverlappingBookings,
+		PendingExpiryHours:        b.PendingExpiryHours,
+		PendingExpiryAction:       b.PendingExpiryAction,
+	}
+	for i, h := range b.OpeningHours {
+		rules.OpeningHours[i] = usecases.OpeningHours{
+			Weekday:  h.Weekday,
+			OpensAt:  h.OpensAt,
+			ClosesAt: h.ClosesAt,
+		}
+	}
+	return rules
+}
+
 type CreateCommonAreaRequest struct {
 	CondominiumID    uuid.UUID `json:"condominiumId" validate:"required"`
 	Name             string    `json:"name" validate:"required,min=3"`
 	Capacity         *int32    `json:"capacity" validate:"omitempty,min=1"`
 	RequiresApproval bool      `json:"requiredApproval"`
+	BookingRulesRequest
 }
 
 type CreateCommonAreaResponse struct {
-	Message    string             `json:"message"`
-	CommonArea pgstore.CommonArea `json:"commonArea"`
+	Message    string         `json:"message"`
+	CommonArea CommonAreaItem `json:"commonArea"`
 }
 
 // Create handles the creation of a new common area
 // @Summary      Create Common Area
-// @Description  Creates a new bookable area (e.g., Gym, BBQ). Only Admin/Syndic can perform this action.
+// @Description  Creates a new bookable area (e.g., Gym, BBQ). Only Admin/Syndic can perform this action. Booking rules are optional: openingHours (one entry per weekday, 0 = Sunday, HH:MM in the condominium timezone; weekdays left out are closed, no entries means always open), min/max duration in minutes, how many days ahead it can be booked, the cool-down in minutes required between two bookings, the monthly limit of bookings per apartment, the cancellation policy (hours before the start a resident can still cancel and the fine in cents billed for late cancellations) and the pricing (price in cents per booking or per hour, following pricingUnit, plus an optional security deposit, both billed to the apartment when the booking is confirmed). Areas with allowOverlappingBookings (gym, pool) take overlapping bookings while their headcount fits the capacity, which is then required. With pendingExpiryHours, bookings still waiting for approval that many hours before they start are denied and their slot freed (pendingExpiryAction "deny", the default) or escalated to the admins and denied only if still pending when they start ("escalate").
 // @Tags         Common Areas
 // @Accept       json
 // @Produce      json
@@ -75,6 +131,7 @@ func (h *CreateCommonAreaHandler) Handle(w http.ResponseWriter, r *http.Request)
 		Name:             data.Name,
 		Capacity:         data.Capacity,
 		RequiresApproval: data.RequiresApproval,
+		Rules:            data.toBookingRules(),
 	}
 
 	area, err := h.CreateCommonArea.Exec(r.Context(), payload)
@@ -83,7 +140,10 @@ func (h *CreateCommonAreaHandler) Handle(w http.ResponseWriter, r *http.Request)
 		case errors.Is(err, usecases.ErrNoPermission):
 			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{Message: "Only admins or syndics can create common areas"})
 
-		case errors.Is(err, usecases.ErrInvalidAreaName):
+		case errors.Is(err, usecases.ErrInvalidAreaName),
+			errors.Is(err, usecases.ErrInvalidBookingRules),
+			errors.Is(err, usecases.ErrInvalidOpeningHours),
+			errors.Is(err, usecases.ErrOverlappingNeedsCapacity):
 			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{Message: err.Error()})
 
 		default:
@@ -100,6 +160,6 @@ func (h *CreateCommonAreaHandler) Handle(w http.ResponseWriter, r *http.Request)
 
 	jsonutils.EncodeJson(w, r, http.StatusCreated, CreateCommonAreaResponse{
 		Message:    "Common area created successfully",
-		CommonArea: area,
+		CommonArea: toCommonAreaItem(area),
 	})
 }
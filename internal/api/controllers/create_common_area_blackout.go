@@ -0,0 +1,125 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/Bellorico323/vizen/internal/validator"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type CreateCommonAreaBlackoutHandler struct {
+	CreateCommonAreaBlackout usecases.CreateCommonAreaBlackoutUC
+}
+
+type CreateCommonAreaBlackoutRequest struct {
+	StartsAt time.Time `json:"startsAt" validate:"required"`
+	EndsAt   time.Time `json:"endsAt" validate:"required,gtfield=StartsAt"`
+	Reason   *string   `json:"reason" validate:"omitempty,max=255"`
+}
+
+type CreateCommonAreaBlackoutResponse struct {
+	Message           string                     `json:"message"`
+	Blackout          pgstore.CommonAreaBlackout `json:"blackout"`
+	CancelledBookings []pgstore.Booking          `json:"cancelledBookings"`
+}
+
+// Handle closes a common area for a period
+// @Summary      Create Common Area Blackout
+// @Description  Closes a common area for a period (maintenance, private events). Pending and confirmed bookings overlapping it are cancelled along with their unpaid bills and the waitlist for the period, and each affected user is notified. New bookings overlapping it are refused. Only Admin/Syndic can perform this action.
+// @Tags         Common Areas
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id      path      string                                      true  "Common Area UUID"
+// @Param        request body      controllers.CreateCommonAreaBlackoutRequest true  "Blackout period"
+// @Success      201     {object}  controllers.CreateCommonAreaBlackoutResponse
+// @Failure      400     {object}  common.ErrResponse            "Invalid ID, payload or period"
+// @Failure      401     {object}  common.ErrResponse            "User not authenticated"
+// @Failure      403     {object}  common.ErrResponse            "Permission denied"
+// @Failure      404     {object}  common.ErrResponse            "Common area not found"
+// @Failure      422     {object}  common.ValidationErrResponse  "Validation Failed"
+// @Failure      500     {object}  common.ErrResponse            "Internal server error"
+// @Router       /common_areas/{id}/blackouts [post]
+func (h *CreateCommonAreaBlackoutHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	areaID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid common area ID format",
+		})
+		return
+	}
+
+	data, err := jsonutils.DecodeJson[CreateCommonAreaBlackoutRequest](r)
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid JSON payload",
+		})
+		return
+	}
+
+	if validationErrors := validator.ValidateStruct(data); len(validationErrors) > 0 {
+		jsonutils.EncodeJson(w, r, http.StatusUnprocessableEntity, common.ValidationErrResponse{
+			Message: "Validation failed",
+			Errors:  validationErrors,
+		})
+		return
+	}
+
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	result, err := h.CreateCommonAreaBlackout.Exec(r.Context(), usecases.CreateCommonAreaBlackoutReq{
+		UserID:       userID,
+		CommonAreaID: areaID,
+		StartsAt:     data.StartsAt,
+		EndsAt:       data.EndsAt,
+		Reason:       data.Reason,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrInvalidBlackoutRange):
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: err.Error(),
+			})
+		case errors.Is(err, usecases.ErrCommonAreaNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Common area not found",
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Only admins or syndics can close common areas",
+			})
+		default:
+			slog.Error("failed to create common area blackout", "error", err, "commonAreaId", areaID)
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to create blackout",
+			})
+		}
+		return
+	}
+
+	cancelled := result.CancelledBookings
+	if cancelled == nil {
+		cancelled = []pgstore.Booking{}
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusCreated, CreateCommonAreaBlackoutResponse{
+		Message:           "Blackout created",
+		Blackout:          result.Blackout,
+		CancelledBookings: cancelled,
+	})
+}
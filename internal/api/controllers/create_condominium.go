@@ -19,10 +19,11 @@ type CreateCondominiumHandler struct {
 }
 
 type CreateCondominiumRequest struct {
-	Name     string `json:"name" validate:"required"`
-	Cnpj     string `json:"cnpj" validate:"required"`
-	Address  string `json:"address" validate:"required"`
-	PlanType string `json:"planType" validate:"required,oneof=basic pro enterprise"`
+	Name     string  `json:"name" validate:"required"`
+	Cnpj     string  `json:"cnpj" validate:"required"`
+	Address  string  `json:"address" validate:"required"`
+	PlanType string  `json:"planType" validate:"required,oneof=basic pro enterprise"`
+	Timezone *string `json:"timezone"`
 }
 
 type CreateCondominiumResponse struct {
@@ -32,14 +33,14 @@ type CreateCondominiumResponse struct {
 
 // Handle executes the condominium creation
 // @Summary 		Create Condominium
-// @Description	Creates a new condominium and assigns the current user as admin.
+// @Description	Creates a new condominium and assigns the current user as admin. The timezone (IANA name, e.g. America/Sao_Paulo) is used to evaluate recurring invites and defaults to America/Sao_Paulo.
 // @Security		BearerAuth
 // @Tags			Condominiums
 // @Accept			json
 // @Produce			json
 // @Param			request body controllers.CreateCondominiumRequest true "Condominium creation payload"
 // @Success			201 {object} controllers.CreateCondominiumResponse "Condominium successfully created"
-// @Failure 		400	{object} common.ErrResponse "Invalid JSON payload"
+// @Failure 		400	{object} common.ErrResponse "Invalid JSON payload or timezone"
 // @Failure			401 {object} common.ErrResponse "User not authenticated"
 // @Failure			403 {object} common.ErrResponse "User does not have permission"
 // @Failure			409 {object} common.ErrResponse "CNPJ already registered"
@@ -76,6 +77,7 @@ func (h *CreateCondominiumHandler) Handle(w http.ResponseWriter, r *http.Request
 		Cnpj:     data.Cnpj,
 		Address:  data.Address,
 		PlanType: data.PlanType,
+		Timezone: data.Timezone,
 		UserID:   userId,
 	}
 
@@ -83,6 +85,13 @@ func (h *CreateCondominiumHandler) Handle(w http.ResponseWriter, r *http.Request
 	if err != nil {
 		slog.Error("Error while creating condominium", "error", err)
 
+		if errors.Is(err, usecases.ErrInvalidTimezone) {
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: err.Error(),
+			})
+			return
+		}
+
 		if errors.Is(err, usecases.ErrNoPermission) {
 			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
 				Message: err.Error(),
@@ -11,6 +11,7 @@ import (
 	"github.com/Bellorico323/vizen/internal/jsonutils"
 	"github.com/Bellorico323/vizen/internal/store/pgstore"
 	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/Bellorico323/vizen/internal/utils"
 	"github.com/Bellorico323/vizen/internal/validator"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -21,31 +22,38 @@ type CreateInviteHandler struct {
 }
 
 type CreateInviteRequest struct {
-	CondominiumID uuid.UUID `json:"condominiumId" validate:"required,uuid4"`
-	ApartmentID   uuid.UUID `json:"apartmentId" validate:"required,uuid4"`
-	GuestName     string    `json:"guestName" validate:"required,min=1"`
-	GuestType     *string   `json:"guestType" validate:"omitempty,oneof=guest service delivery"`
-	StartsAt      time.Time `json:"startsAt" validate:"required"`
-	EndsAt        time.Time `json:"endsAt" validate:"required"`
+	CondominiumID  uuid.UUID `json:"condominiumId" validate:"required,uuid4"`
+	ApartmentID    uuid.UUID `json:"apartmentId" validate:"required,uuid4"`
+	GuestName      string    `json:"guestName" validate:"required,min=1"`
+	GuestType      *string   `json:"guestType" validate:"omitempty,oneof=guest service delivery"`
+	StartsAt       time.Time `json:"startsAt" validate:"required"`
+	EndsAt         time.Time `json:"endsAt" validate:"required"`
+	Weekdays       []int16   `json:"weekdays" validate:"omitempty,dive,min=0,max=6"`
+	DailyStartTime *string   `json:"dailyStartTime"`
+	DailyEndTime   *string   `json:"dailyEndTime"`
+	MaxEntries     *int32    `json:"maxEntries" validate:"omitempty,min=1"`
+	GuestPlate     *string   `json:"guestPlate"`
 }
 
 type CreateInviteResponse struct {
 	Message string         `json:"message"`
 	Invite  pgstore.Invite `json:"invite"`
+	// Warning is set when the guest name matches the condominium blocklist.
+	Warning *string `json:"warning,omitempty"`
 }
 
 // Handle creates a new invite
 // @Summary      Create Guest Invite
-// @Description  Generates a new access invite (QR Code Token). Only residents of the specified apartment can create invites. Dates must be in ISO8601 format.
+// @Description  Generates a new access invite (QR Code Token). Only residents of the specified apartment can create invites. Dates must be in ISO8601 format. For recurring visitors (cleaners, nannies, trainers) send weekdays (0 = Sunday ... 6 = Saturday) with dailyStartTime/dailyEndTime (HH:MM, condominium local time); startsAt/endsAt then bound the whole validity period. maxEntries limits how many times the QR code can be used (deliveries are single-use unless informed). guestPlate lets the guest in by plate at the vehicle gate. Invites whose plate is on the condominium blocklist are refused; when only the guest name matches, the invite is created with a warning, since the gate will refuse the guest while the entry is active.
 // @Tags         Invites
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        request body      controllers.CreateInviteRequest true "Invite Creation Data"
 // @Success      201     {object}  controllers.CreateInviteResponse
-// @Failure      400     {object}  common.ErrResponse            "Invalid Payload or Logic Error (e.g., End date before Start date)"
+// @Failure      400     {object}  common.ErrResponse            "Invalid Payload or Logic Error (e.g., End date before Start date, invalid recurrence)"
 // @Failure      401     {object}  common.ErrResponse            "User not authenticated"
-// @Failure      403     {object}  common.ErrResponse            "Permission denied (User does not reside in this apartment)"
+// @Failure      403     {object}  common.ErrResponse            "Permission denied (User does not reside in this apartment) or guest plate blocked"
 // @Failure      409     {object}  common.ErrResponse            "Conflict (Condominium or Apartment not found)"
 // @Failure      422     {object}  common.ValidationErrResponse  "Validation Failed"
 // @Failure      500     {object}  common.ErrResponse            "Internal server error"
@@ -76,16 +84,21 @@ func (h *CreateInviteHandler) Handle(w http.ResponseWriter, r *http.Request) {
 	}
 
 	payload := usecases.CreateInviteReq{
-		CondominiumID: data.CondominiumID,
-		ApartmentID:   data.ApartmentID,
-		IssuedBy:      userId,
-		GuestName:     data.GuestName,
-		GuestType:     data.GuestType,
-		StartsAt:      data.StartsAt,
-		EndsAt:        data.EndsAt,
+		CondominiumID:  data.CondominiumID,
+		ApartmentID:    data.ApartmentID,
+		IssuedBy:       userId,
+		GuestName:      data.GuestName,
+		GuestType:      data.GuestType,
+		StartsAt:       data.StartsAt,
+		EndsAt:         data.EndsAt,
+		Weekdays:       data.Weekdays,
+		DailyStartTime: data.DailyStartTime,
+		DailyEndTime:   data.DailyEndTime,
+		MaxEntries:     data.MaxEntries,
+		GuestPlate:     data.GuestPlate,
 	}
 
-	invite, err := h.CreateInvite.Exec(r.Context(), payload)
+	result, err := h.CreateInvite.Exec(r.Context(), payload)
 	if err != nil {
 		slog.Error("Error while creating invite",
 			"error", err,
@@ -100,6 +113,11 @@ func (h *CreateInviteHandler) Handle(w http.ResponseWriter, r *http.Request) {
 				Message: err.Error(),
 			})
 
+		case errors.Is(err, usecases.ErrVisitorBlocked):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Guest plate is on the condominium blocklist",
+			})
+
 		case errors.Is(err, usecases.ErrGuestNameIsRequired):
 			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
 				Message: err.Error(),
@@ -110,6 +128,13 @@ func (h *CreateInviteHandler) Handle(w http.ResponseWriter, r *http.Request) {
 				Message: err.Error(),
 			})
 
+		case errors.Is(err, usecases.ErrInvalidRecurrence),
+			errors.Is(err, usecases.ErrInvalidMaxEntries),
+			errors.Is(err, usecases.ErrInvalidPlate):
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: err.Error(),
+			})
+
 		case errors.Is(err, usecases.ErrInviteInThePast):
 			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
 				Message: err.Error(),
@@ -136,8 +161,13 @@ func (h *CreateInviteHandler) Handle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	jsonutils.EncodeJson(w, r, http.StatusCreated, CreateInviteResponse{
+	resp := CreateInviteResponse{
 		Message: "Invite successfully created",
-		Invite:  invite,
-	})
+		Invite:  result.Invite,
+	}
+	if result.GuestNameBlocked {
+		resp.Warning = utils.ToPtr("Guest name matches the condominium blocklist; entry may be refused at the gate")
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusCreated, resp)
 }
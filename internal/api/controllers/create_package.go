@@ -18,20 +18,28 @@ type CreatePackageHandler struct {
 }
 
 type CreatePackageRequest struct {
-	CondominiumID uuid.UUID `json:"condominiumId" validate:"required"`
-	ApartmentID   uuid.UUID `json:"apartmentId" validate:"required"`
-	RecipientName *string   `json:"recipientName"`
-	PhotoUrl      *string   `json:"photoUrl"`
+	CondominiumID   uuid.UUID `json:"condominiumId" validate:"required"`
+	ApartmentID     uuid.UUID `json:"apartmentId" validate:"required"`
+	RecipientName   *string   `json:"recipientName"`
+	PhotoUrl        *string   `json:"photoUrl"`
+	Carrier         *string   `json:"carrier" validate:"omitempty,max=50"`
+	TrackingCode    *string   `json:"trackingCode" validate:"omitempty,max=100"`
+	SizeCategory    *string   `json:"sizeCategory" validate:"omitempty,oneof=envelope small medium large extra_large"`
+	IsPerishable    bool      `json:"isPerishable"`
+	IsFragile       bool      `json:"isFragile"`
+	StorageLocation *string   `json:"storageLocation" validate:"omitempty,max=50"`
 }
 
 type CreatePackageResponse struct {
-	Message   string    `json:"message"`
-	PackageID uuid.UUID `json:"packageId"`
+	Message     string      `json:"message"`
+	PackageID   uuid.UUID   `json:"packageId"`
+	Warning     *string     `json:"warning,omitempty"`
+	DuplicateOf []uuid.UUID `json:"duplicateOf,omitempty"`
 }
 
 // Handle creates a new package entry
 // @Summary      Register new package
-// @Description  Registers a new package arrival at the concierge. Triggers a push notification to the apartment residents.
+// @Description  Registers a new package arrival at the concierge. Triggers a push notification to the apartment residents. If the tracking code was already registered in the condominium the package is still created, but a warning and the previous package IDs are returned.
 // @Tags         Packages
 // @Accept       json
 // @Produce      json
@@ -70,14 +78,20 @@ func (h *CreatePackageHandler) Handle(w http.ResponseWriter, r *http.Request) {
 	}
 
 	payload := usecases.CreatePackageReq{
-		CondominiumID: data.CondominiumID,
-		ApartmentID:   data.ApartmentID,
-		ReceivedBy:    userId,
-		RecipientName: data.RecipientName,
-		PhotoUrl:      data.PhotoUrl,
+		CondominiumID:   data.CondominiumID,
+		ApartmentID:     data.ApartmentID,
+		ReceivedBy:      userId,
+		RecipientName:   data.RecipientName,
+		PhotoUrl:        data.PhotoUrl,
+		Carrier:         data.Carrier,
+		TrackingCode:    data.TrackingCode,
+		SizeCategory:    data.SizeCategory,
+		IsPerishable:    data.IsPerishable,
+		IsFragile:       data.IsFragile,
+		StorageLocation: data.StorageLocation,
 	}
 
-	id, err := h.CreatePackage.Exec(r.Context(), payload)
+	result, err := h.CreatePackage.Exec(r.Context(), payload)
 	if err != nil {
 		slog.Error("Error while creating package",
 			"error", err,
@@ -97,6 +111,11 @@ func (h *CreatePackageHandler) Handle(w http.ResponseWriter, r *http.Request) {
 				Message: "Apartment not found",
 			})
 
+		case errors.Is(err, usecases.ErrInvalidPackageSize):
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: err.Error(),
+			})
+
 		case errors.Is(err, usecases.ErrApartmentIsNotFromCondominium):
 			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
 				Message: "The selected apartment does not belong to this condominium",
@@ -110,8 +129,16 @@ func (h *CreatePackageHandler) Handle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	jsonutils.EncodeJson(w, r, http.StatusCreated, CreatePackageResponse{
+	response := CreatePackageResponse{
 		Message:   "Package successfully created",
-		PackageID: id,
-	})
+		PackageID: result.PackageID,
+	}
+
+	if len(result.DuplicateOf) > 0 {
+		warning := "A package with this tracking code was already registered. Check for a duplicate scan."
+		response.Warning = &warning
+		response.DuplicateOf = result.DuplicateOf
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusCreated, response)
 }
@@ -0,0 +1,169 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/Bellorico323/vizen/internal/validator"
+	"github.com/google/uuid"
+)
+
+type CreatePackagesBatchHandler struct {
+	CreatePackagesBatch usecases.CreatePackagesBatchUC
+}
+
+type CreatePackagesBatchItem struct {
+	ApartmentID     uuid.UUID `json:"apartmentId" validate:"required"`
+	RecipientName   *string   `json:"recipientName"`
+	PhotoUrl        *string   `json:"photoUrl"`
+	Carrier         *string   `json:"carrier" validate:"omitempty,max=50"`
+	TrackingCode    *string   `json:"trackingCode" validate:"omitempty,max=100"`
+	SizeCategory    *string   `json:"sizeCategory" validate:"omitempty,oneof=envelope small medium large extra_large"`
+	IsPerishable    bool      `json:"isPerishable"`
+	IsFragile       bool      `json:"isFragile"`
+	StorageLocation *string   `json:"storageLocation" validate:"omitempty,max=50"`
+}
+
+type CreatePackagesBatchRequest struct {
+	CondominiumID uuid.UUID                 `json:"condominiumId" validate:"required"`
+	Items         []CreatePackagesBatchItem `json:"items" validate:"required,min=1,max=100,dive"`
+}
+
+type CreatePackagesBatchItemResponse struct {
+	Index       int         `json:"index"`
+	PackageID   *uuid.UUID  `json:"packageId,omitempty"`
+	Error       *string     `json:"error,omitempty"`
+	Warning     *string     `json:"warning,omitempty"`
+	DuplicateOf []uuid.UUID `json:"duplicateOf,omitempty"`
+}
+
+type CreatePackagesBatchResponse struct {
+	Created int                               `json:"created"`
+	Failed  int                               `json:"failed"`
+	Results []CreatePackagesBatchItemResponse `json:"results"`
+}
+
+// Handle registers several packages at once
+// @Summary      Register packages in batch
+// @Description  Registers up to 100 packages in a single transaction. Each item is reported individually: items with an unknown apartment or invalid size are skipped and returned with an error, the others are created. Residents receive a single push per apartment (e.g. "3 encomendas chegaram") instead of one per package. Returns 422 when no item could be created.
+// @Tags         Packages
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body controllers.CreatePackagesBatchRequest true "Packages to register"
+// @Success      201  {object}  controllers.CreatePackagesBatchResponse
+// @Failure      400  {object}  common.ErrResponse             "Invalid JSON payload"
+// @Failure      401  {object}  common.ErrResponse             "User not authenticated"
+// @Failure      403  {object}  common.ErrResponse             "Permission denied"
+// @Failure      422  {object}  controllers.CreatePackagesBatchResponse "Validation failed or no package created"
+// @Failure      500  {object}  common.ErrResponse             "Internal server error"
+// @Router       /packages/batch [post]
+func (h *CreatePackagesBatchHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	data, err := jsonutils.DecodeJson[CreatePackagesBatchRequest](r)
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid JSON payload",
+		})
+		return
+	}
+
+	if validationErrors := validator.ValidateStruct(data); len(validationErrors) > 0 {
+		jsonutils.EncodeJson(w, r, http.StatusUnprocessableEntity, common.ValidationErrResponse{
+			Message: "Validation failed",
+			Errors:  validationErrors,
+		})
+		return
+	}
+
+	userId, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	items := make([]usecases.CreatePackageReq, len(data.Items))
+	for i, item := range data.Items {
+		items[i] = usecases.CreatePackageReq{
+			ApartmentID:     item.ApartmentID,
+			RecipientName:   item.RecipientName,
+			PhotoUrl:        item.PhotoUrl,
+			Carrier:         item.Carrier,
+			TrackingCode:    item.TrackingCode,
+			SizeCategory:    item.SizeCategory,
+			IsPerishable:    item.IsPerishable,
+			IsFragile:       item.IsFragile,
+			StorageLocation: item.StorageLocation,
+		}
+	}
+
+	results, err := h.CreatePackagesBatch.Exec(r.Context(), usecases.CreatePackagesBatchReq{
+		CondominiumID: data.CondominiumID,
+		ReceivedBy:    userId,
+		Items:         items,
+	})
+	if err != nil {
+		slog.Error("Error while creating packages batch",
+			"error", err,
+			"condo_id", data.CondominiumID,
+			"user_id", userId,
+		)
+
+		switch {
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "User does not have permission to create packages in this condominium",
+			})
+		case errors.Is(err, usecases.ErrEmptyPackageBatch),
+			errors.Is(err, usecases.ErrPackageBatchTooLarge):
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: err.Error(),
+			})
+		default:
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "An unexpected error occured while creating packages",
+			})
+		}
+		return
+	}
+
+	response := CreatePackagesBatchResponse{
+		Results: make([]CreatePackagesBatchItemResponse, len(results)),
+	}
+
+	for i, result := range results {
+		item := CreatePackagesBatchItemResponse{
+			Index:     i,
+			PackageID: result.PackageID,
+		}
+
+		if result.Err != nil {
+			message := result.Err.Error()
+			item.Error = &message
+			response.Failed++
+		} else {
+			response.Created++
+		}
+
+		if len(result.DuplicateOf) > 0 {
+			warning := "A package with this tracking code was already registered. Check for a duplicate scan."
+			item.Warning = &warning
+			item.DuplicateOf = result.DuplicateOf
+		}
+
+		response.Results[i] = item
+	}
+
+	status := http.StatusCreated
+	if response.Created == 0 {
+		status = http.StatusUnprocessableEntity
+	}
+
+	jsonutils.EncodeJson(w, r, status, response)
+}
@@ -0,0 +1,129 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/Bellorico323/vizen/internal/validator"
+	"github.com/google/uuid"
+)
+
+type CreatePickupAuthorizationHandler struct {
+	CreatePickupAuthorization usecases.CreatePickupAuthorizationUC
+}
+
+type CreatePickupAuthorizationRequest struct {
+	ApartmentID uuid.UUID  `json:"apartmentId" validate:"required,uuid4"`
+	PackageID   *uuid.UUID `json:"packageId"`
+	Name        string     `json:"name" validate:"required,min=1"`
+	Document    string     `json:"document" validate:"required,min=1"`
+	ValidFrom   *time.Time `json:"validFrom"`
+	ValidUntil  time.Time  `json:"validUntil" validate:"required"`
+}
+
+type CreatePickupAuthorizationResponse struct {
+	Message       string                             `json:"message"`
+	Authorization pgstore.PackagePickupAuthorization `json:"authorization"`
+}
+
+// Handle authorizes a third party to collect packages
+// @Summary      Create Pickup Authorization
+// @Description  Allows a resident to authorize a third party (family member, neighbour, driver) to collect packages at the desk. The authorization can target a single package or every package of the apartment during the validity period.
+// @Tags         Packages
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body      controllers.CreatePickupAuthorizationRequest true "Authorization data"
+// @Success      201     {object}  controllers.CreatePickupAuthorizationResponse
+// @Failure      400     {object}  common.ErrResponse            "Invalid Payload or validity period"
+// @Failure      401     {object}  common.ErrResponse            "User not authenticated"
+// @Failure      403     {object}  common.ErrResponse            "Permission denied (User does not reside in this apartment)"
+// @Failure      404     {object}  common.ErrResponse            "Apartment or package not found"
+// @Failure      409     {object}  common.ErrResponse            "Package already withdrawn"
+// @Failure      422     {object}  common.ValidationErrResponse  "Validation Failed"
+// @Failure      500     {object}  common.ErrResponse            "Internal server error"
+// @Router       /pickup_authorizations [post]
+func (h *CreatePickupAuthorizationHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	data, err := jsonutils.DecodeJson[CreatePickupAuthorizationRequest](r)
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid JSON payload",
+		})
+		return
+	}
+
+	if validationErrors := validator.ValidateStruct(data); len(validationErrors) > 0 {
+		jsonutils.EncodeJson(w, r, http.StatusUnprocessableEntity, common.ValidationErrResponse{
+			Message: "Validation failed",
+			Errors:  validationErrors,
+		})
+		return
+	}
+
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	authorization, err := h.CreatePickupAuthorization.Exec(r.Context(), usecases.CreatePickupAuthorizationReq{
+		UserID:      userID,
+		ApartmentID: data.ApartmentID,
+		PackageID:   data.PackageID,
+		Name:        data.Name,
+		Document:    data.Document,
+		ValidFrom:   data.ValidFrom,
+		ValidUntil:  data.ValidUntil,
+	})
+	if err != nil {
+		slog.Error("Error while creating pickup authorization",
+			"error", err,
+			"apartmentId", data.ApartmentID,
+			"userId", userID,
+		)
+
+		switch {
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Only residents of the apartment can authorize package pickups",
+			})
+		case errors.Is(err, usecases.ErrApartmentNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Apartment not found",
+			})
+		case errors.Is(err, usecases.ErrPackageNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Package not found",
+			})
+		case errors.Is(err, usecases.ErrInvalidAuthorizationPeriod),
+			errors.Is(err, usecases.ErrInvalidAuthorizedDocument),
+			errors.Is(err, usecases.ErrPackageNotFromApartment):
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: err.Error(),
+			})
+		case errors.Is(err, usecases.ErrPackageAlreadyWithdrawn):
+			jsonutils.EncodeJson(w, r, http.StatusConflict, common.ErrResponse{
+				Message: "Package has already been withdrawn",
+			})
+		default:
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to create pickup authorization",
+			})
+		}
+		return
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusCreated, CreatePickupAuthorizationResponse{
+		Message:       "Pickup authorization successfully created",
+		Authorization: authorization,
+	})
+}
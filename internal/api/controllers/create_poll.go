@@ -0,0 +1,127 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/Bellorico323/vizen/internal/validator"
+	"github.com/google/uuid"
+)
+
+type CreatePollHandler struct {
+	CreatePoll usecases.CreatePollUC
+}
+
+type CreatePollRequest struct {
+	CondominiumID     uuid.UUID  `json:"condominiumId" validate:"required"`
+	Title             string     `json:"title" validate:"required,min=3,max=150"`
+	Description       *string    `json:"description"`
+	ChoiceType        string     `json:"choiceType" validate:"omitempty,oneof=single multiple"`
+	IsAnonymous       bool       `json:"isAnonymous"`
+	IsWeighted        bool       `json:"isWeighted"`
+	ResultsVisibility string     `json:"resultsVisibility" validate:"omitempty,oneof=live after_close"`
+	OpensAt           *time.Time `json:"opensAt"`
+	ClosesAt          time.Time  `json:"closesAt" validate:"required"`
+	Options           []string   `json:"options" validate:"required,min=2,dive,required,max=150"`
+}
+
+type CreatePollResponse struct {
+	Message string               `json:"message"`
+	Poll    usecases.PollDetails `json:"poll"`
+}
+
+// Handle creates a new poll
+// @Summary      Create Poll
+// @Description  Creates a poll for the condominium. Only Admin/Syndic can perform this action. Votes are counted once per apartment, optionally weighted by the apartment voting weight. Residents are notified when the poll opens.
+// @Tags         Polls
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body      controllers.CreatePollRequest true "Poll Data"
+// @Success      201     {object}  controllers.CreatePollResponse
+// @Failure      400     {object}  common.ErrResponse            "Invalid payload or period"
+// @Failure      401     {object}  common.ErrResponse            "User not authenticated"
+// @Failure      403     {object}  common.ErrResponse            "Permission denied"
+// @Failure      422     {object}  common.ValidationErrResponse  "Validation failed"
+// @Failure      500     {object}  common.ErrResponse            "Internal server error"
+// @Router       /polls [post]
+func (h *CreatePollHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	data, err := jsonutils.DecodeJson[CreatePollRequest](r)
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid JSON payload",
+		})
+		return
+	}
+
+	if validationErrors := validator.ValidateStruct(data); len(validationErrors) > 0 {
+		jsonutils.EncodeJson(w, r, http.StatusUnprocessableEntity, common.ValidationErrResponse{
+			Message: "Validation failed",
+			Errors:  validationErrors,
+		})
+		return
+	}
+
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	poll, err := h.CreatePoll.Exec(r.Context(), usecases.CreatePollReq{
+		CondominiumID:     data.CondominiumID,
+		UserID:            userID,
+		Title:             data.Title,
+		Description:       data.Description,
+		ChoiceType:        data.ChoiceType,
+		IsAnonymous:       data.IsAnonymous,
+		IsWeighted:        data.IsWeighted,
+		ResultsVisibility: data.ResultsVisibility,
+		OpensAt:           data.OpensAt,
+		ClosesAt:          data.ClosesAt,
+		Options:           data.Options,
+	})
+	if err != nil {
+		slog.Error("Error while creating poll",
+			"error", err,
+			"condominiumId", data.CondominiumID,
+			"userId", userID,
+		)
+
+		switch {
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Only admins or syndics can create polls",
+			})
+
+		case errors.Is(err, usecases.ErrPollTitleRequired),
+			errors.Is(err, usecases.ErrPollNotEnoughOptions),
+			errors.Is(err, usecases.ErrPollDuplicatedOption),
+			errors.Is(err, usecases.ErrPollInvalidPeriod),
+			errors.Is(err, usecases.ErrInvalidPollChoiceType),
+			errors.Is(err, usecases.ErrInvalidResultsVisibility):
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: err.Error(),
+			})
+
+		default:
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to create poll",
+			})
+		}
+		return
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusCreated, CreatePollResponse{
+		Message: "Poll created successfully",
+		Poll:    poll,
+	})
+}
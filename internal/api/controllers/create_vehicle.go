@@ -0,0 +1,105 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/Bellorico323/vizen/internal/validator"
+	"github.com/google/uuid"
+)
+
+type CreateVehicleHandler struct {
+	CreateVehicle usecases.CreateVehicleUC
+}
+
+type CreateVehicleRequest struct {
+	ApartmentID uuid.UUID `json:"apartmentId" validate:"required,uuid4"`
+	Plate       string    `json:"plate" validate:"required"`
+	Model       string    `json:"model" validate:"required,max=100"`
+	Color       string    `json:"color" validate:"required,max=50"`
+}
+
+// Handle registers a vehicle for an apartment
+// @Summary      Create Vehicle
+// @Description  Registers a resident vehicle so it can enter by plate. Plates are accepted in the legacy (ABC-1234) or Mercosul (ABC1D23) format; separators are ignored. Available to residents of the apartment and condominium staff.
+// @Tags         Vehicles
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body      controllers.CreateVehicleRequest true "Vehicle data"
+// @Success      201     {object}  controllers.VehicleItem
+// @Failure      400     {object}  common.ErrResponse            "Invalid Payload or plate"
+// @Failure      401     {object}  common.ErrResponse            "User not authenticated"
+// @Failure      403     {object}  common.ErrResponse            "Permission denied"
+// @Failure      404     {object}  common.ErrResponse            "Apartment not found"
+// @Failure      409     {object}  common.ErrResponse            "Plate already registered"
+// @Failure      422     {object}  common.ValidationErrResponse  "Validation Failed"
+// @Failure      500     {object}  common.ErrResponse            "Internal server error"
+// @Router       /vehicles [post]
+func (h *CreateVehicleHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	data, err := jsonutils.DecodeJson[CreateVehicleRequest](r)
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid JSON payload",
+		})
+		return
+	}
+
+	if validationErrors := validator.ValidateStruct(data); len(validationErrors) > 0 {
+		jsonutils.EncodeJson(w, r, http.StatusUnprocessableEntity, common.ValidationErrResponse{
+			Message: "Validation failed",
+			Errors:  validationErrors,
+		})
+		return
+	}
+
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	vehicle, err := h.CreateVehicle.Exec(r.Context(), usecases.CreateVehicleReq{
+		UserID:      userID,
+		ApartmentID: data.ApartmentID,
+		Plate:       data.Plate,
+		Model:       data.Model,
+		Color:       data.Color,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrInvalidPlate),
+			errors.Is(err, usecases.ErrVehicleModelRequired):
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: err.Error(),
+			})
+		case errors.Is(err, usecases.ErrApartmentNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Apartment not found",
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "You do not have permission to register vehicles for this apartment",
+			})
+		case errors.Is(err, usecases.ErrVehicleAlreadyRegistered):
+			jsonutils.EncodeJson(w, r, http.StatusConflict, common.ErrResponse{
+				Message: err.Error(),
+			})
+		default:
+			slog.Error("failed to create vehicle", "error", err, "apartmentId", data.ApartmentID)
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to create vehicle",
+			})
+		}
+		return
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusCreated, toVehicleItem(vehicle))
+}
@@ -0,0 +1,135 @@
+package controllers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/Bellorico323/vizen/internal/utils"
+	"github.com/google/uuid"
+)
+
+type CreateVisitorRequestHandler struct {
+	CreateVisitorRequest usecases.CreateVisitorRequestUC
+	MaxUploadBytes       int64
+}
+
+// Handle registers a walk-in visitor at the front desk
+// @Summary      Register Walk-in Visitor
+// @Description  Registers a visitor that arrived without an invite, as multipart/form-data. Residents of the apartment get a push to approve or deny; unanswered requests expire after a few minutes. The photo is optional and must be JPEG or PNG. Visitors whose name or document is on the condominium blocklist are refused and the staff warned. Staff only.
+// @Tags         Visitors
+// @Accept       multipart/form-data
+// @Produce      json
+// @Security     BearerAuth
+// @Param        apartmentId     formData  string  true   "Apartment UUID"
+// @Param        visitorName     formData  string  true   "Visitor name"
+// @Param        visitorDocument formData  string  false  "Visitor document"
+// @Param        photo           formData  file    false  "Visitor photo"
+// @Success      201             {object}  controllers.VisitorRequestItem
+// @Failure      400             {object}  common.ErrResponse "Invalid payload"
+// @Failure      401             {object}  common.ErrResponse "User not authenticated"
+// @Failure      403             {object}  common.ErrResponse "Permission denied or visitor blocked"
+// @Failure      404             {object}  common.ErrResponse "Apartment not found"
+// @Failure      413             {object}  common.ErrResponse "File too large"
+// @Failure      415             {object}  common.ErrResponse "Unsupported file type"
+// @Failure      500             {object}  common.ErrResponse "Internal server error"
+// @Router       /visitor_requests [post]
+func (h *CreateVisitorRequestHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, h.MaxUploadBytes)
+	if err := r.ParseMultipartForm(h.MaxUploadBytes); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			jsonutils.EncodeJson(w, r, http.StatusRequestEntityTooLarge, common.ErrResponse{
+				Message: "File too large",
+			})
+			return
+		}
+
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid multipart payload",
+		})
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	apartmentID, err := uuid.Parse(r.FormValue("apartmentId"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid apartmentId",
+		})
+		return
+	}
+
+	var photo []byte
+	file, _, err := r.FormFile("photo")
+	switch {
+	case err == nil:
+		defer file.Close()
+
+		photo, err = io.ReadAll(file)
+		if err != nil {
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: "Failed to read photo file",
+			})
+			return
+		}
+	case !errors.Is(err, http.ErrMissingFile):
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid photo file",
+		})
+		return
+	}
+
+	visitor, err := h.CreateVisitorRequest.Exec(r.Context(), usecases.CreateVisitorRequestReq{
+		UserID:          userID,
+		ApartmentID:     apartmentID,
+		VisitorName:     r.FormValue("visitorName"),
+		VisitorDocument: utils.ToNullString(r.FormValue("visitorDocument")),
+		Photo:           photo,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrVisitorNameRequired):
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: err.Error(),
+			})
+		case errors.Is(err, usecases.ErrApartmentNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Apartment not found",
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Only condominium staff can register visitors",
+			})
+		case errors.Is(err, usecases.ErrVisitorBlocked):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Visitor is on the condominium blocklist",
+			})
+		case errors.Is(err, usecases.ErrUnsupportedFileType):
+			jsonutils.EncodeJson(w, r, http.StatusUnsupportedMediaType, common.ErrResponse{
+				Message: err.Error(),
+			})
+		default:
+			slog.Error("failed to create visitor request", "error", err, "apartmentId", apartmentID)
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to register visitor",
+			})
+		}
+		return
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusCreated, toVisitorRequestItem(visitor.VisitorRequest, visitor.PhotoURL))
+}
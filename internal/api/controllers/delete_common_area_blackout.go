@@ -0,0 +1,89 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type DeleteCommonAreaBlackoutHandler struct {
+	DeleteCommonAreaBlackout usecases.DeleteCommonAreaBlackoutUC
+}
+
+// Handle removes a blackout from a common area
+// @Summary      Delete Common Area Blackout
+// @Description  Reopens a common area for bookings during a blackout. Bookings cancelled by the blackout stay cancelled. Only Admin/Syndic can perform this action.
+// @Tags         Common Areas
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id          path  string  true  "Common Area UUID"
+// @Param        blackoutId  path  string  true  "Blackout UUID"
+// @Success      204  "No Content"
+// @Failure      400  {object}  common.ErrResponse  "Invalid ID"
+// @Failure      401  {object}  common.ErrResponse  "User not authenticated"
+// @Failure      403  {object}  common.ErrResponse  "Permission denied"
+// @Failure      404  {object}  common.ErrResponse  "Common area or blackout not found"
+// @Failure      500  {object}  common.ErrResponse  "Internal server error"
+// @Router       /common_areas/{id}/blackouts/{blackoutId} [delete]
+func (h *DeleteCommonAreaBlackoutHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	areaID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid common area ID format",
+		})
+		return
+	}
+
+	blackoutID, err := uuid.Parse(chi.URLParam(r, "blackoutId"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid blackout ID format",
+		})
+		return
+	}
+
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	err = h.DeleteCommonAreaBlackout.Exec(r.Context(), usecases.DeleteCommonAreaBlackoutReq{
+		UserID:       userID,
+		CommonAreaID: areaID,
+		BlackoutID:   blackoutID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrCommonAreaNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Common area not found",
+			})
+		case errors.Is(err, usecases.ErrBlackoutNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Blackout not found",
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Only admins or syndics can reopen common areas",
+			})
+		default:
+			slog.Error("failed to delete common area blackout", "error", err, "blackoutId", blackoutID)
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to delete blackout",
+			})
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
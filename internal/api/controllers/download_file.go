@@ -0,0 +1,74 @@
+package controllers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"path"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/services"
+	"github.com/go-chi/chi/v5"
+)
+
+type DownloadFileHandler struct {
+	Storage services.StorageService
+	Signer  *auth.URLSigner
+}
+
+// Handle streams a stored file referenced by a signed URL
+// @Summary      Download file
+// @Description  Serves a stored file. Does not require a Bearer token, but the URL must carry a valid, unexpired signature as returned by the API.
+// @Tags         Files
+// @Produce      octet-stream
+// @Param        key        path      string  true  "Object key"
+// @Param        expires    query     int     true  "Unix expiration timestamp"
+// @Param        signature  query     string  true  "URL signature"
+// @Success      200        {file}    file
+// @Failure      403        {object}  common.ErrResponse "Invalid or expired signature"
+// @Failure      404        {object}  common.ErrResponse "File not found"
+// @Failure      500        {object}  common.ErrResponse "Internal server error"
+// @Router       /files/{key} [get]
+func (h *DownloadFileHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	key := chi.URLParam(r, "*")
+	query := r.URL.Query()
+
+	if err := h.Signer.Verify(key, query.Get("expires"), query.Get("signature")); err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+			Message: "Invalid or expired link",
+		})
+		return
+	}
+
+	body, err := h.Storage.Get(r.Context(), key)
+	if err != nil {
+		if errors.Is(err, services.ErrObjectNotFound) {
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "File not found",
+			})
+			return
+		}
+
+		slog.Error("Error while reading stored file", "error", err, "key", key)
+		jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+			Message: "Failed to read file",
+		})
+		return
+	}
+	defer body.Close()
+
+	contentType := mime.TypeByExtension(path.Ext(key))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", "private, max-age=300")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	io.Copy(w, body)
+}
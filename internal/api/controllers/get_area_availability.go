@@ -1,10 +1,15 @@
 package controllers
 
 import (
+	"errors"
+	"log/slog"
+	"math"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
 	"github.com/Bellorico323/vizen/internal/jsonutils"
 	"github.com/Bellorico323/vizen/internal/store/pgstore"
 	"github.com/Bellorico323/vizen/internal/usecases"
@@ -16,13 +21,24 @@ type GetAreaAvailabilityHandler struct {
 	GetAreaAvailability usecases.GetAreaAvailabilityUC
 }
 
+type TimeSlotItem struct {
+	StartsAt time.Time `json:"startsAt"`
+	EndsAt   time.Time `json:"endsAt"`
+	// RemainingCapacity is only set for areas allowing overlapping bookings.
+	RemainingCapacity *int32 `json:"remainingCapacity,omitempty"`
+}
+
 type GetAreaAvailabilityResponse struct {
-	Bookings []pgstore.GetAreaAvailabilityRow `json:"bookings"`
+	Timezone    string                           `json:"timezone"`
+	SlotMinutes int32                            `json:"slotMinutes"`
+	Bookings    []pgstore.GetAreaAvailabilityRow `json:"bookings"`
+	Blackouts   []pgstore.CommonAreaBlackout     `json:"blackouts"`
+	FreeSlots   []TimeSlotItem                   `json:"freeSlots"`
 }
 
-// Handle lists occupied slots for a common area
+// Handle lists occupied and free slots for a common area
 // @Summary      Get Area Availability
-// @Description  Returns a list of occupied time slots for a specific common area within a date range. Used to populate calendars.
+// @Description  Returns the bookings overlapping a date range (including the ones straddling its edges) the ranges held for waitlist offers (status "held"), the blackout periods closing the area and the free slots a new booking can use, at the requested granularity. Free slots respect the opening hours, the blackouts, the cool-down between bookings and the advance booking limit of the area; consecutive slots can be combined into one booking. On areas allowing overlapping bookings a slot is free while it has room for the headcount, and reports the remaining capacity. The range can cover at most 31 days. Open to all members.
 // @Tags         Common Areas
 // @Accept       json
 // @Produce      json
@@ -30,9 +46,13 @@ type GetAreaAvailabilityResponse struct {
 // @Param        id    path      string  true  "Common Area UUID"
 // @Param        from  query     string  true  "Start Date (ISO 8601 e.g. 2026-01-01T00:00:00Z)"
 // @Param        to    query     string  true  "End Date (ISO 8601)"
+// @Param        slotMinutes query int  false  "Slot granularity in minutes (default 30)"
+// @Param        headcount   query int  false  "People the slots must have room for, on areas allowing overlapping bookings (default 1)"
 // @Success      200   {object}  controllers.GetAreaAvailabilityResponse
-// @Failure      400   {object}  common.ErrResponse "Invalid ID or Date format"
+// @Failure      400   {object}  common.ErrResponse "Invalid ID, date range or slot size"
 // @Failure      401   {object}  common.ErrResponse "Unauthorized"
+// @Failure      403   {object}  common.ErrResponse "User is not a member of the condominium"
+// @Failure      404   {object}  common.ErrResponse "Common area not found"
 // @Failure      500   {object}  common.ErrResponse "Internal Server Error"
 // @Router       /common-areas/{id}/availability [get]
 func (h *GetAreaAvailabilityHandler) Handle(w http.ResponseWriter, r *http.Request) {
@@ -71,24 +91,83 @@ func (h *GetAreaAvailabilityHandler) Handle(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	bookings, err := h.GetAreaAvailability.Exec(r.Context(), usecases.GetAreaAvailabilityReq{
+	var slotMinutes int
+	if slotStr := r.URL.Query().Get("slotMinutes"); slotStr != "" {
+		slotMinutes, err = strconv.Atoi(slotStr)
+		if err != nil || slotMinutes <= 0 {
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: "Invalid slotMinutes",
+			})
+			return
+		}
+	}
+
+	var headcount int
+	if headcountStr := r.URL.Query().Get("headcount"); headcountStr != "" {
+		headcount, err = strconv.Atoi(headcountStr)
+		if err != nil || headcount <= 0 || headcount > math.MaxInt32 {
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: "Invalid headcount",
+			})
+			return
+		}
+	}
+
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	availability, err := h.GetAreaAvailability.Exec(r.Context(), usecases.GetAreaAvailabilityReq{
+		UserID:       userID,
 		CommonAreaID: commonAreaID,
 		FromDate:     fromDate,
 		ToDate:       toDate,
+		SlotMinutes:  int32(min(slotMinutes, 24*60+1)),
+		Headcount:    int32(headcount),
 	})
 	if err != nil {
-		jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
-			Message: "Failed to fetch bookings",
-		})
+		switch {
+		case errors.Is(err, usecases.ErrInvalidAvailabilityRange),
+			errors.Is(err, usecases.ErrInvalidSlotSize),
+			errors.Is(err, usecases.ErrInvalidHeadcount):
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: err.Error(),
+			})
+		case errors.Is(err, usecases.ErrCommonAreaNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Common area not found",
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Permission denied",
+			})
+		default:
+			slog.Error("failed to compute area availability", "error", err, "commonAreaId", commonAreaID)
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to fetch availability",
+			})
+		}
 		return
 	}
 
-	if bookings == nil {
-		bookings = []pgstore.GetAreaAvailabilityRow{}
+	resp := GetAreaAvailabilityResponse{
+		Timezone:    availability.Timezone,
+		SlotMinutes: availability.SlotMinutes,
+		Bookings:    availability.Bookings,
+		Blackouts:   availability.Blackouts,
+		FreeSlots:   make([]TimeSlotItem, len(availability.FreeSlots)),
+	}
+	for i, slot := range availability.FreeSlots {
+		resp.FreeSlots[i] = TimeSlotItem{
+			StartsAt:          slot.StartsAt,
+			EndsAt:            slot.EndsAt,
+			RemainingCapacity: slot.RemainingCapacity,
+		}
 	}
 
-	jsonutils.EncodeJson(w, r, http.StatusOK, GetAreaAvailabilityResponse{
-		Bookings: bookings,
-	})
-
+	jsonutils.EncodeJson(w, r, http.StatusOK, resp)
 }
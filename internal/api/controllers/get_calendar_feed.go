@@ -0,0 +1,59 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/Bellorico323/vizen/internal/utils"
+	"github.com/go-chi/chi/v5"
+)
+
+// calendarFeedRefresh is how often subscribed calendar apps are asked to poll the feed.
+const calendarFeedRefresh = time.Hour
+
+type GetCalendarFeedHandler struct {
+	GetCalendarFeed usecases.GetCalendarFeedUC
+}
+
+// Handle serves an iCalendar feed
+// @Summary      Calendar Feed (ICS)
+// @Description  Public iCalendar feed opened from a feed URL. Does not require a Bearer token: the code carries a signature and stops working once the feed is revoked. Covers the bookings of the last 90 days onwards, with cancelled and denied bookings marked as cancelled. Times are in UTC and the condominium timezone is advertised through X-WR-TIMEZONE.
+// @Tags         Calendar
+// @Produce      text/calendar
+// @Param        code  path      string  true  "Signed feed code, optionally ending in .ics"
+// @Success      200   {string}  string  "iCalendar document"
+// @Failure      404   {object}  common.ErrResponse "Calendar feed not found"
+// @Failure      500   {object}  common.ErrResponse "Internal server error"
+// @Router       /calendar/{code} [get]
+func (h *GetCalendarFeedHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	code := strings.TrimSuffix(chi.URLParam(r, "code"), ".ics")
+
+	doc, err := h.GetCalendarFeed.Exec(r.Context(), usecases.GetCalendarFeedReq{Code: code})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrCalendarFeedNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Calendar feed not found",
+			})
+		default:
+			slog.Error("failed to load calendar feed", "error", err)
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to load calendar feed",
+			})
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", `inline; filename="vizen.ics"`)
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Referrer-Policy", "no-referrer")
+	w.WriteHeader(http.StatusOK)
+	w.Write(utils.ICalendar(doc.Name, doc.Timezone, calendarFeedRefresh, doc.Events))
+}
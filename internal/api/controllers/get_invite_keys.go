@@ -0,0 +1,24 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+)
+
+type GetInviteKeysHandler struct {
+	Signer *auth.InviteTokenSigner
+}
+
+// Handle publishes the invite token key set
+// @Summary      Get Invite Keys
+// @Description  Public JSON Web Key Set with the Ed25519 keys that sign offline invite tokens. Gate devices cache it and pick the key by the token kid header. Keys being rotated out stay listed while tokens signed with them are valid.
+// @Tags         Invites
+// @Produce      json
+// @Success      200  {object}  auth.JWKS
+// @Router       /.well-known/jwks.json [get]
+func (h *GetInviteKeysHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	jsonutils.EncodeJson(w, r, http.StatusOK, h.Signer.KeySet())
+}
@@ -0,0 +1,90 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type GetInviteOfflineTokenHandler struct {
+	GetInviteOfflineToken usecases.GetInviteOfflineTokenUC
+}
+
+type GetInviteOfflineTokenResponse struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// Handle issues the offline token of an invite
+// @Summary      Get Invite Offline Token
+// @Description  Issues a compact Ed25519 signed token (JWT, alg EdDSA) carrying the invite ID (sub), condominium (cid), validity window (nbf/exp), entry limit (max) and recurrence (wd/ds/de/tz). Gate devices verify it offline against GET /.well-known/jwks.json and upload the entries later through POST /access_logs/sync. Revocations only reach devices when they are online, so revoked and expired invites get no token. Available to the issuer, residents of the apartment and condominium staff.
+// @Tags         Invites
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Invite UUID"
+// @Success      200  {object}  controllers.GetInviteOfflineTokenResponse
+// @Failure      400  {object}  common.ErrResponse  "Invalid ID or invite revoked/expired"
+// @Failure      401  {object}  common.ErrResponse  "User not authenticated"
+// @Failure      403  {object}  common.ErrResponse  "Permission denied"
+// @Failure      404  {object}  common.ErrResponse  "Invite not found"
+// @Failure      500  {object}  common.ErrResponse  "Internal server error"
+// @Router       /invites/{id}/offline_token [get]
+func (h *GetInviteOfflineTokenHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	inviteID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid invite ID format",
+		})
+		return
+	}
+
+	token, err := h.GetInviteOfflineToken.Exec(r.Context(), usecases.GetInviteOfflineTokenReq{
+		UserID:   userID,
+		InviteID: inviteID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrInviteNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Invite not found",
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Permission denied",
+			})
+		case errors.Is(err, usecases.ErrInviteAlreadyRevoked),
+			errors.Is(err, usecases.ErrInviteExpired):
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: err.Error(),
+			})
+		default:
+			slog.Error("failed to issue invite offline token", "error", err, "inviteId", inviteID)
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to issue offline token",
+			})
+		}
+		return
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusOK, GetInviteOfflineTokenResponse{
+		Token:     token.Token,
+		ExpiresAt: token.ExpiresAt,
+	})
+}
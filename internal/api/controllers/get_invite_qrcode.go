@@ -0,0 +1,97 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type GetInviteQRCodeHandler struct {
+	GetInviteQRCode usecases.GetInviteQRCodeUC
+}
+
+// Handle renders an invite as a QR code
+// @Summary      Get Invite QR Code
+// @Description  Renders the invite token as a QR code image. By default the payload is the bare token, so the doorman app can send the scanned value straight to POST /invites/validate. With payload=signed it carries the offline token gate devices verify against the published key set (revoked and expired invites have none). Available to the issuer, residents of the apartment and condominium staff.
+// @Tags         Invites
+// @Produce      png
+// @Produce      image/svg+xml
+// @Security     BearerAuth
+// @Param        id      path      string  true   "Invite UUID"
+// @Param        format  query     string  false  "Image format: png (default) or svg"
+// @Param        size    query     int     false  "Image size in pixels (128-2048, default 512)"
+// @Param        payload query     string  false  "QR code content: token (default) or signed"
+// @Success      200     {file}    file
+// @Failure      400     {object}  common.ErrResponse  "Invalid ID, format or payload, or invite revoked/expired (signed payload)"
+// @Failure      401     {object}  common.ErrResponse  "User not authenticated"
+// @Failure      403     {object}  common.ErrResponse  "Permission denied"
+// @Failure      404     {object}  common.ErrResponse  "Invite not found"
+// @Failure      500     {object}  common.ErrResponse  "Internal server error"
+// @Router       /invites/{id}/qrcode [get]
+func (h *GetInviteQRCodeHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	inviteID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid invite ID format",
+		})
+		return
+	}
+
+	query := r.URL.Query()
+	size, _ := strconv.Atoi(query.Get("size"))
+
+	qr, err := h.GetInviteQRCode.Exec(r.Context(), usecases.GetInviteQRCodeReq{
+		UserID:   userID,
+		InviteID: inviteID,
+		Format:   query.Get("format"),
+		Size:     size,
+		Payload:  query.Get("payload"),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrInviteNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Invite not found",
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Permission denied",
+			})
+		case errors.Is(err, usecases.ErrInvalidQRCodeFormat),
+			errors.Is(err, usecases.ErrInvalidQRCodePayload),
+			errors.Is(err, usecases.ErrInviteAlreadyRevoked),
+			errors.Is(err, usecases.ErrInviteExpired):
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: err.Error(),
+			})
+		default:
+			slog.Error("failed to render invite qr code", "error", err, "inviteId", inviteID)
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to render QR code",
+			})
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", qr.ContentType)
+	w.Header().Set("Cache-Control", "private, max-age=300")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	w.Write(qr.Content)
+}
@@ -18,19 +18,30 @@ type GetPackageHandler struct {
 }
 
 type GetPackageResponse struct {
-	ID              uuid.UUID  `json:"id"`
-	CondominiumID   uuid.UUID  `json:"condominiumId"`
-	ApartmentID     uuid.UUID  `json:"apartmentId"`
-	Block           *string    `json:"block"`
-	ApartmentNumber string     `json:"apartmentNumber"`
-	ReceivedBy      uuid.UUID  `json:"receivedBy"`
-	ReceivedByName  string     `json:"receivedByName"`
-	ReceivedAt      time.Time  `json:"receivedAt"`
-	RecipientName   *string    `json:"recipientName"`
-	PhotoUrl        *string    `json:"photoUrl"`
-	Status          string     `json:"status"`
-	WithdrawnAt     *time.Time `json:"withdrawnAt"`
-	WithdrawnBy     *uuid.UUID `json:"withdrawnBy"`
+	ID                    uuid.UUID  `json:"id"`
+	CondominiumID         uuid.UUID  `json:"condominiumId"`
+	ApartmentID           uuid.UUID  `json:"apartmentId"`
+	Block                 *string    `json:"block"`
+	ApartmentNumber       string     `json:"apartmentNumber"`
+	ReceivedBy            uuid.UUID  `json:"receivedBy"`
+	ReceivedByName        string     `json:"receivedByName"`
+	ReceivedAt            time.Time  `json:"receivedAt"`
+	RecipientName         *string    `json:"recipientName"`
+	PhotoUrl              *string    `json:"photoUrl"`
+	HasPhoto              bool       `json:"hasPhoto"`
+	Carrier               *string    `json:"carrier"`
+	TrackingCode          *string    `json:"trackingCode"`
+	SizeCategory          *string    `json:"sizeCategory"`
+	IsPerishable          bool       `json:"isPerishable"`
+	IsFragile             bool       `json:"isFragile"`
+	StorageLocation       *string    `json:"storageLocation"`
+	DaysWaiting           int32      `json:"daysWaiting"`
+	CollectedByName       *string    `json:"collectedByName"`
+	CollectedByDocument   *string    `json:"collectedByDocument"`
+	PickupAuthorizationID *uuid.UUID `json:"pickupAuthorizationId"`
+	Status                string     `json:"status"`
+	WithdrawnAt           *time.Time `json:"withdrawnAt"`
+	WithdrawnBy           *uuid.UUID `json:"withdrawnBy"`
 }
 
 // Handle retrieves a specific package by ID
@@ -90,20 +101,42 @@ func (h *GetPackageHandler) Handle(w http.ResponseWriter, r *http.Request) {
 	}
 
 	response := GetPackageResponse{
-		ID:              pkg.ID,
-		CondominiumID:   pkg.CondominiumID,
-		ApartmentID:     pkg.ApartmentID,
-		Block:           pkg.Block,
-		ApartmentNumber: pkg.ApartmentNumber,
-		ReceivedBy:      pkg.ReceivedBy,
-		ReceivedByName:  pkg.ReceivedByName,
-		ReceivedAt:      pkg.ReceivedAt,
-		RecipientName:   pkg.RecipientName,
-		PhotoUrl:        pkg.PhotoUrl,
-		Status:          pkg.Status,
-		WithdrawnAt:     nil,
-		WithdrawnBy:     pkg.WithdrawnBy,
+		ID:                    pkg.ID,
+		CondominiumID:         pkg.CondominiumID,
+		ApartmentID:           pkg.ApartmentID,
+		Block:                 pkg.Block,
+		ApartmentNumber:       pkg.ApartmentNumber,
+		ReceivedBy:            pkg.ReceivedBy,
+		ReceivedByName:        pkg.ReceivedByName,
+		ReceivedAt:            pkg.ReceivedAt,
+		RecipientName:         pkg.RecipientName,
+		PhotoUrl:              pkg.PhotoUrl,
+		HasPhoto:              pkg.PhotoKey != nil,
+		Carrier:               pkg.Carrier,
+		TrackingCode:          pkg.TrackingCode,
+		SizeCategory:          pkg.SizeCategory,
+		IsPerishable:          pkg.IsPerishable,
+		IsFragile:             pkg.IsFragile,
+		StorageLocation:       pkg.StorageLocation,
+		DaysWaiting:           daysWaiting(pkg.ReceivedAt, pkg.WithdrawnAt, pkg.ReturnedAt),
+		CollectedByName:       pkg.CollectedByName,
+		CollectedByDocument:   pkg.CollectedByDocument,
+		PickupAuthorizationID: pkg.PickupAuthorizationID,
+		Status:                pkg.Status,
+		WithdrawnAt:           nil,
+		WithdrawnBy:           pkg.WithdrawnBy,
 	}
 
 	jsonutils.EncodeJson(w, r, http.StatusOK, response)
 }
+
+func daysWaiting(receivedAt time.Time, withdrawnAt, returnedAt *time.Time) int32 {
+	end := time.Now()
+	if withdrawnAt != nil {
+		end = *withdrawnAt
+	} else if returnedAt != nil {
+		end = *returnedAt
+	}
+
+	return int32(end.Sub(receivedAt).Hours() / 24)
+}
@@ -0,0 +1,74 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type GetPackagePhotoHandler struct {
+	GetPackagePhoto usecases.GetPackagePhotoUC
+}
+
+// Handle returns short-lived download URLs for a package photo
+// @Summary      Get package photo URLs
+// @Description  Returns signed URLs for the package photo and its thumbnail. Only staff and residents of the package apartment can fetch them. URLs expire after a few minutes.
+// @Tags         Packages
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Package UUID"
+// @Success      200  {object}  usecases.PackagePhoto
+// @Failure      400  {object}  common.ErrResponse "Invalid ID format"
+// @Failure      401  {object}  common.ErrResponse "User not authenticated"
+// @Failure      403  {object}  common.ErrResponse "Permission denied"
+// @Failure      404  {object}  common.ErrResponse "Package or photo not found"
+// @Failure      500  {object}  common.ErrResponse "Internal server error"
+// @Router       /packages/{id}/photo [get]
+func (h *GetPackagePhotoHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	packageID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid package ID format",
+		})
+		return
+	}
+
+	photo, err := h.GetPackagePhoto.Exec(r.Context(), usecases.GetPackagePhotoReq{
+		UserID:    userID,
+		PackageID: packageID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrPackageNotFound),
+			errors.Is(err, usecases.ErrPackageHasNoPhoto):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: err.Error(),
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "You do not have permission to view this package",
+			})
+		default:
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to retrieve package photo",
+			})
+		}
+		return
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusOK, photo)
+}
@@ -0,0 +1,73 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type GetPollHandler struct {
+	GetPoll usecases.GetPollUC
+}
+
+// Handle retrieves a poll with its options and results
+// @Summary      Get Poll
+// @Description  Returns a poll with its options. Results are included when the poll shows them live, when it is closed, or when the user is an Admin/Syndic. Voters are only listed for named polls.
+// @Tags         Polls
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Poll UUID"
+// @Success      200  {object}  usecases.PollDetails
+// @Failure      400  {object}  common.ErrResponse "Invalid ID format"
+// @Failure      401  {object}  common.ErrResponse "User not authenticated"
+// @Failure      403  {object}  common.ErrResponse "Permission denied"
+// @Failure      404  {object}  common.ErrResponse "Poll not found"
+// @Failure      500  {object}  common.ErrResponse "Internal server error"
+// @Router       /polls/{id} [get]
+func (h *GetPollHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	pollID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid poll ID format",
+		})
+		return
+	}
+
+	poll, err := h.GetPoll.Exec(r.Context(), usecases.GetPollReq{
+		PollID: pollID,
+		UserID: userID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrPollNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Poll not found",
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "You do not have permission to view this poll",
+			})
+		default:
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to retrieve poll",
+			})
+		}
+		return
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusOK, poll)
+}
@@ -0,0 +1,186 @@
+package controllers
+
+import (
+	"errors"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/Bellorico323/vizen/internal/utils"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type GetPublicInviteHandler struct {
+	GetPublicInvite usecases.GetPublicInviteUC
+}
+
+type PublicInviteResponse struct {
+	GuestName       string    `json:"guestName"`
+	GuestType       string    `json:"guestType"`
+	Block           *string   `json:"block"`
+	ApartmentNumber string    `json:"apartmentNumber"`
+	StartsAt        time.Time `json:"startsAt"`
+	EndsAt          time.Time `json:"endsAt"`
+	Weekdays        []int16   `json:"weekdays,omitempty"`
+	DailyStartTime  *string   `json:"dailyStartTime,omitempty"`
+	DailyEndTime    *string   `json:"dailyEndTime,omitempty"`
+	Timezone        string    `json:"timezone"`
+	Status          string    `json:"status"`
+	QRCodePayload   uuid.UUID `json:"qrCodePayload"`
+}
+
+var weekdayNames = []string{"Dom", "Seg", "Ter", "Qua", "Qui", "Sex", "Sáb"}
+
+var publicInviteStatus = map[string]string{
+	"active":    "Convite válido",
+	"scheduled": "Convite ainda não iniciado",
+	"expired":   "Convite expirado",
+	"revoked":   "Convite cancelado",
+}
+
+var publicInvitePage = template.Must(template.New("invite").Parse(`<!doctype html>
+<html lang="pt-BR">
+<head>
+	<meta charset="utf-8" />
+	<meta name="viewport" content="width=device-width, initial-scale=1" />
+	<meta name="robots" content="noindex" />
+	<title>Convite - {{.GuestName}}</title>
+	<style>
+		body { font-family: system-ui, sans-serif; margin: 0; padding: 24px; background: #f4f4f5; color: #18181b; }
+		main { max-width: 420px; margin: 0 auto; background: #fff; border-radius: 12px; padding: 24px; text-align: center; }
+		.status { font-weight: 600; }
+		.status.active { color: #15803d; }
+		.status.inactive { color: #b91c1c; }
+		.qr svg { width: 100%; height: auto; }
+		dl { text-align: left; }
+		dt { font-size: 12px; color: #71717a; margin-top: 12px; }
+		dd { margin: 0; }
+	</style>
+</head>
+<body>
+	<main>
+		<h1>{{.GuestName}}</h1>
+		<p class="status {{if .Active}}active{{else}}inactive{{end}}">{{.StatusLabel}}</p>
+		{{if .Active}}<div class="qr">{{.QRCode}}</div>
+		<p>Apresente este QR Code na portaria.</p>{{end}}
+		<dl>
+			<dt>Unidade</dt>
+			<dd>{{if .Block}}Bloco {{.Block}} - {{end}}Apto {{.ApartmentNumber}}</dd>
+			<dt>Validade</dt>
+			<dd>{{.Validity}}</dd>
+			{{if .Schedule}}<dt>Dias e horários</dt>
+			<dd>{{.Schedule}}</dd>{{end}}
+		</dl>
+	</main>
+</body>
+</html>
+`))
+
+// Handle shows an invite to the guest through a shared link
+// @Summary      Public Invite Page
+// @Description  Public guest page opened from a shared invite link. Does not require a Bearer token: the short code carries a signature. Returns HTML by default, or JSON when the client sends Accept: application/json or format=json. Only the guest name, unit and validity are exposed; qrCodePayload is the value accepted by POST /invites/validate.
+// @Tags         Invites
+// @Produce      html
+// @Produce      json
+// @Param        code    path      string  true   "Signed invite code"
+// @Param        format  query     string  false  "Set to json to force a JSON response"
+// @Success      200     {object}  controllers.PublicInviteResponse
+// @Failure      403     {object}  common.ErrResponse "Invalid link"
+// @Failure      404     {object}  common.ErrResponse "Invite not found"
+// @Failure      500     {object}  common.ErrResponse "Internal server error"
+// @Router       /g/{code} [get]
+func (h *GetPublicInviteHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	invite, err := h.GetPublicInvite.Exec(r.Context(), chi.URLParam(r, "code"))
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrInvalidShareLink):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Invalid or expired link",
+			})
+		case errors.Is(err, usecases.ErrInviteNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Invite not found",
+			})
+		default:
+			slog.Error("failed to load public invite", "error", err)
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to load invite",
+			})
+		}
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Referrer-Policy", "no-referrer")
+
+	if r.URL.Query().Get("format") == "json" || strings.Contains(r.Header.Get("Accept"), "application/json") {
+		jsonutils.EncodeJson(w, r, http.StatusOK, PublicInviteResponse{
+			GuestName:       invite.GuestName,
+			GuestType:       invite.GuestType,
+			Block:           invite.Block,
+			ApartmentNumber: invite.ApartmentNumber,
+			StartsAt:        invite.StartsAt,
+			EndsAt:          invite.EndsAt,
+			Weekdays:        invite.Weekdays,
+			DailyStartTime:  invite.DailyStartTime,
+			DailyEndTime:    invite.DailyEndTime,
+			Timezone:        invite.Timezone,
+			Status:          invite.Status,
+			QRCodePayload:   invite.Token,
+		})
+		return
+	}
+
+	loc, err := time.LoadLocation(invite.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	page := struct {
+		GuestName       string
+		Block           *string
+		ApartmentNumber string
+		Active          bool
+		StatusLabel     string
+		QRCode          template.HTML
+		Validity        string
+		Schedule        string
+	}{
+		GuestName:       invite.GuestName,
+		Block:           invite.Block,
+		ApartmentNumber: invite.ApartmentNumber,
+		Active:          invite.Status == "active",
+		StatusLabel:     publicInviteStatus[invite.Status],
+		Validity: invite.StartsAt.In(loc).Format("02/01/2006 15:04") + " até " +
+			invite.EndsAt.In(loc).Format("02/01/2006 15:04"),
+	}
+
+	if len(invite.Weekdays) > 0 && invite.DailyStartTime != nil && invite.DailyEndTime != nil {
+		days := make([]string, len(invite.Weekdays))
+		for i, d := range invite.Weekdays {
+			days[i] = weekdayNames[d]
+		}
+		page.Schedule = strings.Join(days, ", ") + ", das " + *invite.DailyStartTime + " às " + *invite.DailyEndTime
+	}
+
+	if page.Active {
+		svg, err := utils.QRCodeSVG(invite.Token.String(), 320)
+		if err != nil {
+			slog.Error("failed to render public invite qr code", "error", err)
+		}
+		// The SVG is generated by us from a UUID, so it is safe to inline.
+		page.QRCode = template.HTML(svg)
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	if err := publicInvitePage.Execute(w, page); err != nil {
+		slog.Error("failed to render public invite page", "error", err)
+	}
+}
@@ -0,0 +1,73 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type GetVisitorRequestHandler struct {
+	GetVisitorRequest usecases.GetVisitorRequestUC
+}
+
+// Handle returns a walk-in visitor request
+// @Summary      Get Visitor Request
+// @Description  Returns a walk-in visitor request with a short-lived photo URL. Available to staff and residents of the target apartment, so the app can show who is at the door.
+// @Tags         Visitors
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Visitor request UUID"
+// @Success      200  {object}  controllers.VisitorRequestItem
+// @Failure      400  {object}  common.ErrResponse "Invalid ID format"
+// @Failure      401  {object}  common.ErrResponse "User not authenticated"
+// @Failure      403  {object}  common.ErrResponse "Permission denied"
+// @Failure      404  {object}  common.ErrResponse "Visitor request not found"
+// @Failure      500  {object}  common.ErrResponse "Internal server error"
+// @Router       /visitor_requests/{id} [get]
+func (h *GetVisitorRequestHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	visitorRequestID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid visitor request ID format",
+		})
+		return
+	}
+
+	visitor, err := h.GetVisitorRequest.Exec(r.Context(), usecases.GetVisitorRequestReq{
+		VisitorRequestID: visitorRequestID,
+		UserID:           userID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrVisitorRequestNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Visitor request not found",
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "You do not have permission to see this visitor",
+			})
+		default:
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to get visitor request",
+			})
+		}
+		return
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusOK, toVisitorRequestItem(visitor.VisitorRequest, visitor.PhotoURL))
+}
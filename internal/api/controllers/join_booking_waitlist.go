@@ -0,0 +1,117 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/Bellorico323/vizen/internal/validator"
+	"github.com/google/uuid"
+)
+
+type JoinBookingWaitlistHandler struct {
+	JoinBookingWaitlist usecases.JoinBookingWaitlistUC
+}
+
+type JoinBookingWaitlistRequest struct {
+	CondominiumID uuid.UUID `json:"condominiumId" validate:"required"`
+	ApartmentID   uuid.UUID `json:"apartmentId" validate:"required"`
+	CommonAreaID  uuid.UUID `json:"commonAreaId" validate:"required"`
+	StartsAt      time.Time `json:"startsAt" validate:"required"`
+	EndsAt        time.Time `json:"endsAt" validate:"required,gtfield=StartsAt"`
+	// Headcount is how many people the booking would cover (defaults to 1).
+	Headcount int32 `json:"headcount" validate:"omitempty,min=1"`
+}
+
+type JoinBookingWaitlistResponse struct {
+	Message string                       `json:"message"`
+	Entry   pgstore.BookingWaitlistEntry `json:"entry"`
+}
+
+// Handle puts the apartment in line for a taken time range
+// @Summary      Join Booking Waitlist
+// @Description  Joins the waitlist for a time range that is taken. When a booking in it is cancelled or denied, the range is offered to the waitlist in joining order and held for a limited time for the resident, who is notified and can accept it.
+// @Tags         Bookings
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body      controllers.JoinBookingWaitlistRequest true "Waitlist Data"
+// @Success      201     {object}  controllers.JoinBookingWaitlistResponse
+// @Failure      400     {object}  common.ErrResponse "Invalid dates or headcount"
+// @Failure      403     {object}  common.ErrResponse "Permission denied"
+// @Failure      404     {object}  common.ErrResponse "Common area not found"
+// @Failure      409     {object}  common.ErrResponse "Time range is available, closed by a blackout, or the apartment is already waiting for it"
+// @Router       /bookings/waitlist [post]
+func (h *JoinBookingWaitlistHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	data, err := jsonutils.DecodeJson[JoinBookingWaitlistRequest](r)
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{Message: "Invalid JSON payload"})
+		return
+	}
+
+	if errs := validator.ValidateStruct(data); len(errs) > 0 {
+		jsonutils.EncodeJson(w, r, http.StatusUnprocessableEntity, common.ValidationErrResponse{Message: "Validation failed", Errors: errs})
+		return
+	}
+
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{Message: "Unauthorized"})
+		return
+	}
+
+	entry, err := h.JoinBookingWaitlist.Exec(r.Context(), usecases.JoinBookingWaitlistReq{
+		CondominiumID: data.CondominiumID,
+		UserID:        userID,
+		ApartmentID:   data.ApartmentID,
+		CommonAreaID:  data.CommonAreaID,
+		StartsAt:      data.StartsAt,
+		EndsAt:        data.EndsAt,
+		Headcount:     data.Headcount,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "You must be a resident of the apartment to join the waitlist.",
+			})
+
+		case errors.Is(err, usecases.ErrCommonAreaNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Common area not found",
+			})
+
+		case errors.Is(err, usecases.ErrInvalidBookingDate),
+			errors.Is(err, usecases.ErrInvalidHeadcount):
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: err.Error(),
+			})
+
+		case errors.Is(err, usecases.ErrSlotAvailable),
+			errors.Is(err, usecases.ErrAlreadyWaitlisted),
+			errors.Is(err, usecases.ErrAreaBlackedOut),
+			errors.Is(err, usecases.ErrCommonAreaArchived):
+			jsonutils.EncodeJson(w, r, http.StatusConflict, common.ErrResponse{
+				Message: err.Error(),
+			})
+
+		default:
+			slog.Error("Error while joining booking waitlist", "error", err, "commonAreaId", data.CommonAreaID, "userId", userID)
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to join waitlist",
+			})
+		}
+		return
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusCreated, JoinBookingWaitlistResponse{
+		Message: "You will be notified if this time range becomes available.",
+		Entry:   entry,
+	})
+}
@@ -0,0 +1,80 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type LeaveBookingWaitlistHandler struct {
+	LeaveBookingWaitlist usecases.LeaveBookingWaitlistUC
+}
+
+// Handle takes the apartment out of the waitlist
+// @Summary      Leave Booking Waitlist
+// @Description  Cancels a waiting entry or declines an open offer. A declined offer is passed on to the next entries in line.
+// @Tags         Bookings
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Waitlist entry UUID"
+// @Success      204  "No Content"
+// @Failure      400  {object}  common.ErrResponse  "Invalid ID"
+// @Failure      401  {object}  common.ErrResponse  "Unauthorized"
+// @Failure      403  {object}  common.ErrResponse  "User is not a resident of the apartment"
+// @Failure      404  {object}  common.ErrResponse  "Waitlist entry not found"
+// @Failure      409  {object}  common.ErrResponse  "Entry is no longer active"
+// @Failure      500  {object}  common.ErrResponse  "Internal Server Error"
+// @Router       /bookings/waitlist/{id} [delete]
+func (h *LeaveBookingWaitlistHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	entryID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid waitlist entry ID format",
+		})
+		return
+	}
+
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	err = h.LeaveBookingWaitlist.Exec(r.Context(), usecases.LeaveBookingWaitlistReq{
+		UserID:  userID,
+		EntryID: entryID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrWaitlistEntryNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Waitlist entry not found",
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Only residents of the apartment can leave this waitlist entry",
+			})
+		case errors.Is(err, usecases.ErrWaitlistEntryInactive):
+			jsonutils.EncodeJson(w, r, http.StatusConflict, common.ErrResponse{
+				Message: err.Error(),
+			})
+		default:
+			slog.Error("failed to leave booking waitlist", "error", err, "waitlistEntryId", entryID)
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to leave waitlist",
+			})
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,193 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/Bellorico323/vizen/internal/utils"
+	"github.com/google/uuid"
+)
+
+type ListAccessLogsHandler struct {
+	ListAccessLogs usecases.ListAccessLogsUC
+}
+
+type AccessLogItem struct {
+	ID               uuid.UUID  `json:"id"`
+	InviteID         *uuid.UUID `json:"inviteId"`
+	VisitorRequestID *uuid.UUID `json:"visitorRequestId"`
+	VehicleID        *uuid.UUID `json:"vehicleId"`
+	Plate            *string    `json:"plate"`
+	ApartmentID      uuid.UUID  `json:"apartmentId"`
+	Block            *string    `json:"block"`
+	ApartmentNumber  string     `json:"apartmentNumber"`
+	GuestName        string     `json:"guestName"`
+	GuestType        string     `json:"guestType"`
+	EnteredAt        time.Time  `json:"enteredAt"`
+	AuthorizedBy     *uuid.UUID `json:"authorizedBy"`
+	ExitedAt         *time.Time `json:"exitedAt"`
+	ExitRegisteredBy *uuid.UUID `json:"exitRegisteredBy"`
+	// SyncedAt is set for entries a gate device recorded offline and uploaded later.
+	SyncedAt *time.Time `json:"syncedAt"`
+}
+
+type ListAccessLogsResponse struct {
+	Data []AccessLogItem `json:"data"`
+}
+
+func toAccessLogItem(row pgstore.ListAccessLogsRow) AccessLogItem {
+	return AccessLogItem{
+		ID:               row.ID,
+		InviteID:         row.InviteID,
+		VisitorRequestID: row.VisitorRequestID,
+		VehicleID:        row.VehicleID,
+		Plate:            row.Plate,
+		ApartmentID:      row.ApartmentID,
+		Block:            row.Block,
+		ApartmentNumber:  row.ApartmentNumber,
+		GuestName:        row.GuestName,
+		GuestType:        row.GuestType,
+		EnteredAt:        row.EnteredAt,
+		AuthorizedBy:     row.AuthorizedBy,
+		ExitedAt:         row.ExitedAt,
+		ExitRegisteredBy: row.ExitRegisteredBy,
+		SyncedAt:         row.SyncedAt,
+	}
+}
+
+// Handle lists access log entries
+// @Summary      List Access Logs
+// @Description  Lists gate entries, newest first. Walk-in visitors approved by a resident show up with guestType walk_in and a visitorRequestId instead of an inviteId. Resident vehicles let in by plate show up with guestType resident and a vehicleId. Staff can see the whole condominium and filter by apartment, invite, guest type and date range. Residents must inform their apartmentId and only see their own unit.
+// @Tags         Access Logs
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        condominiumId query     string  true   "Condominium UUID"
+// @Param        apartmentId   query     string  false  "Apartment UUID (Required for residents)"
+// @Param        inviteId      query     string  false  "Invite UUID"
+// @Param        guestType     query     string  false  "Guest type (guest, service, delivery, walk_in, resident)"
+// @Param        from          query     string  false  "Entries at or after this instant (RFC3339)"
+// @Param        to            query     string  false  "Entries before this instant (RFC3339)"
+// @Param        page          query     int     false  "Page number (default 1)"
+// @Param        limit         query     int     false  "Items per page (default 50)"
+// @Success      200           {object}  controllers.ListAccessLogsResponse
+// @Failure      400           {object}  common.ErrResponse "Invalid parameters"
+// @Failure      401           {object}  common.ErrResponse "User not authenticated"
+// @Failure      403           {object}  common.ErrResponse "Permission denied"
+// @Failure      500           {object}  common.ErrResponse "Internal server error"
+// @Router       /access_logs [get]
+func (h *ListAccessLogsHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	q := r.URL.Query()
+	condoID, err := uuid.Parse(q.Get("condominiumId"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{Message: "Invalid condominiumId"})
+		return
+	}
+
+	apartmentID, err := parseOptionalUUID(q.Get("apartmentId"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{Message: "Invalid apartmentId"})
+		return
+	}
+
+	inviteID, err := parseOptionalUUID(q.Get("inviteId"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{Message: "Invalid inviteId"})
+		return
+	}
+
+	from, err := parseOptionalTime(q.Get("from"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{Message: "Invalid from, use RFC3339"})
+		return
+	}
+
+	to, err := parseOptionalTime(q.Get("to"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{Message: "Invalid to, use RFC3339"})
+		return
+	}
+
+	page, _ := strconv.Atoi(q.Get("page"))
+	if page < 1 {
+		page = 1
+	}
+
+	limit, _ := strconv.Atoi(q.Get("limit"))
+	if limit < 1 || limit > 200 {
+		limit = 50
+	}
+
+	rows, err := h.ListAccessLogs.Exec(r.Context(), usecases.ListAccessLogsReq{
+		CondominiumID: condoID,
+		UserID:        userID,
+		ApartmentID:   apartmentID,
+		InviteID:      inviteID,
+		GuestType:     utils.ToNullString(q.Get("guestType")),
+		From:          from,
+		To:            to,
+		Limit:         int32(limit),
+		Offset:        int32((page - 1) * limit),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Permission denied. Residents must specify their apartmentId.",
+			})
+		case errors.Is(err, usecases.ErrInvalidDateRange):
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: err.Error(),
+			})
+		default:
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to list access logs",
+			})
+		}
+		return
+	}
+
+	resp := ListAccessLogsResponse{Data: make([]AccessLogItem, len(rows))}
+	for i, row := range rows {
+		resp.Data[i] = toAccessLogItem(row)
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusOK, resp)
+}
+
+func parseOptionalUUID(s string) (*uuid.UUID, error) {
+	if s == "" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+func parseOptionalTime(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
@@ -0,0 +1,107 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/google/uuid"
+)
+
+type ListBlockedVisitorsHandler struct {
+	ListBlockedVisitors usecases.ListBlockedVisitorsUC
+}
+
+type BlockedVisitorItem struct {
+	ID            uuid.UUID  `json:"id"`
+	CondominiumID uuid.UUID  `json:"condominiumId"`
+	Name          string     `json:"name"`
+	Document      *string    `json:"document"`
+	Plate         *string    `json:"plate"`
+	Reason        string     `json:"reason"`
+	CreatedBy     uuid.UUID  `json:"createdBy"`
+	ExpiresAt     *time.Time `json:"expiresAt"`
+	CreatedAt     time.Time  `json:"createdAt"`
+}
+
+type ListBlockedVisitorsResponse struct {
+	Data []BlockedVisitorItem `json:"data"`
+}
+
+func toBlockedVisitorItem(b pgstore.BlockedVisitor) BlockedVisitorItem {
+	return BlockedVisitorItem{
+		ID:            b.ID,
+		CondominiumID: b.CondominiumID,
+		Name:          b.Name,
+		Document:      b.Document,
+		Plate:         b.Plate,
+		Reason:        b.Reason,
+		CreatedBy:     b.CreatedBy,
+		ExpiresAt:     b.ExpiresAt,
+		CreatedAt:     b.CreatedAt,
+	}
+}
+
+// Handle lists the condominium blocklist
+// @Summary      List Blocked Visitors
+// @Description  Lists the active entries of the condominium blocklist. Expired and removed entries are omitted. Staff only.
+// @Tags         Blocked Visitors
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        condominiumId query     string  true  "Condominium UUID"
+// @Success      200           {object}  controllers.ListBlockedVisitorsResponse
+// @Failure      400           {object}  common.ErrResponse "Invalid condominiumId"
+// @Failure      401           {object}  common.ErrResponse "User not authenticated"
+// @Failure      403           {object}  common.ErrResponse "Permission denied"
+// @Failure      500           {object}  common.ErrResponse "Internal server error"
+// @Router       /blocked_visitors [get]
+func (h *ListBlockedVisitorsHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	condoID, err := uuid.Parse(r.URL.Query().Get("condominiumId"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{Message: "Invalid condominiumId"})
+		return
+	}
+
+	entries, err := h.ListBlockedVisitors.Exec(r.Context(), usecases.ListBlockedVisitorsReq{
+		CondominiumID: condoID,
+		UserID:        userID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Only condominium staff can see the blocklist",
+			})
+		default:
+			slog.Error("failed to list blocked visitors", "error", err, "condominiumId", condoID)
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to list blocked visitors",
+			})
+		}
+		return
+	}
+
+	resp := ListBlockedVisitorsResponse{
+		Data: make([]BlockedVisitorItem, len(entries)),
+	}
+	for i, entry := range entries {
+		resp.Data[i] = toBlockedVisitorItem(entry)
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusOK, resp)
+}
@@ -0,0 +1,71 @@
+package controllers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/google/uuid"
+)
+
+type ListBookingWaitlistHandler struct {
+	ListBookingWaitlist usecases.ListBookingWaitlistUC
+}
+
+type ListBookingWaitlistResponse struct {
+	Entries []pgstore.ListUserWaitlistEntriesRow `json:"entries"`
+}
+
+// Handle lists the user's active waitlist entries
+// @Summary      List Booking Waitlist
+// @Description  Lists the waitlist entries the user is still waiting on. Entries with status "offered" hold the range until offer_expires_at and can be accepted.
+// @Tags         Bookings
+// @Produce      json
+// @Security     BearerAuth
+// @Param        condominiumId query     string  true  "Condominium UUID"
+// @Success      200     {object}  controllers.ListBookingWaitlistResponse
+// @Failure      400     {object}  common.ErrResponse "Invalid UUID"
+// @Failure      401     {object}  common.ErrResponse "Unauthorized"
+// @Failure      500     {object}  common.ErrResponse "Internal Server Error"
+// @Router       /bookings/waitlist [get]
+func (h *ListBookingWaitlistHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "Unauthorized",
+		})
+		return
+	}
+
+	condoID, err := uuid.Parse(r.URL.Query().Get("condominiumId"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid condominiumId",
+		})
+		return
+	}
+
+	entries, err := h.ListBookingWaitlist.Exec(r.Context(), usecases.ListBookingWaitlistReq{
+		CondominiumID: condoID,
+		UserID:        userID,
+	})
+	if err != nil {
+		slog.Error("failed to list booking waitlist", "error", err, "userId", userID)
+		jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+			Message: "Failed to list waitlist",
+		})
+		return
+	}
+
+	if entries == nil {
+		entries = []pgstore.ListUserWaitlistEntriesRow{}
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusOK, ListBookingWaitlistResponse{
+		Entries: entries,
+	})
+}
@@ -0,0 +1,71 @@
+package controllers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/google/uuid"
+)
+
+type ListCalendarFeedsHandler struct {
+	ListCalendarFeeds usecases.ListCalendarFeedsUC
+}
+
+type ListCalendarFeedsResponse struct {
+	Feeds []CalendarFeedItem `json:"feeds"`
+}
+
+// Handle lists the user's calendar feeds
+// @Summary      List Calendar Feeds
+// @Description  Lists the user's active iCalendar feeds in a condominium with their subscription URLs.
+// @Tags         Calendar
+// @Produce      json
+// @Security     BearerAuth
+// @Param        condominiumId query     string  true  "Condominium UUID"
+// @Success      200     {object}  controllers.ListCalendarFeedsResponse
+// @Failure      400     {object}  common.ErrResponse "Invalid UUID"
+// @Failure      401     {object}  common.ErrResponse "Unauthorized"
+// @Failure      500     {object}  common.ErrResponse "Internal Server Error"
+// @Router       /calendar_feeds [get]
+func (h *ListCalendarFeedsHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "Unauthorized",
+		})
+		return
+	}
+
+	condoID, err := uuid.Parse(r.URL.Query().Get("condominiumId"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid condominiumId",
+		})
+		return
+	}
+
+	links, err := h.ListCalendarFeeds.Exec(r.Context(), usecases.ListCalendarFeedsReq{
+		UserID:        userID,
+		CondominiumID: condoID,
+	})
+	if err != nil {
+		slog.Error("failed to list calendar feeds", "error", err, "userId", userID)
+		jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+			Message: "Failed to list calendar feeds",
+		})
+		return
+	}
+
+	feeds := make([]CalendarFeedItem, len(links))
+	for i, link := range links {
+		feeds[i] = toCalendarFeedItem(link)
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusOK, ListCalendarFeedsResponse{
+		Feeds: feeds,
+	})
+}
@@ -0,0 +1,86 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type ListCommonAreaBlackoutsHandler struct {
+	ListCommonAreaBlackouts usecases.ListCommonAreaBlackoutsUC
+}
+
+type ListCommonAreaBlackoutsResponse struct {
+	Blackouts []pgstore.CommonAreaBlackout `json:"blackouts"`
+}
+
+// Handle lists the blackouts of a common area
+// @Summary      List Common Area Blackouts
+// @Description  Lists the current and upcoming blackout periods of a common area. Open to all members.
+// @Tags         Common Areas
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Common Area UUID"
+// @Success      200  {object}  controllers.ListCommonAreaBlackoutsResponse
+// @Failure      400  {object}  common.ErrResponse  "Invalid ID"
+// @Failure      401  {object}  common.ErrResponse  "User not authenticated"
+// @Failure      403  {object}  common.ErrResponse  "User is not a member of the condominium"
+// @Failure      404  {object}  common.ErrResponse  "Common area not found"
+// @Failure      500  {object}  common.ErrResponse  "Internal server error"
+// @Router       /common_areas/{id}/blackouts [get]
+func (h *ListCommonAreaBlackoutsHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	areaID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid common area ID format",
+		})
+		return
+	}
+
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	blackouts, err := h.ListCommonAreaBlackouts.Exec(r.Context(), usecases.ListCommonAreaBlackoutsReq{
+		UserID:       userID,
+		CommonAreaID: areaID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrCommonAreaNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Common area not found",
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "User is not a member of this condominium",
+			})
+		default:
+			slog.Error("failed to list common area blackouts", "error", err, "commonAreaId", areaID)
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to list blackouts",
+			})
+		}
+		return
+	}
+
+	if blackouts == nil {
+		blackouts = []pgstore.CommonAreaBlackout{}
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusOK, ListCommonAreaBlackoutsResponse{
+		Blackouts: blackouts,
+	})
+}
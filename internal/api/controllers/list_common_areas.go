@@ -9,6 +9,7 @@ import (
 	"github.com/Bellorico323/vizen/internal/jsonutils"
 	"github.com/Bellorico323/vizen/internal/store/pgstore"
 	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/Bellorico323/vizen/internal/utils"
 	"github.com/google/uuid"
 )
 
@@ -16,13 +17,40 @@ type ListCommonAreasHandler struct {
 	ListCommonAreas usecases.ListCommonAreasUC
 }
 
+type OpeningHoursItem struct {
+	Weekday  int16  `json:"weekday"`
+	OpensAt  string `json:"opens_at"`
+	ClosesAt string `json:"closes_at"`
+}
+
+type CommonAreaItem struct {
+	pgstore.CommonArea
+	// OpeningHours is empty when the area is always open.
+	OpeningHours []OpeningHoursItem `json:"opening_hours"`
+}
+
 type ListCommonAreasResponse struct {
-	Data []pgstore.CommonArea `json:"data"`
+	Data []CommonAreaItem `json:"data"`
+}
+
+func toCommonAreaItem(area usecases.CommonAreaDetails) CommonAreaItem {
+	item := CommonAreaItem{
+		CommonArea:   area.CommonArea,
+		OpeningHours: make([]OpeningHoursItem, len(area.OpeningHours)),
+	}
+	for i, h := range area.OpeningHours {
+		item.OpeningHours[i] = OpeningHoursItem{
+			Weekday:  h.Weekday,
+			OpensAt:  utils.FormatClock(h.OpensMinute),
+			ClosesAt: utils.FormatClock(h.ClosesMinute),
+		}
+	}
+	return item
 }
 
 // List handles listing of common areas
 // @Summary      List Common Areas
-// @Description  Lists all common areas available in the condominium. Open to all members.
+// @Description  Lists all common areas available in the condominium with their booking rules and opening hours (weekday 0 = Sunday, condominium timezone). Open to all members.
 // @Tags         Common Areas
 // @Accept       json
 // @Produce      json
@@ -62,7 +90,12 @@ func (h *ListCommonAreasHandler) Handle(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	jsonutils.EncodeJson(w, r, http.StatusOK, ListCommonAreasResponse{
-		Data: areas,
-	})
+	resp := ListCommonAreasResponse{
+		Data: make([]CommonAreaItem, len(areas)),
+	}
+	for i, area := range areas {
+		resp.Data[i] = toCommonAreaItem(area)
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusOK, resp)
 }
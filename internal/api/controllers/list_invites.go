@@ -10,6 +10,7 @@ import (
 	"github.com/Bellorico323/vizen/internal/auth"
 	"github.com/Bellorico323/vizen/internal/jsonutils"
 	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/Bellorico323/vizen/internal/utils"
 	"github.com/google/uuid"
 )
 
@@ -19,15 +20,22 @@ type ListInvitesHandler struct {
 
 type ListInvitesResponse struct {
 	Data []struct {
-		ID              uuid.UUID `json:"id"`
-		GuestName       string    `json:"guestName"`
-		GuestType       string    `json:"guestType"`
-		StartsAt        string    `json:"startsAt"`
-		EndsAt          string    `json:"endsAt"`
-		RevokedAt       *string   `json:"revokedAt,omitempty"`
-		Token           uuid.UUID `json:"token"`
-		ApartmentBlock  string    `json:"block"`
-		ApartmentNumber string    `json:"apartmentNumber"`
+		ID               uuid.UUID `json:"id"`
+		GuestName        string    `json:"guestName"`
+		GuestType        string    `json:"guestType"`
+		StartsAt         string    `json:"startsAt"`
+		EndsAt           string    `json:"endsAt"`
+		RevokedAt        *string   `json:"revokedAt,omitempty"`
+		Token            uuid.UUID `json:"token"`
+		ApartmentBlock   string    `json:"block"`
+		ApartmentNumber  string    `json:"apartmentNumber"`
+		Weekdays         []int16   `json:"weekdays,omitempty"`
+		DailyStartTime   *string   `json:"dailyStartTime,omitempty"`
+		DailyEndTime     *string   `json:"dailyEndTime,omitempty"`
+		MaxEntries       *int32    `json:"maxEntries,omitempty"`
+		EntriesCount     int32     `json:"entriesCount"`
+		RemainingEntries *int32    `json:"remainingEntries,omitempty"`
+		GuestPlate       *string   `json:"guestPlate,omitempty"`
 	} `json:"data"`
 }
 
@@ -101,15 +109,22 @@ func (h *ListInvitesHandler) Handle(w http.ResponseWriter, r *http.Request) {
 
 	resp := ListInvitesResponse{}
 	resp.Data = make([]struct {
-		ID              uuid.UUID `json:"id"`
-		GuestName       string    `json:"guestName"`
-		GuestType       string    `json:"guestType"`
-		StartsAt        string    `json:"startsAt"`
-		EndsAt          string    `json:"endsAt"`
-		RevokedAt       *string   `json:"revokedAt,omitempty"`
-		Token           uuid.UUID `json:"token"`
-		ApartmentBlock  string    `json:"block"`
-		ApartmentNumber string    `json:"apartmentNumber"`
+		ID               uuid.UUID `json:"id"`
+		GuestName        string    `json:"guestName"`
+		GuestType        string    `json:"guestType"`
+		StartsAt         string    `json:"startsAt"`
+		EndsAt           string    `json:"endsAt"`
+		RevokedAt        *string   `json:"revokedAt,omitempty"`
+		Token            uuid.UUID `json:"token"`
+		ApartmentBlock   string    `json:"block"`
+		ApartmentNumber  string    `json:"apartmentNumber"`
+		Weekdays         []int16   `json:"weekdays,omitempty"`
+		DailyStartTime   *string   `json:"dailyStartTime,omitempty"`
+		DailyEndTime     *string   `json:"dailyEndTime,omitempty"`
+		MaxEntries       *int32    `json:"maxEntries,omitempty"`
+		EntriesCount     int32     `json:"entriesCount"`
+		RemainingEntries *int32    `json:"remainingEntries,omitempty"`
+		GuestPlate       *string   `json:"guestPlate,omitempty"`
 	}, len(rows))
 
 	for i, row := range rows {
@@ -129,6 +144,19 @@ func (h *ListInvitesHandler) Handle(w http.ResponseWriter, r *http.Request) {
 		if row.Block != nil {
 			resp.Data[i].ApartmentBlock = *row.Block
 		}
+		resp.Data[i].Weekdays = row.RecurrenceWeekdays
+		if row.DailyStartMinute != nil {
+			resp.Data[i].DailyStartTime = utils.ToPtr(utils.FormatClock(*row.DailyStartMinute))
+		}
+		if row.DailyEndMinute != nil {
+			resp.Data[i].DailyEndTime = utils.ToPtr(utils.FormatClock(*row.DailyEndMinute))
+		}
+		resp.Data[i].MaxEntries = row.MaxEntries
+		resp.Data[i].EntriesCount = row.EntriesCount
+		resp.Data[i].GuestPlate = row.GuestPlate
+		if row.MaxEntries != nil {
+			resp.Data[i].RemainingEntries = utils.ToPtr(max(*row.MaxEntries-row.EntriesCount, 0))
+		}
 	}
 
 	jsonutils.EncodeJson(w, r, http.StatusOK, resp)
@@ -3,11 +3,13 @@ package controllers
 import (
 	"errors"
 	"net/http"
+	"strconv"
 
 	"github.com/Bellorico323/vizen/internal/api/common"
 	"github.com/Bellorico323/vizen/internal/auth"
 	"github.com/Bellorico323/vizen/internal/jsonutils"
 	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/Bellorico323/vizen/internal/utils"
 	"github.com/google/uuid"
 )
 
@@ -17,14 +19,21 @@ type ListPackagesHandler struct {
 
 // Handle lists packages based on filters
 // @Summary      List Packages
-// @Description  Retrieve a list of packages. Admins can view all packages for a condominium. Residents must filter by their apartment ID to view their packages.
+// @Description  Retrieve a list of packages. Condominium staff can view and search all packages for a condominium. Residents must filter by their apartment ID to view their packages.
 // @Tags         Packages
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        condominiumId query     string  true  "Condominium UUID"
 // @Param        apartmentId   query     string  false "Apartment UUID (Mandatory for Residents)"
-// @Param        status        query     string  false "Filter by status (pending, withdrawn)"
+// @Param        status        query     string  false "Filter by status (pending, withdrawn, returned_to_sender)"
+// @Param        sort          query     string  false "Sort order: received_at (default, newest first) or days_waiting (longest waiting first)"
+// @Param        carrier       query     string  false "Filter by carrier (staff only)"
+// @Param        trackingCode  query     string  false "Search by tracking code, partial match (staff only)"
+// @Param        sizeCategory  query     string  false "Filter by size (envelope, small, medium, large, extra_large) (staff only)"
+// @Param        isPerishable  query     boolean false "Filter perishable packages (staff only)"
+// @Param        isFragile     query     boolean false "Filter fragile packages (staff only)"
+// @Param        storageLocation query   string  false "Filter by shelf/locker location (staff only)"
 // @Success      200           {object}  []usecases.PackageListItem
 // @Failure      400           {object}  common.ErrResponse "Invalid parameters"
 // @Failure      401           {object}  common.ErrResponse "User not authenticated"
@@ -77,11 +86,47 @@ func (h *ListPackagesHandler) Handle(w http.ResponseWriter, r *http.Request) {
 		status = &statusStr
 	}
 
+	var sort *string
+	sortStr := query.Get("sort")
+	switch sortStr {
+	case "", "received_at":
+	case "days_waiting":
+		sort = &sortStr
+	default:
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid sort, use received_at or days_waiting",
+		})
+		return
+	}
+
+	isPerishable, err := parseOptionalBool(query.Get("isPerishable"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid isPerishable value",
+		})
+		return
+	}
+
+	isFragile, err := parseOptionalBool(query.Get("isFragile"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid isFragile value",
+		})
+		return
+	}
+
 	packages, err := h.ListPackages.Exec(r.Context(), usecases.ListPackagesReq{
-		UserID:        userID,
-		CondominiumID: condoID,
-		ApartmentID:   aptID,
-		Status:        status,
+		UserID:          userID,
+		CondominiumID:   condoID,
+		ApartmentID:     aptID,
+		Status:          status,
+		Sort:            sort,
+		Carrier:         utils.ToNullString(query.Get("carrier")),
+		TrackingCode:    utils.ToNullString(query.Get("trackingCode")),
+		SizeCategory:    utils.ToNullString(query.Get("sizeCategory")),
+		IsPerishable:    isPerishable,
+		IsFragile:       isFragile,
+		StorageLocation: utils.ToNullString(query.Get("storageLocation")),
 	})
 
 	if err != nil {
@@ -100,3 +145,16 @@ func (h *ListPackagesHandler) Handle(w http.ResponseWriter, r *http.Request) {
 
 	jsonutils.EncodeJson(w, r, http.StatusOK, packages)
 }
+
+func parseOptionalBool(value string) (*bool, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parsed, nil
+}
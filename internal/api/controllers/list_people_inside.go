@@ -0,0 +1,82 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/google/uuid"
+)
+
+type ListPeopleInsideHandler struct {
+	ListPeopleInside usecases.ListPeopleInsideUC
+}
+
+type ListPeopleInsideResponse struct {
+	Total       int             `json:"total"`
+	GeneratedAt time.Time       `json:"generatedAt"`
+	Data        []AccessLogItem `json:"data"`
+}
+
+// Handle lists visitors currently inside the condominium
+// @Summary      List People Inside
+// @Description  Lists visitors that entered and have no exit registered yet, grouped by unit. Meant for building evacuations. Staff only. Resident vehicles are not listed. Entries older than the configured lookback (24h by default) are ignored.
+// @Tags         Access Logs
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        condominiumId query     string  true  "Condominium UUID"
+// @Success      200           {object}  controllers.ListPeopleInsideResponse
+// @Failure      400           {object}  common.ErrResponse "Invalid condominiumId"
+// @Failure      401           {object}  common.ErrResponse "User not authenticated"
+// @Failure      403           {object}  common.ErrResponse "Permission denied"
+// @Failure      500           {object}  common.ErrResponse "Internal server error"
+// @Router       /access_logs/inside [get]
+func (h *ListPeopleInsideHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	condoID, err := uuid.Parse(r.URL.Query().Get("condominiumId"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{Message: "Invalid condominiumId"})
+		return
+	}
+
+	rows, err := h.ListPeopleInside.Exec(r.Context(), usecases.ListPeopleInsideReq{
+		CondominiumID: condoID,
+		UserID:        userID,
+	})
+	if err != nil {
+		if errors.Is(err, usecases.ErrNoPermission) {
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Only condominium staff can see who is inside",
+			})
+			return
+		}
+		jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+			Message: "Failed to list people inside",
+		})
+		return
+	}
+
+	resp := ListPeopleInsideResponse{
+		Total:       len(rows),
+		GeneratedAt: time.Now(),
+		Data:        make([]AccessLogItem, len(rows)),
+	}
+	for i, row := range rows {
+		resp.Data[i] = toAccessLogItem(pgstore.ListAccessLogsRow(row))
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusOK, resp)
+}
@@ -0,0 +1,87 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/google/uuid"
+)
+
+type ListPickupAuthorizationsHandler struct {
+	ListPickupAuthorizations usecases.ListPickupAuthorizationsUC
+}
+
+type ListPickupAuthorizationsResponse struct {
+	Data []pgstore.PackagePickupAuthorization `json:"data"`
+}
+
+// Handle lists pickup authorizations of an apartment
+// @Summary      List Pickup Authorizations
+// @Description  Lists the third parties authorized to collect packages of an apartment. Available to residents of the apartment and condominium staff.
+// @Tags         Packages
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        apartmentId query     string  true   "Apartment UUID"
+// @Param        onlyActive  query     boolean false  "If true, returns only authorizations currently valid and not revoked"
+// @Success      200         {object}  controllers.ListPickupAuthorizationsResponse
+// @Failure      400         {object}  common.ErrResponse "Invalid apartmentId"
+// @Failure      401         {object}  common.ErrResponse "User not authenticated"
+// @Failure      403         {object}  common.ErrResponse "Permission denied"
+// @Failure      404         {object}  common.ErrResponse "Apartment not found"
+// @Failure      500         {object}  common.ErrResponse "Internal server error"
+// @Router       /pickup_authorizations [get]
+func (h *ListPickupAuthorizationsHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	q := r.URL.Query()
+	apartmentID, err := uuid.Parse(q.Get("apartmentId"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid apartmentId",
+		})
+		return
+	}
+
+	authorizations, err := h.ListPickupAuthorizations.Exec(r.Context(), usecases.ListPickupAuthorizationsReq{
+		UserID:      userID,
+		ApartmentID: apartmentID,
+		OnlyActive:  q.Get("onlyActive") == "true",
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrApartmentNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Apartment not found",
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Permission denied",
+			})
+		default:
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to list pickup authorizations",
+			})
+		}
+		return
+	}
+
+	if authorizations == nil {
+		authorizations = []pgstore.PackagePickupAuthorization{}
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusOK, ListPickupAuthorizationsResponse{
+		Data: authorizations,
+	})
+}
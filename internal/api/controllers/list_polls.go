@@ -0,0 +1,90 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/google/uuid"
+)
+
+type ListPollsHandler struct {
+	ListPolls usecases.ListPollsUC
+}
+
+type ListPollsResponse struct {
+	Polls []usecases.PollListItem `json:"polls"`
+}
+
+// Handle lists the polls of a condominium
+// @Summary      List Polls
+// @Description  Get a paginated list of polls for a condominium. Available to residents and staff.
+// @Tags         Polls
+// @Produce      json
+// @Security     BearerAuth
+// @Param        condominiumId query     string  true   "Condominium UUID"
+// @Param        onlyOpen      query     boolean false  "If true, returns only polls currently open for voting"
+// @Param        page          query     int     false  "Page number (default 1)"
+// @Param        limit         query     int     false  "Items per page (default 20)"
+// @Success      200           {object}  controllers.ListPollsResponse
+// @Failure      400           {object}  common.ErrResponse "Invalid parameters"
+// @Failure      401           {object}  common.ErrResponse "User not authenticated"
+// @Failure      403           {object}  common.ErrResponse "Permission denied"
+// @Failure      500           {object}  common.ErrResponse "Internal server error"
+// @Router       /polls [get]
+func (h *ListPollsHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	query := r.URL.Query()
+
+	condoID, err := uuid.Parse(query.Get("condominiumId"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid condominiumId format",
+		})
+		return
+	}
+
+	onlyOpen, _ := strconv.ParseBool(query.Get("onlyOpen"))
+	page, _ := strconv.Atoi(query.Get("page"))
+	limit, _ := strconv.Atoi(query.Get("limit"))
+
+	polls, err := h.ListPolls.Exec(r.Context(), usecases.ListPollsReq{
+		CondominiumID: condoID,
+		UserID:        userID,
+		OnlyOpen:      onlyOpen,
+		Page:          int32(page),
+		Limit:         int32(limit),
+	})
+	if err != nil {
+		if errors.Is(err, usecases.ErrNoPermission) {
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "You are not a member of this condominium.",
+			})
+			return
+		}
+
+		jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+			Message: "Failed to fetch polls",
+		})
+		return
+	}
+
+	if polls == nil {
+		polls = []usecases.PollListItem{}
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusOK, ListPollsResponse{
+		Polls: polls,
+	})
+}
@@ -0,0 +1,104 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/google/uuid"
+)
+
+type ListVehiclesHandler struct {
+	ListVehicles usecases.ListVehiclesUC
+}
+
+type VehicleItem struct {
+	ID          uuid.UUID `json:"id"`
+	ApartmentID uuid.UUID `json:"apartmentId"`
+	Plate       string    `json:"plate"`
+	Model       string    `json:"model"`
+	Color       string    `json:"color"`
+	CreatedBy   uuid.UUID `json:"createdBy"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+type ListVehiclesResponse struct {
+	Data []VehicleItem `json:"data"`
+}
+
+func toVehicleItem(v pgstore.Vehicle) VehicleItem {
+	return VehicleItem{
+		ID:          v.ID,
+		ApartmentID: v.ApartmentID,
+		Plate:       v.Plate,
+		Model:       v.Model,
+		Color:       v.Color,
+		CreatedBy:   v.CreatedBy,
+		CreatedAt:   v.CreatedAt,
+	}
+}
+
+// Handle lists the vehicles of an apartment
+// @Summary      List Vehicles
+// @Description  Lists the vehicles registered for an apartment. Available to residents of the apartment and condominium staff.
+// @Tags         Vehicles
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        apartmentId query     string  true  "Apartment UUID"
+// @Success      200         {object}  controllers.ListVehiclesResponse
+// @Failure      400         {object}  common.ErrResponse "Invalid apartmentId"
+// @Failure      401         {object}  common.ErrResponse "User not authenticated"
+// @Failure      403         {object}  common.ErrResponse "Permission denied"
+// @Failure      404         {object}  common.ErrResponse "Apartment not found"
+// @Failure      500         {object}  common.ErrResponse "Internal server error"
+// @Router       /vehicles [get]
+func (h *ListVehiclesHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	apartmentID, err := uuid.Parse(r.URL.Query().Get("apartmentId"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{Message: "Invalid apartmentId"})
+		return
+	}
+
+	vehicles, err := h.ListVehicles.Exec(r.Context(), usecases.ListVehiclesReq{
+		UserID:      userID,
+		ApartmentID: apartmentID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrApartmentNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Apartment not found",
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "You do not have permission to see this apartment's vehicles",
+			})
+		default:
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to list vehicles",
+			})
+		}
+		return
+	}
+
+	resp := ListVehiclesResponse{Data: make([]VehicleItem, len(vehicles))}
+	for i, v := range vehicles {
+		resp.Data[i] = toVehicleItem(v)
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusOK, resp)
+}
@@ -0,0 +1,113 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/Bellorico323/vizen/internal/utils"
+	"github.com/google/uuid"
+)
+
+type ListVisitorRequestsHandler struct {
+	ListVisitorRequests usecases.ListVisitorRequestsUC
+}
+
+type VisitorRequestItem struct {
+	ID              uuid.UUID  `json:"id"`
+	CondominiumID   uuid.UUID  `json:"condominiumId"`
+	ApartmentID     uuid.UUID  `json:"apartmentId"`
+	VisitorName     string     `json:"visitorName"`
+	VisitorDocument *string    `json:"visitorDocument"`
+	PhotoURL        *string    `json:"photoUrl"`
+	Status          string     `json:"status"`
+	RequestedBy     uuid.UUID  `json:"requestedBy"`
+	DecidedBy       *uuid.UUID `json:"decidedBy"`
+	DecidedAt       *time.Time `json:"decidedAt"`
+	ExpiresAt       time.Time  `json:"expiresAt"`
+	CreatedAt       time.Time  `json:"createdAt"`
+}
+
+type ListVisitorRequestsResponse struct {
+	Data []VisitorRequestItem `json:"data"`
+}
+
+// toVisitorRequestItem maps a visitor request; photoURL is nil when there is no photo or it was not signed.
+func toVisitorRequestItem(v pgstore.VisitorRequest, photoURL *string) VisitorRequestItem {
+	return VisitorRequestItem{
+		ID:              v.ID,
+		CondominiumID:   v.CondominiumID,
+		ApartmentID:     v.ApartmentID,
+		VisitorName:     v.VisitorName,
+		VisitorDocument: v.VisitorDocument,
+		PhotoURL:        photoURL,
+		Status:          v.Status,
+		RequestedBy:     v.RequestedBy,
+		DecidedBy:       v.DecidedBy,
+		DecidedAt:       v.DecidedAt,
+		ExpiresAt:       v.ExpiresAt,
+		CreatedAt:       v.CreatedAt,
+	}
+}
+
+// Handle lists recent walk-in visitor requests
+// @Summary      List Visitor Requests
+// @Description  Lists walk-in visitor requests created in the last 24 hours, newest first. Staff only.
+// @Tags         Visitors
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        condominiumId query     string  true   "Condominium UUID"
+// @Param        status        query     string  false  "Status (pending, approved, denied, expired)"
+// @Success      200           {object}  controllers.ListVisitorRequestsResponse
+// @Failure      400           {object}  common.ErrResponse "Invalid condominiumId"
+// @Failure      401           {object}  common.ErrResponse "User not authenticated"
+// @Failure      403           {object}  common.ErrResponse "Permission denied"
+// @Failure      500           {object}  common.ErrResponse "Internal server error"
+// @Router       /visitor_requests [get]
+func (h *ListVisitorRequestsHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	q := r.URL.Query()
+	condoID, err := uuid.Parse(q.Get("condominiumId"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{Message: "Invalid condominiumId"})
+		return
+	}
+
+	rows, err := h.ListVisitorRequests.Exec(r.Context(), usecases.ListVisitorRequestsReq{
+		CondominiumID: condoID,
+		UserID:        userID,
+		Status:        utils.ToNullString(q.Get("status")),
+	})
+	if err != nil {
+		if errors.Is(err, usecases.ErrNoPermission) {
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Only condominium staff can list visitor requests",
+			})
+			return
+		}
+		jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+			Message: "Failed to list visitor requests",
+		})
+		return
+	}
+
+	resp := ListVisitorRequestsResponse{Data: make([]VisitorRequestItem, len(rows))}
+	for i, row := range rows {
+		resp.Data[i] = toVisitorRequestItem(row.VisitorRequest, row.PhotoURL)
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusOK, resp)
+}
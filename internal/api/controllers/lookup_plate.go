@@ -0,0 +1,143 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/google/uuid"
+)
+
+type LookupPlateHandler struct {
+	LookupPlate usecases.LookupPlateUC
+}
+
+type PlateVehicle struct {
+	ID              uuid.UUID `json:"id"`
+	ApartmentID     uuid.UUID `json:"apartmentId"`
+	Block           *string   `json:"block"`
+	ApartmentNumber string    `json:"apartmentNumber"`
+	Plate           string    `json:"plate"`
+	Model           string    `json:"model"`
+	Color           string    `json:"color"`
+}
+
+type PlateInviteItem struct {
+	ID              uuid.UUID `json:"id"`
+	GuestName       string    `json:"guestName"`
+	GuestType       string    `json:"guestType"`
+	GuestPlate      *string   `json:"guestPlate"`
+	ApartmentID     uuid.UUID `json:"apartmentId"`
+	Block           *string   `json:"block"`
+	ApartmentNumber string    `json:"apartmentNumber"`
+	ResidentName    string    `json:"residentName"`
+	EndsAt          time.Time `json:"endsAt"`
+	AllowedNow      bool      `json:"allowedNow"`
+}
+
+type LookupPlateResponse struct {
+	Plate   string            `json:"plate"`
+	Vehicle *PlateVehicle     `json:"vehicle"`
+	Invites []PlateInviteItem `json:"invites"`
+}
+
+func toPlateVehicle(v *pgstore.FindVehicleByPlateRow) *PlateVehicle {
+	if v == nil {
+		return nil
+	}
+	return &PlateVehicle{
+		ID:              v.ID,
+		ApartmentID:     v.ApartmentID,
+		Block:           v.Block,
+		ApartmentNumber: v.ApartmentNumber,
+		Plate:           v.Plate,
+		Model:           v.Model,
+		Color:           v.Color,
+	}
+}
+
+func toPlateInviteItem(invite pgstore.GetInviteByTokenRow, allowedNow bool) PlateInviteItem {
+	return PlateInviteItem{
+		ID:              invite.ID,
+		GuestName:       invite.GuestName,
+		GuestType:       invite.GuestType,
+		GuestPlate:      invite.GuestPlate,
+		ApartmentID:     invite.ApartmentID,
+		Block:           invite.Block,
+		ApartmentNumber: invite.ApartmentNumber,
+		ResidentName:    invite.ResidentName,
+		EndsAt:          invite.EndsAt,
+		AllowedNow:      allowedNow,
+	}
+}
+
+// Handle looks up who a plate belongs to
+// @Summary      Lookup Plate
+// @Description  Shows the resident vehicle registered with a plate and the active invites carrying it. allowedNow tells whether the invite schedule lets the guest in at this moment. Legacy and Mercosul forms of the same plate match each other. Staff only.
+// @Tags         Vehicles
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        condominiumId query     string  true  "Condominium UUID"
+// @Param        plate         query     string  true  "Plate (ABC1234 or ABC1D23)"
+// @Success      200           {object}  controllers.LookupPlateResponse
+// @Failure      400           {object}  common.ErrResponse "Invalid condominiumId or plate"
+// @Failure      401           {object}  common.ErrResponse "User not authenticated"
+// @Failure      403           {object}  common.ErrResponse "Permission denied"
+// @Failure      500           {object}  common.ErrResponse "Internal server error"
+// @Router       /vehicles/lookup [get]
+func (h *LookupPlateHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	q := r.URL.Query()
+	condoID, err := uuid.Parse(q.Get("condominiumId"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{Message: "Invalid condominiumId"})
+		return
+	}
+
+	lookup, err := h.LookupPlate.Exec(r.Context(), usecases.LookupPlateReq{
+		CondominiumID: condoID,
+		UserID:        userID,
+		Plate:         q.Get("plate"),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrInvalidPlate):
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: err.Error(),
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Only condominium staff can look up plates",
+			})
+		default:
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to look up plate",
+			})
+		}
+		return
+	}
+
+	resp := LookupPlateResponse{
+		Plate:   lookup.Plate,
+		Vehicle: toPlateVehicle(lookup.Vehicle),
+		Invites: make([]PlateInviteItem, len(lookup.Invites)),
+	}
+	for i, inv := range lookup.Invites {
+		resp.Invites[i] = toPlateInviteItem(inv.Invite, inv.AllowedNow)
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusOK, resp)
+}
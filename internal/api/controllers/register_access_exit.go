@@ -0,0 +1,90 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type RegisterAccessExitHandler struct {
+	RegisterAccessExit usecases.RegisterAccessExitUC
+}
+
+type RegisterAccessExitResponse struct {
+	Message   string            `json:"message"`
+	AccessLog pgstore.AccessLog `json:"accessLog"`
+}
+
+// Handle registers a visitor exit
+// @Summary      Register Exit
+// @Description  Registers the moment a visitor left the condominium. Staff only.
+// @Tags         Access Logs
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Access log UUID"
+// @Success      200  {object}  controllers.RegisterAccessExitResponse
+// @Failure      400  {object}  common.ErrResponse  "Invalid ID"
+// @Failure      401  {object}  common.ErrResponse  "User not authenticated"
+// @Failure      403  {object}  common.ErrResponse  "Permission denied"
+// @Failure      404  {object}  common.ErrResponse  "Access log not found"
+// @Failure      409  {object}  common.ErrResponse  "Exit already registered"
+// @Failure      500  {object}  common.ErrResponse  "Internal server error"
+// @Router       /access_logs/{id}/exit [patch]
+func (h *RegisterAccessExitHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	accessLogID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid access log ID format",
+		})
+		return
+	}
+
+	entry, err := h.RegisterAccessExit.Exec(r.Context(), usecases.RegisterAccessExitReq{
+		AccessLogID: accessLogID,
+		UserID:      userID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrAccessLogNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Access log not found",
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Only condominium staff can register exits",
+			})
+		case errors.Is(err, usecases.ErrAccessAlreadyExited):
+			jsonutils.EncodeJson(w, r, http.StatusConflict, common.ErrResponse{
+				Message: err.Error(),
+			})
+		default:
+			slog.Error("failed to register exit", "error", err, "accessLogId", accessLogID)
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to register exit",
+			})
+		}
+		return
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusOK, RegisterAccessExitResponse{
+		Message:   "Exit registered",
+		AccessLog: entry,
+	})
+}
@@ -0,0 +1,118 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/Bellorico323/vizen/internal/validator"
+	"github.com/google/uuid"
+)
+
+type RegisterPlateEntryHandler struct {
+	RegisterPlateEntry usecases.RegisterPlateEntryUC
+}
+
+type RegisterPlateEntryRequest struct {
+	CondominiumID uuid.UUID `json:"condominiumId" validate:"required,uuid4"`
+	Plate         string    `json:"plate" validate:"required"`
+}
+
+type RegisterPlateEntryResponse struct {
+	Message string           `json:"message"`
+	Plate   string           `json:"plate"`
+	Vehicle *PlateVehicle    `json:"vehicle"`
+	Invite  *PlateInviteItem `json:"invite"`
+	// RemainingEntries is null for resident vehicles and invites without an entry limit.
+	RemainingEntries *int32 `json:"remainingEntries"`
+}
+
+// Handle lets a vehicle in by plate
+// @Summary      Register Plate Entry
+// @Description  Lets a plate in when it belongs to a resident vehicle or to an invite usable right now (same rules as token validation, including schedule, entry limits and the condominium blocklist), and records the entry in the access log. Staff only.
+// @Tags         Access Logs
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body      controllers.RegisterPlateEntryRequest true "Plate read at the gate"
+// @Success      201     {object}  controllers.RegisterPlateEntryResponse
+// @Failure      400     {object}  common.ErrResponse            "Invalid Payload or plate"
+// @Failure      401     {object}  common.ErrResponse            "User not authenticated"
+// @Failure      403     {object}  common.ErrResponse            "Permission denied, plate not authorized or visitor blocked"
+// @Failure      422     {object}  common.ValidationErrResponse  "Validation Failed"
+// @Failure      500     {object}  common.ErrResponse            "Internal server error"
+// @Router       /access_logs/plate [post]
+func (h *RegisterPlateEntryHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	data, err := jsonutils.DecodeJson[RegisterPlateEntryRequest](r)
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid JSON payload",
+		})
+		return
+	}
+
+	if validationErrors := validator.ValidateStruct(data); len(validationErrors) > 0 {
+		jsonutils.EncodeJson(w, r, http.StatusUnprocessableEntity, common.ValidationErrResponse{
+			Message: "Validation failed",
+			Errors:  validationErrors,
+		})
+		return
+	}
+
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	entry, err := h.RegisterPlateEntry.Exec(r.Context(), usecases.RegisterPlateEntryReq{
+		CondominiumID: data.CondominiumID,
+		UserID:        userID,
+		Plate:         data.Plate,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrInvalidPlate):
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: err.Error(),
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Only condominium staff can register entries",
+			})
+		case errors.Is(err, usecases.ErrVisitorBlocked):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Visitor is on the condominium blocklist",
+			})
+		case errors.Is(err, usecases.ErrPlateNotAuthorized):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: err.Error(),
+			})
+		default:
+			slog.Error("failed to register plate entry", "error", err, "plate", data.Plate)
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to register entry",
+			})
+		}
+		return
+	}
+
+	resp := RegisterPlateEntryResponse{
+		Message:          "Access granted",
+		Plate:            entry.Plate,
+		Vehicle:          toPlateVehicle(entry.Vehicle),
+		RemainingEntries: entry.RemainingEntries,
+	}
+	if entry.Invite != nil {
+		invite := toPlateInviteItem(*entry.Invite, true)
+		resp.Invite = &invite
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusCreated, resp)
+}
@@ -0,0 +1,124 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/Bellorico323/vizen/internal/validator"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type ReleaseBookingDepositHandler struct {
+	ReleaseBookingDeposit usecases.ReleaseBookingDepositUC
+}
+
+type ReleaseBookingDepositRequest struct {
+	RetainedCents int64   `json:"retainedCents" validate:"min=0"`
+	Reason        *string `json:"reason" validate:"omitempty,max=500"`
+}
+
+type ReleaseBookingDepositResponse struct {
+	Message string          `json:"message"`
+	Booking pgstore.Booking `json:"booking"`
+}
+
+// Handle records the release of a booking deposit
+// @Summary      Release Booking Deposit
+// @Description  Records that the paid security deposit of a booking was given back to the apartment, once the booking ended or was cancelled. Part of it can be retained to cover damages, with a reason. Only Admin/Syndic can perform this action.
+// @Tags         Bookings
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id       path      string                                   true  "Booking UUID"
+// @Param        request  body      controllers.ReleaseBookingDepositRequest true  "Retained amount"
+// @Success      200      {object}  controllers.ReleaseBookingDepositResponse
+// @Failure      400      {object}  common.ErrResponse            "Invalid ID, payload or retained amount"
+// @Failure      401      {object}  common.ErrResponse            "Unauthorized"
+// @Failure      403      {object}  common.ErrResponse            "Permission denied"
+// @Failure      404      {object}  common.ErrResponse            "Booking not found"
+// @Failure      409      {object}  common.ErrResponse            "No deposit, deposit not paid, already released or booking not finished"
+// @Failure      422      {object}  common.ValidationErrResponse  "Validation failed"
+// @Failure      500      {object}  common.ErrResponse            "Internal Server Error"
+// @Router       /bookings/{id}/deposit/release [post]
+func (h *ReleaseBookingDepositHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	bookingID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid booking ID format",
+		})
+		return
+	}
+
+	data, err := jsonutils.DecodeJson[ReleaseBookingDepositRequest](r)
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid JSON payload",
+		})
+		return
+	}
+
+	if validationErrors := validator.ValidateStruct(data); len(validationErrors) > 0 {
+		jsonutils.EncodeJson(w, r, http.StatusUnprocessableEntity, common.ValidationErrResponse{
+			Message: "Validation failed",
+			Errors:  validationErrors,
+		})
+		return
+	}
+
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	booking, err := h.ReleaseBookingDeposit.Exec(r.Context(), usecases.ReleaseBookingDepositReq{
+		UserID:        userID,
+		BookingID:     bookingID,
+		RetainedCents: data.RetainedCents,
+		Reason:        data.Reason,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrInvalidRetainedAmount),
+			errors.Is(err, usecases.ErrRetentionReasonRequired):
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: err.Error(),
+			})
+		case errors.Is(err, usecases.ErrBookingNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Booking not found",
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Only admins or syndics can release deposits",
+			})
+		case errors.Is(err, usecases.ErrBookingHasNoDeposit),
+			errors.Is(err, usecases.ErrDepositNotPaid),
+			errors.Is(err, usecases.ErrDepositAlreadyReleased),
+			errors.Is(err, usecases.ErrBookingNotFinished):
+			jsonutils.EncodeJson(w, r, http.StatusConflict, common.ErrResponse{
+				Message: err.Error(),
+			})
+		default:
+			slog.Error("failed to release booking deposit", "error", err, "bookingId", bookingID)
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to release deposit",
+			})
+		}
+		return
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusOK, ReleaseBookingDepositResponse{
+		Message: "Deposit released",
+		Booking: booking,
+	})
+}
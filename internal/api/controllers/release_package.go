@@ -0,0 +1,115 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type ReleasePackageHandler struct {
+	ReleasePackage usecases.ReleasePackageUC
+}
+
+type ReleasePackageRequest struct {
+	PickupCode *string `json:"pickupCode"`
+}
+
+type ReleasePackageResponse struct {
+	ReleaseUntil time.Time `json:"releaseUntil"`
+}
+
+// Handle releases a package so the resident can confirm the pickup
+// @Summary      Release package at the desk
+// @Description  Called by the doorman when the resident is physically at the desk and presents the pickup code. Opens a short window in which the resident can confirm the pickup from the app.
+// @Tags         Packages
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id      path      string                            true  "Package UUID"
+// @Param        request body      controllers.ReleasePackageRequest true  "Pickup code"
+// @Success      200     {object}  controllers.ReleasePackageResponse
+// @Failure      400     {object}  common.ErrResponse "Invalid ID or Payload"
+// @Failure      401     {object}  common.ErrResponse "User not authenticated"
+// @Failure      403     {object}  common.ErrResponse "Permission denied"
+// @Failure      404     {object}  common.ErrResponse "Package not found"
+// @Failure      409     {object}  common.ErrResponse "Package already withdrawn or returned"
+// @Failure      422     {object}  common.ErrResponse "Missing or invalid pickup code"
+// @Failure      423     {object}  common.ErrResponse "Pickup code locked"
+// @Failure      500     {object}  common.ErrResponse "Internal server error"
+// @Router       /packages/{id}/release [post]
+func (h *ReleasePackageHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	packageID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid package ID format",
+		})
+		return
+	}
+
+	data, err := jsonutils.DecodeJson[ReleasePackageRequest](r)
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid JSON payload",
+		})
+		return
+	}
+
+	releaseUntil, err := h.ReleasePackage.Exec(r.Context(), usecases.ReleasePackageReq{
+		PackageID:  packageID,
+		UserID:     userID,
+		PickupCode: data.PickupCode,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrPackageNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Package not found",
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Only condominium staff can release packages",
+			})
+		case errors.Is(err, usecases.ErrPackageAlreadyWithdrawn):
+			jsonutils.EncodeJson(w, r, http.StatusConflict, common.ErrResponse{
+				Message: "Package has already been withdrawn",
+			})
+		case errors.Is(err, usecases.ErrPackageReturned):
+			jsonutils.EncodeJson(w, r, http.StatusConflict, common.ErrResponse{
+				Message: "Package was returned to sender",
+			})
+		case errors.Is(err, usecases.ErrPickupCodeRequired),
+			errors.Is(err, usecases.ErrInvalidPickupCode):
+			jsonutils.EncodeJson(w, r, http.StatusUnprocessableEntity, common.ErrResponse{
+				Message: err.Error(),
+			})
+		case errors.Is(err, usecases.ErrPickupCodeLocked):
+			jsonutils.EncodeJson(w, r, http.StatusLocked, common.ErrResponse{
+				Message: err.Error(),
+			})
+		default:
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to release package",
+			})
+		}
+		return
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusOK, ReleasePackageResponse{
+		ReleaseUntil: releaseUntil,
+	})
+}
@@ -0,0 +1,76 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type RemoveBlockedVisitorHandler struct {
+	RemoveBlockedVisitor usecases.RemoveBlockedVisitorUC
+}
+
+// Handle removes a visitor from the condominium blocklist
+// @Summary      Remove Blocked Visitor
+// @Description  Lifts a block. The entry is kept for history with who removed it. Admins and syndics only.
+// @Tags         Blocked Visitors
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Blocked visitor UUID"
+// @Success      204  "No Content"
+// @Failure      400  {object}  common.ErrResponse  "Invalid ID"
+// @Failure      401  {object}  common.ErrResponse  "User not authenticated"
+// @Failure      403  {object}  common.ErrResponse  "Permission denied"
+// @Failure      404  {object}  common.ErrResponse  "Blocked visitor not found"
+// @Failure      500  {object}  common.ErrResponse  "Internal server error"
+// @Router       /blocked_visitors/{id} [delete]
+func (h *RemoveBlockedVisitorHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	entryID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid blocked visitor ID format",
+		})
+		return
+	}
+
+	err = h.RemoveBlockedVisitor.Exec(r.Context(), usecases.RemoveBlockedVisitorReq{
+		UserID:           userID,
+		BlockedVisitorID: entryID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrBlockedVisitorNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Blocked visitor not found",
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Only admins and syndics can remove blocked visitors",
+			})
+		default:
+			slog.Error("failed to remove blocked visitor", "error", err, "blockedVisitorId", entryID)
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to remove blocked visitor",
+			})
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,76 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type RemoveVehicleHandler struct {
+	RemoveVehicle usecases.RemoveVehicleUC
+}
+
+// Handle removes a vehicle from an apartment
+// @Summary      Remove Vehicle
+// @Description  Removes a resident vehicle, so its plate no longer opens the gate. Past entries keep referencing it. Available to residents of the apartment and condominium staff.
+// @Tags         Vehicles
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Vehicle UUID"
+// @Success      204  "No Content"
+// @Failure      400  {object}  common.ErrResponse  "Invalid ID"
+// @Failure      401  {object}  common.ErrResponse  "User not authenticated"
+// @Failure      403  {object}  common.ErrResponse  "Permission denied"
+// @Failure      404  {object}  common.ErrResponse  "Vehicle not found"
+// @Failure      500  {object}  common.ErrResponse  "Internal server error"
+// @Router       /vehicles/{id} [delete]
+func (h *RemoveVehicleHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	vehicleID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid vehicle ID format",
+		})
+		return
+	}
+
+	err = h.RemoveVehicle.Exec(r.Context(), usecases.RemoveVehicleReq{
+		UserID:    userID,
+		VehicleID: vehicleID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrVehicleNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Vehicle not found",
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "You do not have permission to remove this vehicle",
+			})
+		default:
+			slog.Error("failed to remove vehicle", "error", err, "vehicleId", vehicleID)
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to remove vehicle",
+			})
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,82 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type ResetPackagePickupCodeHandler struct {
+	ResetPackagePickupCode usecases.ResetPackagePickupCodeUC
+}
+
+// Handle generates a new pickup code for a package
+// @Summary      Reset package pickup code
+// @Description  Generates a new pickup code, clears failed attempts and unlocks the package. The new code is pushed to the apartment residents. Only Admin/Syndic can perform this action.
+// @Tags         Packages
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Package UUID"
+// @Success      204  "No Content"
+// @Failure      400  {object}  common.ErrResponse "Invalid ID format"
+// @Failure      401  {object}  common.ErrResponse "User not authenticated"
+// @Failure      403  {object}  common.ErrResponse "Permission denied"
+// @Failure      404  {object}  common.ErrResponse "Package not found"
+// @Failure      409  {object}  common.ErrResponse "Package already withdrawn or returned"
+// @Failure      500  {object}  common.ErrResponse "Internal server error"
+// @Router       /packages/{id}/pickup_code [post]
+func (h *ResetPackagePickupCodeHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	packageID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid package ID format",
+		})
+		return
+	}
+
+	err = h.ResetPackagePickupCode.Exec(r.Context(), usecases.ResetPackagePickupCodeReq{
+		PackageID: packageID,
+		UserID:    userID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrPackageNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Package not found",
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Only admins or syndics can reset pickup codes",
+			})
+		case errors.Is(err, usecases.ErrPackageAlreadyWithdrawn):
+			jsonutils.EncodeJson(w, r, http.StatusConflict, common.ErrResponse{
+				Message: "Package has already been withdrawn",
+			})
+		case errors.Is(err, usecases.ErrPackageReturned):
+			jsonutils.EncodeJson(w, r, http.StatusConflict, common.ErrResponse{
+				Message: "Package was returned to sender",
+			})
+		default:
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to reset pickup code",
+			})
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,114 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/Bellorico323/vizen/internal/validator"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type RespondVisitorRequestHandler struct {
+	RespondVisitorRequest usecases.RespondVisitorRequestUC
+}
+
+type RespondVisitorRequestRequest struct {
+	Decision string `json:"decision" validate:"required,oneof=approve deny"`
+}
+
+// Handle approves or denies a walk-in visitor
+// @Summary      Approve or Deny Visitor
+// @Description  Lets a resident of the target apartment answer a walk-in request. Approving registers the entry in the access log. Visitors blocked in the meantime cannot be approved. The front desk is updated live.
+// @Tags         Visitors
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id      path      string                                   true  "Visitor request UUID"
+// @Param        request body      controllers.RespondVisitorRequestRequest true  "Decision (approve/deny)"
+// @Success      200     {object}  controllers.VisitorRequestItem
+// @Failure      400     {object}  common.ErrResponse            "Invalid Payload or ID"
+// @Failure      401     {object}  common.ErrResponse            "User not authenticated"
+// @Failure      403     {object}  common.ErrResponse            "Permission denied or visitor blocked"
+// @Failure      404     {object}  common.ErrResponse            "Visitor request not found"
+// @Failure      409     {object}  common.ErrResponse            "Already answered"
+// @Failure      410     {object}  common.ErrResponse            "Request expired"
+// @Failure      422     {object}  common.ValidationErrResponse  "Validation Failed"
+// @Failure      500     {object}  common.ErrResponse            "Internal server error"
+// @Router       /visitor_requests/{id}/decision [patch]
+func (h *RespondVisitorRequestHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	visitorRequestID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid visitor request ID format",
+		})
+		return
+	}
+
+	data, err := jsonutils.DecodeJson[RespondVisitorRequestRequest](r)
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid JSON payload",
+		})
+		return
+	}
+
+	if validationErrors := validator.ValidateStruct(data); len(validationErrors) > 0 {
+		jsonutils.EncodeJson(w, r, http.StatusUnprocessableEntity, common.ValidationErrResponse{
+			Message: "Validation failed",
+			Errors:  validationErrors,
+		})
+		return
+	}
+
+	visitor, err := h.RespondVisitorRequest.Exec(r.Context(), usecases.RespondVisitorRequestReq{
+		VisitorRequestID: visitorRequestID,
+		UserID:           userID,
+		Approve:          data.Decision == "approve",
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrVisitorRequestNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Visitor request not found",
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Only residents of the apartment can answer this visitor",
+			})
+		case errors.Is(err, usecases.ErrVisitorBlocked):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Visitor is on the condominium blocklist",
+			})
+		case errors.Is(err, usecases.ErrVisitorRequestNotPending):
+			jsonutils.EncodeJson(w, r, http.StatusConflict, common.ErrResponse{
+				Message: err.Error(),
+			})
+		case errors.Is(err, usecases.ErrVisitorRequestExpired):
+			jsonutils.EncodeJson(w, r, http.StatusGone, common.ErrResponse{
+				Message: err.Error(),
+			})
+		default:
+			slog.Error("failed to answer visitor request", "error", err, "visitorRequestId", visitorRequestID)
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to answer visitor request",
+			})
+		}
+		return
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusOK, toVisitorRequestItem(visitor, nil))
+}
@@ -0,0 +1,86 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type ReturnPackageHandler struct {
+	ReturnPackage usecases.ReturnPackageUC
+}
+
+// Handle marks a package as returned to sender
+// @Summary      Return package to sender
+// @Description  Marks a pending package as returned to sender, usually after it exceeded the storage limit. Residents of the apartment are notified. Only condominium staff can perform this action.
+// @Tags         Packages
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Package UUID"
+// @Success      204  "No Content"
+// @Failure      400  {object}  common.ErrResponse "Invalid ID format"
+// @Failure      401  {object}  common.ErrResponse "User not authenticated"
+// @Failure      403  {object}  common.ErrResponse "Permission denied"
+// @Failure      404  {object}  common.ErrResponse "Package not found"
+// @Failure      409  {object}  common.ErrResponse "Package already withdrawn or returned"
+// @Failure      500  {object}  common.ErrResponse "Internal server error"
+// @Router       /packages/{id}/return [patch]
+func (h *ReturnPackageHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	packageID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid package ID format",
+		})
+		return
+	}
+
+	err = h.ReturnPackage.Exec(r.Context(), usecases.ReturnPackageReq{
+		PackageID: packageID,
+		UserID:    userID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrPackageNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Package not found",
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Only condominium staff can return packages",
+			})
+		case errors.Is(err, usecases.ErrPackageAlreadyWithdrawn):
+			jsonutils.EncodeJson(w, r, http.StatusConflict, common.ErrResponse{
+				Message: "Package has already been withdrawn",
+			})
+		case errors.Is(err, usecases.ErrPackageReturned):
+			jsonutils.EncodeJson(w, r, http.StatusConflict, common.ErrResponse{
+				Message: "Package was returned to sender",
+			})
+		case errors.Is(err, usecases.ErrPackageNotPending):
+			jsonutils.EncodeJson(w, r, http.StatusConflict, common.ErrResponse{
+				Message: "Package is no longer pending",
+			})
+		default:
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to return package",
+			})
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
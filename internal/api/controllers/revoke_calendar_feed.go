@@ -0,0 +1,70 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type RevokeCalendarFeedHandler struct {
+	RevokeCalendarFeed usecases.RevokeCalendarFeedUC
+}
+
+// Handle revokes a calendar feed
+// @Summary      Revoke Calendar Feed
+// @Description  Revokes one of the user's iCalendar feeds. Its URL stops working right away; subscribed calendar apps stop receiving updates.
+// @Tags         Calendar
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Calendar feed UUID"
+// @Success      204  "No Content"
+// @Failure      400  {object}  common.ErrResponse  "Invalid ID"
+// @Failure      401  {object}  common.ErrResponse  "User not authenticated"
+// @Failure      404  {object}  common.ErrResponse  "Calendar feed not found"
+// @Failure      500  {object}  common.ErrResponse  "Internal server error"
+// @Router       /calendar_feeds/{id} [delete]
+func (h *RevokeCalendarFeedHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	feedID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid calendar feed ID format",
+		})
+		return
+	}
+
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	err = h.RevokeCalendarFeed.Exec(r.Context(), usecases.RevokeCalendarFeedReq{
+		UserID: userID,
+		FeedID: feedID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrCalendarFeedNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Calendar feed not found",
+			})
+		default:
+			slog.Error("failed to revoke calendar feed", "error", err, "feedId", feedID)
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to revoke calendar feed",
+			})
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
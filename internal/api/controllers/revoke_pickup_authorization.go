@@ -0,0 +1,86 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type RevokePickupAuthorizationHandler struct {
+	RevokePickupAuthorization usecases.RevokePickupAuthorizationUC
+}
+
+// Handle revokes a pickup authorization
+// @Summary      Revoke Pickup Authorization
+// @Description  Revokes a third party pickup authorization. Residents of the apartment and condominium admins can revoke it.
+// @Tags         Packages
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Pickup authorization UUID"
+// @Success      204  "No Content"
+// @Failure      400  {object}  common.ErrResponse  "Invalid ID"
+// @Failure      401  {object}  common.ErrResponse  "User not authenticated"
+// @Failure      403  {object}  common.ErrResponse  "Permission denied"
+// @Failure      404  {object}  common.ErrResponse  "Pickup authorization not found"
+// @Failure      409  {object}  common.ErrResponse  "Pickup authorization already revoked"
+// @Failure      500  {object}  common.ErrResponse  "Internal server error"
+// @Router       /pickup_authorizations/{id} [delete]
+func (h *RevokePickupAuthorizationHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	authorizationID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid pickup authorization ID format",
+		})
+		return
+	}
+
+	err = h.RevokePickupAuthorization.Exec(r.Context(), usecases.RevokePickupAuthorizationReq{
+		UserID:          userID,
+		AuthorizationID: authorizationID,
+	})
+	if err != nil {
+		slog.Error("failed to revoke pickup authorization",
+			"error", err,
+			"authorizationId", authorizationID,
+			"userId", userID,
+		)
+
+		switch {
+		case errors.Is(err, usecases.ErrPickupAuthorizationNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Pickup authorization not found",
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "You do not have permission to revoke this authorization",
+			})
+		case errors.Is(err, usecases.ErrPickupAuthorizationRevoked):
+			jsonutils.EncodeJson(w, r, http.StatusConflict, common.ErrResponse{
+				Message: "Pickup authorization is already revoked",
+			})
+		default:
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to revoke pickup authorization",
+			})
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
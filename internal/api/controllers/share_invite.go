@@ -0,0 +1,89 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type ShareInviteHandler struct {
+	ShareInvite usecases.ShareInviteUC
+}
+
+type ShareInviteResponse struct {
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// Handle creates a shareable guest link for an invite
+// @Summary      Share Invite
+// @Description  Returns a signed short link that can be sent to the guest. The link opens a public page (or JSON with Accept: application/json) with the guest name, unit and validity, plus the QR code to show at the gate. It stops working when the invite expires or is revoked.
+// @Tags         Invites
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id   path      string  true  "Invite UUID"
+// @Success      200  {object}  controllers.ShareInviteResponse
+// @Failure      400  {object}  common.ErrResponse  "Invalid ID, invite revoked or expired"
+// @Failure      401  {object}  common.ErrResponse  "User not authenticated"
+// @Failure      403  {object}  common.ErrResponse  "Permission denied"
+// @Failure      404  {object}  common.ErrResponse  "Invite not found"
+// @Failure      500  {object}  common.ErrResponse  "Internal server error"
+// @Router       /invites/{id}/share [post]
+func (h *ShareInviteHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	inviteID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid invite ID format",
+		})
+		return
+	}
+
+	link, err := h.ShareInvite.Exec(r.Context(), usecases.ShareInviteReq{
+		UserID:   userID,
+		InviteID: inviteID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrInviteNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Invite not found",
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Permission denied",
+			})
+		case errors.Is(err, usecases.ErrInviteAlreadyRevoked),
+			errors.Is(err, usecases.ErrInviteExpired):
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: err.Error(),
+			})
+		default:
+			slog.Error("failed to share invite", "error", err, "inviteId", inviteID)
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to share invite",
+			})
+		}
+		return
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusOK, ShareInviteResponse{
+		URL:       link.URL,
+		ExpiresAt: link.ExpiresAt,
+	})
+}
@@ -0,0 +1,142 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/Bellorico323/vizen/internal/validator"
+	"github.com/google/uuid"
+)
+
+type SyncOfflineEntriesHandler struct {
+	SyncOfflineEntries usecases.SyncOfflineEntriesUC
+}
+
+type OfflineEntryRequest struct {
+	ID        uuid.UUID `json:"id" validate:"required"`
+	Token     string    `json:"token" validate:"required"`
+	EnteredAt time.Time `json:"enteredAt" validate:"required"`
+}
+
+type SyncOfflineEntriesRequest struct {
+	CondominiumID uuid.UUID             `json:"condominiumId" validate:"required,uuid4"`
+	Entries       []OfflineEntryRequest `json:"entries" validate:"required,max=500,dive"`
+}
+
+type OfflineEntryResultItem struct {
+	ID uuid.UUID `json:"id"`
+	// Status is accepted, duplicate or rejected.
+	Status      string     `json:"status"`
+	Reason      *string    `json:"reason,omitempty"`
+	AccessLogID *uuid.UUID `json:"accessLogId,omitempty"`
+}
+
+type SyncOfflineEntriesResponse struct {
+	Accepted   int                      `json:"accepted"`
+	Duplicates int                      `json:"duplicates"`
+	Rejected   int                      `json:"rejected"`
+	Results    []OfflineEntryResultItem `json:"results"`
+}
+
+// Handle uploads entries recorded offline by gate devices
+// @Summary      Sync Offline Entries
+// @Description  Records the entries a gate device let in while offline, using the signed invite tokens it verified. id is generated by the device for each entry: uploading an entry again reports it as duplicate instead of logging it twice, so failed uploads can simply be retried. Entries with an invalid signature, a token from another condominium or a time outside the invite validity window are rejected. Up to 500 entries per request. Staff only.
+// @Tags         Access Logs
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body      controllers.SyncOfflineEntriesRequest true "Entries recorded offline"
+// @Success      200     {object}  controllers.SyncOfflineEntriesResponse
+// @Failure      400     {object}  common.ErrResponse            "Invalid Payload"
+// @Failure      401     {object}  common.ErrResponse            "User not authenticated"
+// @Failure      403     {object}  common.ErrResponse            "Permission denied"
+// @Failure      422     {object}  common.ValidationErrResponse  "Validation Failed"
+// @Failure      500     {object}  common.ErrResponse            "Internal server error"
+// @Router       /access_logs/sync [post]
+func (h *SyncOfflineEntriesHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	data, err := jsonutils.DecodeJson[SyncOfflineEntriesRequest](r)
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid JSON payload",
+		})
+		return
+	}
+
+	if validationErrors := validator.ValidateStruct(data); len(validationErrors) > 0 {
+		jsonutils.EncodeJson(w, r, http.StatusUnprocessableEntity, common.ValidationErrResponse{
+			Message: "Validation failed",
+			Errors:  validationErrors,
+		})
+		return
+	}
+
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	entries := make([]usecases.OfflineEntry, len(data.Entries))
+	for i, entry := range data.Entries {
+		entries[i] = usecases.OfflineEntry{
+			ID:        entry.ID,
+			Token:     entry.Token,
+			EnteredAt: entry.EnteredAt,
+		}
+	}
+
+	results, err := h.SyncOfflineEntries.Exec(r.Context(), usecases.SyncOfflineEntriesReq{
+		CondominiumID: data.CondominiumID,
+		UserID:        userID,
+		Entries:       entries,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrTooManyOfflineEntries):
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: err.Error(),
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Only condominium staff can sync entries",
+			})
+		default:
+			slog.Error("failed to sync offline entries", "error", err, "condominiumId", data.CondominiumID)
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to sync entries",
+			})
+		}
+		return
+	}
+
+	resp := SyncOfflineEntriesResponse{
+		Results: make([]OfflineEntryResultItem, len(results)),
+	}
+	for i, result := range results {
+		switch result.Status {
+		case usecases.OfflineEntryAccepted:
+			resp.Accepted++
+		case usecases.OfflineEntryDuplicate:
+			resp.Duplicates++
+		case usecases.OfflineEntryRejected:
+			resp.Rejected++
+		}
+
+		resp.Results[i] = OfflineEntryResultItem{
+			ID:          result.ID,
+			Status:      result.Status,
+			Reason:      result.Reason,
+			AccessLogID: result.AccessLogID,
+		}
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusOK, resp)
+}
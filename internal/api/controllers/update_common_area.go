@@ -0,0 +1,108 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/Bellorico323/vizen/internal/validator"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type UpdateCommonAreaHandler struct {
+	UpdateCommonArea usecases.UpdateCommonAreaUC
+}
+
+type UpdateCommonAreaRequest struct {
+	Name             string `json:"name" validate:"required,min=3"`
+	Capacity         *int32 `json:"capacity" validate:"omitempty,min=1"`
+	RequiresApproval bool   `json:"requiredApproval"`
+}
+
+// Handle updates a common area
+// @Summary      Update Common Area
+// @Description  Replaces the name, capacity and approval requirement of a common area. Like the rules, they apply to new bookings: existing bookings are kept. Areas taking overlapping bookings must keep a capacity. Only Admin/Syndic can perform this action.
+// @Tags         Common Areas
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id      path      string                              true  "Common Area UUID"
+// @Param        request body      controllers.UpdateCommonAreaRequest true  "Common area data"
+// @Success      200     {object}  controllers.CommonAreaItem
+// @Failure      400     {object}  common.ErrResponse            "Invalid ID or payload"
+// @Failure      401     {object}  common.ErrResponse            "User not authenticated"
+// @Failure      403     {object}  common.ErrResponse            "Permission denied"
+// @Failure      404     {object}  common.ErrResponse            "Common area not found"
+// @Failure      422     {object}  common.ValidationErrResponse  "Validation Failed"
+// @Failure      500     {object}  common.ErrResponse            "Internal server error"
+// @Router       /common_areas/{id} [put]
+func (h *UpdateCommonAreaHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	areaID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid common area ID format",
+		})
+		return
+	}
+
+	data, err := jsonutils.DecodeJson[UpdateCommonAreaRequest](r)
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid JSON payload",
+		})
+		return
+	}
+
+	if validationErrors := validator.ValidateStruct(data); len(validationErrors) > 0 {
+		jsonutils.EncodeJson(w, r, http.StatusUnprocessableEntity, common.ValidationErrResponse{
+			Message: "Validation failed",
+			Errors:  validationErrors,
+		})
+		return
+	}
+
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	area, err := h.UpdateCommonArea.Exec(r.Context(), usecases.UpdateCommonAreaReq{
+		UserID:           userID,
+		CommonAreaID:     areaID,
+		Name:             data.Name,
+		Capacity:         data.Capacity,
+		RequiresApproval: data.RequiresApproval,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrOverlappingNeedsCapacity):
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: err.Error(),
+			})
+		case errors.Is(err, usecases.ErrCommonAreaNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Common area not found",
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Only admins or syndics can update common areas",
+			})
+		default:
+			slog.Error("failed to update common area", "error", err, "commonAreaId", areaID)
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to update common area",
+			})
+		}
+		return
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusOK, toCommonAreaItem(area))
+}
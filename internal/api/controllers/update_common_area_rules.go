@@ -0,0 +1,102 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/Bellorico323/vizen/internal/validator"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type UpdateCommonAreaRulesHandler struct {
+	UpdateCommonAreaRules usecases.UpdateCommonAreaRulesUC
+}
+
+// Handle replaces the booking rules of a common area
+// @Summary      Update Common Area Rules
+// @Description  Replaces the whole rule set of a common area; omitted limits are disabled and an empty openingHours keeps the area always open. Existing bookings are kept, the rules apply to new bookings; only the pending expiry also covers bookings already waiting for approval. Only Admin/Syndic can perform this action.
+// @Tags         Common Areas
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id      path      string                          true  "Common Area UUID"
+// @Param        request body      controllers.BookingRulesRequest true  "Booking rules"
+// @Success      200     {object}  controllers.CommonAreaItem
+// @Failure      400     {object}  common.ErrResponse            "Invalid ID, payload or rules"
+// @Failure      401     {object}  common.ErrResponse            "User not authenticated"
+// @Failure      403     {object}  common.ErrResponse            "Permission denied"
+// @Failure      404     {object}  common.ErrResponse            "Common area not found"
+// @Failure      422     {object}  common.ValidationErrResponse  "Validation Failed"
+// @Failure      500     {object}  common.ErrResponse            "Internal server error"
+// @Router       /common_areas/{id}/rules [put]
+func (h *UpdateCommonAreaRulesHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	areaID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid common area ID format",
+		})
+		return
+	}
+
+	data, err := jsonutils.DecodeJson[BookingRulesRequest](r)
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid JSON payload",
+		})
+		return
+	}
+
+	if validationErrors := validator.ValidateStruct(data); len(validationErrors) > 0 {
+		jsonutils.EncodeJson(w, r, http.StatusUnprocessableEntity, common.ValidationErrResponse{
+			Message: "Validation failed",
+			Errors:  validationErrors,
+		})
+		return
+	}
+
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	area, err := h.UpdateCommonAreaRules.Exec(r.Context(), usecases.UpdateCommonAreaRulesReq{
+		UserID:       userID,
+		CommonAreaID: areaID,
+		Rules:        data.toBookingRules(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, usecases.ErrInvalidBookingRules),
+			errors.Is(err, usecases.ErrInvalidOpeningHours),
+			errors.Is(err, usecases.ErrOverlappingNeedsCapacity):
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: err.Error(),
+			})
+		case errors.Is(err, usecases.ErrCommonAreaNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Common area not found",
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Only admins or syndics can change common area rules",
+			})
+		default:
+			slog.Error("failed to update common area rules", "error", err, "commonAreaId", areaID)
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to update common area rules",
+			})
+		}
+		return
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusOK, toCommonAreaItem(area))
+}
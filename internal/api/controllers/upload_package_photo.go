@@ -0,0 +1,126 @@
+package controllers
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type UploadPackagePhotoHandler struct {
+	UploadPackagePhoto usecases.UploadPackagePhotoUC
+	MaxUploadBytes     int64
+}
+
+// Handle uploads the photo of a package
+// @Summary      Upload package photo
+// @Description  Uploads the photo taken at the concierge as multipart/form-data (field "photo"). Only JPEG and PNG are accepted; a thumbnail is generated and any previous photo is replaced. Only condominium staff can perform this action.
+// @Tags         Packages
+// @Accept       multipart/form-data
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id    path      string  true  "Package UUID"
+// @Param        photo formData  file    true  "Package photo"
+// @Success      201   {object}  usecases.PackagePhoto
+// @Failure      400   {object}  common.ErrResponse "Invalid ID or missing file"
+// @Failure      401   {object}  common.ErrResponse "User not authenticated"
+// @Failure      403   {object}  common.ErrResponse "Permission denied"
+// @Failure      404   {object}  common.ErrResponse "Package not found"
+// @Failure      413   {object}  common.ErrResponse "File too large"
+// @Failure      415   {object}  common.ErrResponse "Unsupported file type"
+// @Failure      500   {object}  common.ErrResponse "Internal server error"
+// @Router       /packages/{id}/photo [post]
+func (h *UploadPackagePhotoHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	packageID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid package ID format",
+		})
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, h.MaxUploadBytes)
+	if err := r.ParseMultipartForm(h.MaxUploadBytes); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			jsonutils.EncodeJson(w, r, http.StatusRequestEntityTooLarge, common.ErrResponse{
+				Message: "File too large",
+			})
+			return
+		}
+
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid multipart payload",
+		})
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	file, _, err := r.FormFile("photo")
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Missing photo file",
+		})
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Failed to read photo file",
+		})
+		return
+	}
+
+	photo, err := h.UploadPackagePhoto.Exec(r.Context(), usecases.UploadPackagePhotoReq{
+		PackageID: packageID,
+		UserID:    userID,
+		Data:      data,
+	})
+	if err != nil {
+		slog.Error("Error while uploading package photo",
+			"error", err,
+			"package_id", packageID,
+			"user_id", userID,
+		)
+
+		switch {
+		case errors.Is(err, usecases.ErrPackageNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Package not found",
+			})
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Only condominium staff can upload package photos",
+			})
+		case errors.Is(err, usecases.ErrUnsupportedFileType),
+			errors.Is(err, usecases.ErrInvalidImage):
+			jsonutils.EncodeJson(w, r, http.StatusUnsupportedMediaType, common.ErrResponse{
+				Message: err.Error(),
+			})
+		default:
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to upload package photo",
+			})
+		}
+		return
+	}
+
+	jsonutils.EncodeJson(w, r, http.StatusCreated, photo)
+}
@@ -26,11 +26,13 @@ type ValidateInviteRequest struct {
 type ValidateInviteResponse struct {
 	Message string                      `json:"message"`
 	Invite  pgstore.GetInviteByTokenRow `json:"invite"`
+	// RemainingEntries is null when the invite has no entry limit.
+	RemainingEntries *int32 `json:"remainingEntries"`
 }
 
 // Handle validates an access token
 // @Summary      Validate Access Token (QR Code)
-// @Description  Validates a visitor's QR code token. Checks if the token exists, belongs to the condominium, is within the valid time window, and has not been revoked. Records the entry in access logs upon success.
+// @Description  Validates a visitor's QR code token. Checks if the token exists, belongs to the condominium, is within the valid time window, and has not been revoked. Recurring invites are also checked against their weekdays and daily window in the condominium's timezone. Invites with an entry limit are refused once all entries were used; the response carries the remaining entries. Guests matching the condominium blocklist (by name or plate) are refused and the condominium admins are alerted. Records the entry in access logs upon success.
 // @Tags         Invites
 // @Accept       json
 // @Produce      json
@@ -39,7 +41,7 @@ type ValidateInviteResponse struct {
 // @Success      200     {object}  controllers.ValidateInviteResponse
 // @Failure      400     {object}  common.ErrResponse            "Invalid Payload"
 // @Failure      401     {object}  common.ErrResponse            "User not authenticated"
-// @Failure      403     {object}  common.ErrResponse            "Business Rule Violation (Expired, Revoked, Not Started) or Permission Denied"
+// @Failure      403     {object}  common.ErrResponse            "Business Rule Violation (Expired, Revoked, Not Started, Outside Schedule, Exhausted), blocked visitor or Permission Denied"
 // @Failure      404     {object}  common.ErrResponse            "Invite/Token not found"
 // @Failure      422     {object}  common.ValidationErrResponse  "Validation Failed"
 // @Failure      500     {object}  common.ErrResponse            "Internal server error"
@@ -75,7 +77,7 @@ func (h *ValidateInviteHandler) Handle(w http.ResponseWriter, r *http.Request) {
 		UserID:        userId,
 	}
 
-	invite, err := h.ValidateInvite.Exec(r.Context(), payload)
+	result, err := h.ValidateInvite.Exec(r.Context(), payload)
 	if err != nil {
 		slog.Error("Error while validating invite",
 			"error", err,
@@ -110,6 +112,21 @@ func (h *ValidateInviteHandler) Handle(w http.ResponseWriter, r *http.Request) {
 				Message: err.Error(),
 			})
 
+		case errors.Is(err, usecases.ErrInviteExhausted):
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: err.Error(),
+			})
+
+		case errors.Is(err, usecases.ErrInviteOutsideSchedule):
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: err.Error(),
+			})
+
+		case errors.Is(err, usecases.ErrVisitorBlocked):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Visitor is on the condominium blocklist",
+			})
+
 		default:
 			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
 				Message: "An unexpected error occurred while validating invite",
@@ -120,7 +137,8 @@ func (h *ValidateInviteHandler) Handle(w http.ResponseWriter, r *http.Request) {
 	}
 
 	jsonutils.EncodeJson(w, r, http.StatusOK, ValidateInviteResponse{
-		Message: "Access granted",
-		Invite:  invite,
+		Message:          "Access granted",
+		Invite:           result.Invite,
+		RemainingEntries: result.RemainingEntries,
 	})
 }
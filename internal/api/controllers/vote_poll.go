@@ -0,0 +1,129 @@
+package controllers
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/Bellorico323/vizen/internal/validator"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type VotePollHandler struct {
+	VotePoll usecases.VotePollUC
+}
+
+type VotePollRequest struct {
+	ApartmentID uuid.UUID   `json:"apartmentId" validate:"required"`
+	OptionIDs   []uuid.UUID `json:"optionIds" validate:"required,min=1"`
+}
+
+// Handle registers the apartment ballot
+// @Summary      Vote in Poll
+// @Description  Registers the ballot of an apartment. Each apartment votes once; any resident of the apartment may cast the vote.
+// @Tags         Polls
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id      path      string                     true  "Poll UUID"
+// @Param        request body      controllers.VotePollRequest true  "Ballot"
+// @Success      204     "No Content"
+// @Failure      400     {object}  common.ErrResponse            "Invalid payload or options"
+// @Failure      401     {object}  common.ErrResponse            "User not authenticated"
+// @Failure      403     {object}  common.ErrResponse            "User is not a resident of the apartment"
+// @Failure      404     {object}  common.ErrResponse            "Poll or apartment not found"
+// @Failure      409     {object}  common.ErrResponse            "Apartment already voted or poll not open"
+// @Failure      422     {object}  common.ValidationErrResponse  "Validation failed"
+// @Failure      500     {object}  common.ErrResponse            "Internal server error"
+// @Router       /polls/{id}/votes [post]
+func (h *VotePollHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	pollID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid poll ID format",
+		})
+		return
+	}
+
+	data, err := jsonutils.DecodeJson[VotePollRequest](r)
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+			Message: "Invalid JSON payload",
+		})
+		return
+	}
+
+	if validationErrors := validator.ValidateStruct(data); len(validationErrors) > 0 {
+		jsonutils.EncodeJson(w, r, http.StatusUnprocessableEntity, common.ValidationErrResponse{
+			Message: "Validation failed",
+			Errors:  validationErrors,
+		})
+		return
+	}
+
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	err = h.VotePoll.Exec(r.Context(), usecases.VotePollReq{
+		PollID:      pollID,
+		UserID:      userID,
+		ApartmentID: data.ApartmentID,
+		OptionIDs:   data.OptionIDs,
+	})
+	if err != nil {
+		slog.Error("Error while voting in poll",
+			"error", err,
+			"pollId", pollID,
+			"apartmentId", data.ApartmentID,
+			"userId", userID,
+		)
+
+		switch {
+		case errors.Is(err, usecases.ErrPollNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Poll not found",
+			})
+
+		case errors.Is(err, usecases.ErrApartmentNotFound):
+			jsonutils.EncodeJson(w, r, http.StatusNotFound, common.ErrResponse{
+				Message: "Apartment not found",
+			})
+
+		case errors.Is(err, usecases.ErrNoPermission):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "You must be a resident of the apartment to vote",
+			})
+
+		case errors.Is(err, usecases.ErrPollNotOpen),
+			errors.Is(err, usecases.ErrPollAlreadyVoted):
+			jsonutils.EncodeJson(w, r, http.StatusConflict, common.ErrResponse{
+				Message: err.Error(),
+			})
+
+		case errors.Is(err, usecases.ErrApartmentIsNotFromCondominium),
+			errors.Is(err, usecases.ErrInvalidPollChoice),
+			errors.Is(err, usecases.ErrPollSingleChoice):
+			jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{
+				Message: err.Error(),
+			})
+
+		default:
+			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+				Message: "Failed to register vote",
+			})
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
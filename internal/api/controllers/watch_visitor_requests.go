@@ -0,0 +1,113 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/api/common"
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/jsonutils"
+	"github.com/Bellorico323/vizen/internal/usecases"
+	"github.com/google/uuid"
+)
+
+// sseHeartbeat keeps idle connections from being closed by proxies.
+const sseHeartbeat = 25 * time.Second
+
+type WatchVisitorRequestsHandler struct {
+	WatchVisitorRequests usecases.WatchVisitorRequestsUC
+}
+
+// Handle streams walk-in visitor changes to the front desk
+// @Summary      Watch Visitor Requests
+// @Description  Server-Sent Events stream with the walk-in requests of a condominium. Each event is named visitor_request.created or visitor_request.updated and carries the request as JSON (photoUrl is not signed, fetch the request to get it). Staff only.
+// @Tags         Visitors
+// @Produce      text/event-stream
+// @Security     BearerAuth
+// @Param        condominiumId query     string  true  "Condominium UUID"
+// @Success      200           {object}  controllers.VisitorRequestItem "Event payload"
+// @Failure      400           {object}  common.ErrResponse "Invalid condominiumId"
+// @Failure      401           {object}  common.ErrResponse "User not authenticated"
+// @Failure      403           {object}  common.ErrResponse "Permission denied"
+// @Failure      500           {object}  common.ErrResponse "Internal server error"
+// @Router       /visitor_requests/events [get]
+func (h *WatchVisitorRequestsHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := auth.GetUserIDFromContext(r.Context())
+	if !ok {
+		jsonutils.EncodeJson(w, r, http.StatusUnauthorized, common.ErrResponse{
+			Message: "User not authenticated",
+		})
+		return
+	}
+
+	condoID, err := uuid.Parse(r.URL.Query().Get("condominiumId"))
+	if err != nil {
+		jsonutils.EncodeJson(w, r, http.StatusBadRequest, common.ErrResponse{Message: "Invalid condominiumId"})
+		return
+	}
+
+	events, unsubscribe, err := h.WatchVisitorRequests.Exec(r.Context(), usecases.WatchVisitorRequestsReq{
+		CondominiumID: condoID,
+		UserID:        userID,
+	})
+	if err != nil {
+		if errors.Is(err, usecases.ErrNoPermission) {
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: "Only condominium staff can watch visitor requests",
+			})
+			return
+		}
+		jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
+			Message: "Failed to watch visitor requests",
+		})
+		return
+	}
+	defer unsubscribe()
+
+	rc := http.NewResponseController(w)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	fmt.Fprint(w, ": connected\n\n")
+	if err := rc.Flush(); err != nil {
+		slog.Error("streaming not supported", "error", err)
+		return
+	}
+
+	heartbeat := time.NewTicker(sseHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": ping\n\n")
+
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+
+			payload, err := json.Marshal(toVisitorRequestItem(event.Request, nil))
+			if err != nil {
+				slog.Error("failed to encode visitor event", "error", err)
+				continue
+			}
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, payload)
+		}
+
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
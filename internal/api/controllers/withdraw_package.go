@@ -17,24 +17,29 @@ type WithdrawPackageHandler struct {
 }
 
 type WithdrawPackageRequest struct {
-	CondominiumID uuid.UUID `json:"condominiumId"`
+	CondominiumID     uuid.UUID `json:"condominiumId"`
+	PickupCode        *string   `json:"pickupCode"`
+	CollectorName     *string   `json:"collectorName"`
+	CollectorDocument *string   `json:"collectorDocument"`
 }
 
 // Handle marks a package as withdrawn
 // @Summary      Withdraw Package
-// @Description  Marks a package as delivered/withdrawn. Can be performed by Condo Staff (for any package) or the Package Owner (Resident).
+// @Description  Marks a package as delivered/withdrawn. Staff must inform the pickup code sent to the residents (the package is locked after 5 invalid attempts). Residents can only confirm the pickup while the package is released at the desk. When a third party collects the package, staff informs their name and document; without a pickup code, the document must match an active pickup authorization from the residents.
 // @Tags         Packages
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        id      path      string                           true  "Package UUID"
-// @Param        request body      controllers.WithdrawPackageRequest true  "Condominium ID"
+// @Param        request body      controllers.WithdrawPackageRequest true  "Condominium ID and pickup code"
 // @Success      204     "No Content"
 // @Failure      400     {object}  common.ErrResponse             "Invalid ID or Payload"
 // @Failure      401     {object}  common.ErrResponse             "User not authenticated"
-// @Failure      403     {object}  common.ErrResponse             "Permission denied"
+// @Failure      403     {object}  common.ErrResponse             "Permission denied, package not released at the desk or no pickup authorization"
 // @Failure      404     {object}  common.ErrResponse             "Package not found"
-// @Failure      409     {object}  common.ErrResponse             "Package already withdrawn"
+// @Failure      409     {object}  common.ErrResponse             "Package already withdrawn or returned"
+// @Failure      422     {object}  common.ErrResponse             "Missing or invalid pickup code"
+// @Failure      423     {object}  common.ErrResponse             "Pickup code locked"
 // @Failure      500     {object}  common.ErrResponse             "Internal server error"
 // @Router       /packages/{id}/withdraw [patch]
 func (h *WithdrawPackageHandler) Handle(w http.ResponseWriter, r *http.Request) {
@@ -64,9 +69,12 @@ func (h *WithdrawPackageHandler) Handle(w http.ResponseWriter, r *http.Request)
 	}
 
 	err = h.WithdrawPackage.Exec(r.Context(), usecases.WithdrawPackageReq{
-		PackageID:     packageID,
-		UserID:        userID,
-		CondominiumID: data.CondominiumID,
+		PackageID:         packageID,
+		UserID:            userID,
+		CondominiumID:     data.CondominiumID,
+		PickupCode:        data.PickupCode,
+		CollectorName:     data.CollectorName,
+		CollectorDocument: data.CollectorDocument,
 	})
 
 	if err != nil {
@@ -83,6 +91,28 @@ func (h *WithdrawPackageHandler) Handle(w http.ResponseWriter, r *http.Request)
 			jsonutils.EncodeJson(w, r, http.StatusConflict, common.ErrResponse{
 				Message: "Package has already been withdrawn",
 			})
+		case errors.Is(err, usecases.ErrPackageReturned):
+			jsonutils.EncodeJson(w, r, http.StatusConflict, common.ErrResponse{
+				Message: "Package was returned to sender",
+			})
+		case errors.Is(err, usecases.ErrPackageNotPending):
+			jsonutils.EncodeJson(w, r, http.StatusConflict, common.ErrResponse{
+				Message: "Package is no longer pending",
+			})
+		case errors.Is(err, usecases.ErrPackageNotReleased),
+			errors.Is(err, usecases.ErrNoPickupAuthorization):
+			jsonutils.EncodeJson(w, r, http.StatusForbidden, common.ErrResponse{
+				Message: err.Error(),
+			})
+		case errors.Is(err, usecases.ErrPickupCodeRequired),
+			errors.Is(err, usecases.ErrInvalidPickupCode):
+			jsonutils.EncodeJson(w, r, http.StatusUnprocessableEntity, common.ErrResponse{
+				Message: err.Error(),
+			})
+		case errors.Is(err, usecases.ErrPickupCodeLocked):
+			jsonutils.EncodeJson(w, r, http.StatusLocked, common.ErrResponse{
+				Message: err.Error(),
+			})
 		default:
 			jsonutils.EncodeJson(w, r, http.StatusInternalServerError, common.ErrResponse{
 				Message: "Failed to withdraw package",
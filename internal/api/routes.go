@@ -74,6 +74,12 @@ func (api *Api) BindRoutes() {
 				})
 			})
 
+			// Public, authorized by URL signature
+			r.Get("/files/*", api.DownloadFileController.Handle)
+			r.Get("/g/{code}", api.GetPublicInviteController.Handle)
+			r.Get("/calendar/{code}", api.GetCalendarFeedController.Handle)
+			r.Get("/.well-known/jwks.json", api.GetInviteKeysController.Handle)
+
 			r.Route("/auth", func(r chi.Router) {
 				r.Post("/refresh", api.RefreshTokenController.Handle)
 				r.Post("/logout", api.LogoutController.Handle)
@@ -102,25 +108,82 @@ func (api *Api) BindRoutes() {
 				})
 				r.Route("/packages", func(r chi.Router) {
 					r.Post("/", api.CreatePackageController.Handle)
+					r.Post("/batch", api.CreatePackagesBatchController.Handle)
 					r.Get("/{id}", api.GetPackageController.Handle)
 					r.Get("/", api.ListPackagesController.Handle)
 					r.Patch("/{id}/withdraw", api.WithdrawPackageController.Handle)
+					r.Post("/{id}/photo", api.UploadPackagePhotoController.Handle)
+					r.Get("/{id}/photo", api.GetPackagePhotoController.Handle)
+					r.Post("/{id}/release", api.ReleasePackageController.Handle)
+					r.Post("/{id}/pickup_code", api.ResetPackagePickupCodeController.Handle)
+					r.Patch("/{id}/return", api.ReturnPackageController.Handle)
+				})
+				r.Route("/pickup_authorizations", func(r chi.Router) {
+					r.Post("/", api.CreatePickupAuthorizationController.Handle)
+					r.Get("/", api.ListPickupAuthorizationsController.Handle)
+					r.Delete("/{id}", api.RevokePickupAuthorizationController.Handle)
 				})
 				r.Route("/invites", func(r chi.Router) {
 					r.Post("/", api.CreateInviteController.Handle)
 					r.Post("/validate", api.ValidateInviteController.Handle)
 					r.Patch("/{id}/revoke", api.RevokeInviteController.Handle)
 					r.Get("/", api.ListInvitesController.Handle)
+					r.Get("/{id}/qrcode", api.GetInviteQRCodeController.Handle)
+					r.Get("/{id}/offline_token", api.GetInviteOfflineTokenController.Handle)
+					r.Post("/{id}/share", api.ShareInviteController.Handle)
+				})
+				r.Route("/access_logs", func(r chi.Router) {
+					r.Get("/", api.ListAccessLogsController.Handle)
+					r.Get("/inside", api.ListPeopleInsideController.Handle)
+					r.Patch("/{id}/exit", api.RegisterAccessExitController.Handle)
+					r.Post("/plate", api.RegisterPlateEntryController.Handle)
+					r.Post("/sync", api.SyncOfflineEntriesController.Handle)
+				})
+				r.Route("/vehicles", func(r chi.Router) {
+					r.Post("/", api.CreateVehicleController.Handle)
+					r.Get("/", api.ListVehiclesController.Handle)
+					r.Get("/lookup", api.LookupPlateController.Handle)
+					r.Delete("/{id}", api.RemoveVehicleController.Handle)
+				})
+				r.Route("/blocked_visitors", func(r chi.Router) {
+					r.Post("/", api.CreateBlockedVisitorController.Handle)
+					r.Get("/", api.ListBlockedVisitorsController.Handle)
+					r.Delete("/{id}", api.RemoveBlockedVisitorController.Handle)
+				})
+				r.Route("/visitor_requests", func(r chi.Router) {
+					r.Post("/", api.CreateVisitorRequestController.Handle)
+					r.Get("/", api.ListVisitorRequestsController.Handle)
+					r.Get("/events", api.WatchVisitorRequestsController.Handle)
+					r.Get("/{id}", api.GetVisitorRequestController.Handle)
+					r.Patch("/{id}/decision", api.RespondVisitorRequestController.Handle)
 				})
 				r.Route("/common_areas", func(r chi.Router) {
 					r.Post("/", api.CreateCommonAreaController.Handle)
 					r.Get("/", api.ListCommonAreasController.Handle)
 					r.Get("/{id}/availability", api.GetAreaAvailabilityController.Handle)
+					r.Put("/{id}", api.UpdateCommonAreaController.Handle)
+					r.Delete("/{id}", api.ArchiveCommonAreaController.Handle)
+					r.Put("/{id}/rules", api.UpdateCommonAreaRulesController.Handle)
+					r.Post("/{id}/blackouts", api.CreateCommonAreaBlackoutController.Handle)
+					r.Get("/{id}/blackouts", api.ListCommonAreaBlackoutsController.Handle)
+					r.Delete("/{id}/blackouts/{blackoutId}", api.DeleteCommonAreaBlackoutController.Handle)
 				})
 				r.Route("/bookings", func(r chi.Router) {
 					r.Post("/", api.CreateBookingController.Handle)
 					r.Patch("/{id}/status", api.EditBookingController.Handle)
+					r.Post("/{id}/cancel", api.CancelBookingController.Handle)
+					r.Post("/{id}/deposit/release", api.ReleaseBookingDepositController.Handle)
 					r.Get("/", api.ListBookingsController.Handle)
+
+					r.Post("/waitlist", api.JoinBookingWaitlistController.Handle)
+					r.Get("/waitlist", api.ListBookingWaitlistController.Handle)
+					r.Post("/waitlist/{id}/accept", api.AcceptWaitlistOfferController.Handle)
+					r.Delete("/waitlist/{id}", api.LeaveBookingWaitlistController.Handle)
+				})
+				r.Route("/calendar_feeds", func(r chi.Router) {
+					r.Post("/", api.CreateCalendarFeedController.Handle)
+					r.Get("/", api.ListCalendarFeedsController.Handle)
+					r.Delete("/{id}", api.RevokeCalendarFeedController.Handle)
 				})
 				r.Route("/bills", func(r chi.Router) {
 					r.Post("/", api.CreateBillController.Handle)
@@ -128,6 +191,13 @@ func (api *Api) BindRoutes() {
 					r.Patch("/{id}/cancel", api.CancelBillController.Handle)
 					r.Get("/", api.ListBillsController.Handle)
 				})
+				r.Route("/polls", func(r chi.Router) {
+					r.Post("/", api.CreatePollController.Handle)
+					r.Get("/", api.ListPollsController.Handle)
+					r.Get("/{id}", api.GetPollController.Handle)
+					r.Post("/{id}/votes", api.VotePollController.Handle)
+					r.Patch("/{id}/close", api.ClosePollController.Handle)
+				})
 			})
 		})
 	})
@@ -0,0 +1,181 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+var ErrInvalidInviteToken = errors.New("invalid invite token")
+
+const inviteTokenIssuer = "vizen-api"
+
+// InviteTokenClaims carries everything a gate device needs to check an invite without reaching the
+// API. Subject is the invite ID, NotBefore/ExpiresAt are the invite validity window.
+type InviteTokenClaims struct {
+	CondominiumID uuid.UUID `json:"cid"`
+	ApartmentID   uuid.UUID `json:"aid"`
+	GuestName     string    `json:"gn"`
+	GuestType     string    `json:"gt"`
+	GuestPlate    *string   `json:"pl,omitempty"`
+	MaxEntries    *int32    `json:"max,omitempty"`
+
+	// Recurring invites: weekdays (0 = Sunday) and daily window in minutes since midnight, in the
+	// condominium timezone.
+	Weekdays         []int16 `json:"wd,omitempty"`
+	DailyStartMinute *int16  `json:"ds,omitempty"`
+	DailyEndMinute   *int16  `json:"de,omitempty"`
+	Timezone         string  `json:"tz,omitempty"`
+
+	jwt.RegisteredClaims
+}
+
+// JWK is an Ed25519 public key in the JSON Web Key format (RFC 8037).
+type JWK struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// InviteTokenSigner issues Ed25519 signed invite tokens that gate devices verify offline against
+// the published key set. Previous public keys stay in the key set while tokens signed with them
+// are still around, so the signing key can be rotated.
+type InviteTokenSigner struct {
+	privateKey ed25519.PrivateKey
+	keyID      string
+	publicKeys map[string]ed25519.PublicKey
+	keySet     JWKS
+}
+
+func NewInviteTokenSigner() (*InviteTokenSigner, error) {
+	privKeyContent := os.Getenv("INVITE_SIGNING_KEY")
+	if privKeyContent == "" {
+		return nil, fmt.Errorf("INVITE_SIGNING_KEY is missing")
+	}
+
+	privKeyBytes, err := base64.StdEncoding.DecodeString(privKeyContent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode invite signing key base64: %w", err)
+	}
+
+	key, err := jwt.ParseEdPrivateKeyFromPEM(privKeyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse invite signing key: %w", err)
+	}
+
+	privateKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("invite signing key is not an Ed25519 key")
+	}
+
+	s := &InviteTokenSigner{
+		privateKey: privateKey,
+		publicKeys: make(map[string]ed25519.PublicKey),
+	}
+	s.keyID = s.addPublicKey(privateKey.Public().(ed25519.PublicKey))
+
+	// Comma separated, base64 encoded PEM public keys of previous signing keys.
+	for content := range strings.SplitSeq(os.Getenv("INVITE_PREVIOUS_PUBLIC_KEYS"), ",") {
+		content = strings.TrimSpace(content)
+		if content == "" {
+			continue
+		}
+
+		pubKeyBytes, err := base64.StdEncoding.DecodeString(content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode previous invite public key base64: %w", err)
+		}
+
+		pub, err := jwt.ParseEdPublicKeyFromPEM(pubKeyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse previous invite public key: %w", err)
+		}
+
+		publicKey, ok := pub.(ed25519.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("previous invite public key is not an Ed25519 key")
+		}
+		s.addPublicKey(publicKey)
+	}
+
+	return s, nil
+}
+
+func (s *InviteTokenSigner) addPublicKey(key ed25519.PublicKey) string {
+	sum := sha256.Sum256(key)
+	kid := base64.RawURLEncoding.EncodeToString(sum[:8])
+
+	if _, ok := s.publicKeys[kid]; !ok {
+		s.publicKeys[kid] = key
+		s.keySet.Keys = append(s.keySet.Keys, JWK{
+			Kty: "OKP",
+			Crv: "Ed25519",
+			X:   base64.RawURLEncoding.EncodeToString(key),
+			Kid: kid,
+			Alg: "EdDSA",
+			Use: "sig",
+		})
+	}
+
+	return kid
+}
+
+// KeySet returns the public keys devices use to verify invite tokens.
+func (s *InviteTokenSigner) KeySet() JWKS {
+	return s.keySet
+}
+
+func (s *InviteTokenSigner) Sign(claims InviteTokenClaims) (string, error) {
+	claims.Issuer = inviteTokenIssuer
+
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+	token.Header["kid"] = s.keyID
+
+	signedToken, err := token.SignedString(s.privateKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign invite token: %w", err)
+	}
+
+	return signedToken, nil
+}
+
+// Parse checks the token signature and returns its claims. The validity window is not checked:
+// devices upload entries after the fact, so callers compare it with the time of the entry.
+func (s *InviteTokenSigner) Parse(tokenString string) (InviteTokenClaims, error) {
+	var claims InviteTokenClaims
+
+	_, err := jwt.ParseWithClaims(tokenString, &claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		key, ok := s.publicKeys[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown key id %q", kid)
+		}
+		return key, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodEdDSA.Alg()}),
+		jwt.WithoutClaimsValidation(),
+	)
+	if err != nil {
+		return InviteTokenClaims{}, ErrInvalidInviteToken
+	}
+
+	if claims.Issuer != inviteTokenIssuer || claims.ExpiresAt == nil || claims.NotBefore == nil {
+		return InviteTokenClaims{}, ErrInvalidInviteToken
+	}
+
+	return claims, nil
+}
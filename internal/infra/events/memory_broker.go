@@ -0,0 +1,64 @@
+package events
+
+import (
+	"log/slog"
+	"sync"
+
+	"github.com/Bellorico323/vizen/internal/services"
+	"github.com/google/uuid"
+)
+
+const subscriberBuffer = 16
+
+// MemoryBroker keeps subscribers in process memory, so it only reaches clients connected to the
+// same API instance. Slow subscribers miss events instead of blocking publishers.
+type MemoryBroker struct {
+	mu          sync.RWMutex
+	subscribers map[uuid.UUID]map[chan services.VisitorEvent]struct{}
+}
+
+func NewMemoryBroker() *MemoryBroker {
+	return &MemoryBroker{
+		subscribers: make(map[uuid.UUID]map[chan services.VisitorEvent]struct{}),
+	}
+}
+
+func (b *MemoryBroker) Publish(event services.VisitorEvent) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for ch := range b.subscribers[event.Request.CondominiumID] {
+		select {
+		case ch <- event:
+		default:
+			slog.Warn("Dropping visitor event for slow subscriber", "condo_id", event.Request.CondominiumID, "type", event.Type)
+		}
+	}
+}
+
+func (b *MemoryBroker) Subscribe(condoID uuid.UUID) (<-chan services.VisitorEvent, func()) {
+	ch := make(chan services.VisitorEvent, subscriberBuffer)
+
+	b.mu.Lock()
+	if b.subscribers[condoID] == nil {
+		b.subscribers[condoID] = make(map[chan services.VisitorEvent]struct{})
+	}
+	b.subscribers[condoID][ch] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+
+			delete(b.subscribers[condoID], ch)
+			if len(b.subscribers[condoID]) == 0 {
+				delete(b.subscribers, condoID)
+			}
+			close(ch)
+		})
+	}
+
+	return ch, unsubscribe
+}
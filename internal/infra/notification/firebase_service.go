@@ -90,7 +90,7 @@ func (s *FirebaseService) SendToCondoResidents(ctx context.Context, condoID uuid
 	return s.sendChunks(ctx, tokens, title, body, nil)
 }
 
-func (s *FirebaseService) SendToApartmentResidents(ctx context.Context, apartmentID, packageID uuid.UUID, title, body string) error {
+func (s *FirebaseService) SendToApartmentResidents(ctx context.Context, apartmentID uuid.UUID, title, body string, data map[string]string) error {
 	tokens, err := s.querier.GetManyTokensByApartmentId(ctx, apartmentID)
 	if err != nil {
 		slog.Error("Failed to fetch resident tokens for notification", "error", err)
@@ -101,10 +101,7 @@ func (s *FirebaseService) SendToApartmentResidents(ctx context.Context, apartmen
 		return nil
 	}
 
-	return s.sendChunks(ctx, tokens, title, body, map[string]string{
-		"type":      "PACKAGE_ARRIVED",
-		"packageId": packageID.String(),
-	})
+	return s.sendChunks(ctx, tokens, title, body, data)
 }
 
 func (s *FirebaseService) sendChunks(ctx context.Context, tokens []string, title, body string, data map[string]string) error {
@@ -0,0 +1,51 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+type Task interface {
+	Exec(ctx context.Context) error
+}
+
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Task     Task
+}
+
+type Scheduler struct {
+	jobs []Job
+}
+
+func NewScheduler(jobs ...Job) *Scheduler {
+	return &Scheduler{
+		jobs: jobs,
+	}
+}
+
+// Start runs every job once and then on each tick of its interval until ctx is cancelled.
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, job := range s.jobs {
+		go s.run(ctx, job)
+	}
+}
+
+func (s *Scheduler) run(ctx context.Context, job Job) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := job.Task.Exec(ctx); err != nil {
+			slog.Error("Scheduled job failed", "job", job.Name, "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
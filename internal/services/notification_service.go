@@ -10,5 +10,5 @@ type NotificationService interface {
 	SendToUser(ctx context.Context, userID uuid.UUID, title, body string) error
 	SendToCondoAdmins(ctx context.Context, condoID uuid.UUID, title, body string) error
 	SendToCondoResidents(ctx context.Context, condoID uuid.UUID, title, body string) error
-	SendToApartmentResidents(ctx context.Context, apartmentID, packageID uuid.UUID, title, body string) error
+	SendToApartmentResidents(ctx context.Context, apartmentID uuid.UUID, title, body string, data map[string]string) error
 }
@@ -0,0 +1,23 @@
+package services
+
+import (
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+)
+
+const (
+	VisitorRequestCreated = "visitor_request.created"
+	VisitorRequestUpdated = "visitor_request.updated"
+)
+
+type VisitorEvent struct {
+	Type    string
+	Request pgstore.VisitorRequest
+}
+
+// VisitorEventBroker fans out walk-in visitor changes to the front desk screens of a condominium.
+type VisitorEventBroker interface {
+	Publish(event VisitorEvent)
+	// Subscribe returns a channel with the events of condoID and a function that must be called to stop listening.
+	Subscribe(condoID uuid.UUID) (<-chan VisitorEvent, func())
+}
@@ -7,30 +7,354 @@ package pgstore
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
+const getAccessLogById = `-- name: GetAccessLogById :one
+SELECT
+  id, invite_id, condominium_id, entered_at, authorized_by, apartment_id, exited_at, exit_registered_by, visitor_request_id, vehicle_id, plate, offline_entry_id, synced_at
+FROM access_logs
+WHERE id = $1
+`
+
+func (q *Queries) GetAccessLogById(ctx context.Context, id uuid.UUID) (AccessLog, error) {
+	row := q.db.QueryRow(ctx, getAccessLogById, id)
+	var i AccessLog
+	err := row.Scan(
+		&i.ID,
+		&i.InviteID,
+		&i.CondominiumID,
+		&i.EnteredAt,
+		&i.AuthorizedBy,
+		&i.ApartmentID,
+		&i.ExitedAt,
+		&i.ExitRegisteredBy,
+		&i.VisitorRequestID,
+		&i.VehicleID,
+		&i.Plate,
+		&i.OfflineEntryID,
+		&i.SyncedAt,
+	)
+	return i, err
+}
+
+const listAccessLogs = `-- name: ListAccessLogs :many
+SELECT
+  l.id, l.invite_id, l.condominium_id, l.entered_at, l.authorized_by, l.apartment_id, l.exited_at, l.exit_registered_by, l.visitor_request_id, l.vehicle_id, l.plate, l.offline_entry_id, l.synced_at,
+  COALESCE(i.guest_name, v.visitor_name, ve.model)::text AS guest_name,
+  (CASE WHEN l.invite_id IS NOT NULL THEN i.guest_type WHEN l.vehicle_id IS NOT NULL THEN 'resident' ELSE 'walk_in' END)::text AS guest_type,
+  a.block,
+  a.number AS apartment_number
+FROM access_logs l
+LEFT JOIN invites i ON i.id = l.invite_id
+LEFT JOIN visitor_requests v ON v.id = l.visitor_request_id
+LEFT JOIN vehicles ve ON ve.id = l.vehicle_id
+JOIN apartments a ON a.id = l.apartment_id
+WHERE l.condominium_id = $1
+  AND ($4::uuid IS NULL OR l.apartment_id = $4)
+  AND ($5::uuid IS NULL OR l.invite_id = $5)
+  AND ($6::text IS NULL OR (CASE WHEN l.invite_id IS NOT NULL THEN i.guest_type WHEN l.vehicle_id IS NOT NULL THEN 'resident' ELSE 'walk_in' END) = $6)
+  AND ($7::timestamptz IS NULL OR l.entered_at >= $7)
+  AND ($8::timestamptz IS NULL OR l.entered_at < $8)
+ORDER BY l.entered_at DESC
+LIMIT $2 OFFSET $3
+`
+
+type ListAccessLogsParams struct {
+	CondominiumID uuid.UUID  `json:"condominium_id"`
+	Limit         int32      `json:"limit"`
+	Offset        int32      `json:"offset"`
+	ApartmentID   *uuid.UUID `json:"apartment_id"`
+	InviteID      *uuid.UUID `json:"invite_id"`
+	GuestType     *string    `json:"guest_type"`
+	From          *time.Time `json:"from"`
+	To            *time.Time `json:"to"`
+}
+
+type ListAccessLogsRow struct {
+	ID               uuid.UUID  `json:"id"`
+	InviteID         *uuid.UUID `json:"invite_id"`
+	CondominiumID    uuid.UUID  `json:"condominium_id"`
+	EnteredAt        time.Time  `json:"entered_at"`
+	AuthorizedBy     *uuid.UUID `json:"authorized_by"`
+	ApartmentID      uuid.UUID  `json:"apartment_id"`
+	ExitedAt         *time.Time `json:"exited_at"`
+	ExitRegisteredBy *uuid.UUID `json:"exit_registered_by"`
+	VisitorRequestID *uuid.UUID `json:"visitor_request_id"`
+	VehicleID        *uuid.UUID `json:"vehicle_id"`
+	Plate            *string    `json:"plate"`
+	OfflineEntryID   *uuid.UUID `json:"offline_entry_id"`
+	SyncedAt         *time.Time `json:"synced_at"`
+	GuestName        string     `json:"guest_name"`
+	GuestType        string     `json:"guest_type"`
+	Block            *string    `json:"block"`
+	ApartmentNumber  string     `json:"apartment_number"`
+}
+
+func (q *Queries) ListAccessLogs(ctx context.Context, arg ListAccessLogsParams) ([]ListAccessLogsRow, error) {
+	rows, err := q.db.Query(ctx, listAccessLogs,
+		arg.CondominiumID,
+		arg.Limit,
+		arg.Offset,
+		arg.ApartmentID,
+		arg.InviteID,
+		arg.GuestType,
+		arg.From,
+		arg.To,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAccessLogsRow
+	for rows.Next() {
+		var i ListAccessLogsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.InviteID,
+			&i.CondominiumID,
+			&i.EnteredAt,
+			&i.AuthorizedBy,
+			&i.ApartmentID,
+			&i.ExitedAt,
+			&i.ExitRegisteredBy,
+			&i.VisitorRequestID,
+			&i.VehicleID,
+			&i.Plate,
+			&i.OfflineEntryID,
+			&i.SyncedAt,
+			&i.GuestName,
+			&i.GuestType,
+			&i.Block,
+			&i.ApartmentNumber,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPeopleInside = `-- name: ListPeopleInside :many
+SELECT
+  l.id, l.invite_id, l.condominium_id, l.entered_at, l.authorized_by, l.apartment_id, l.exited_at, l.exit_registered_by, l.visitor_request_id, l.vehicle_id, l.plate, l.offline_entry_id, l.synced_at,
+  COALESCE(i.guest_name, v.visitor_name, ve.model)::text AS guest_name,
+  (CASE WHEN l.invite_id IS NOT NULL THEN i.guest_type WHEN l.vehicle_id IS NOT NULL THEN 'resident' ELSE 'walk_in' END)::text AS guest_type,
+  a.block,
+  a.number AS apartment_number
+FROM access_logs l
+LEFT JOIN invites i ON i.id = l.invite_id
+LEFT JOIN visitor_requests v ON v.id = l.visitor_request_id
+LEFT JOIN vehicles ve ON ve.id = l.vehicle_id
+JOIN apartments a ON a.id = l.apartment_id
+WHERE l.condominium_id = $1
+  AND l.exited_at IS NULL
+  AND l.vehicle_id IS NULL
+  AND l.entered_at >= $2
+ORDER BY a.block, a.number, l.entered_at
+`
+
+type ListPeopleInsideParams struct {
+	CondominiumID uuid.UUID `json:"condominium_id"`
+	EnteredAt     time.Time `json:"entered_at"`
+}
+
+type ListPeopleInsideRow struct {
+	ID               uuid.UUID  `json:"id"`
+	InviteID         *uuid.UUID `json:"invite_id"`
+	CondominiumID    uuid.UUID  `json:"condominium_id"`
+	EnteredAt        time.Time  `json:"entered_at"`
+	AuthorizedBy     *uuid.UUID `json:"authorized_by"`
+	ApartmentID      uuid.UUID  `json:"apartment_id"`
+	ExitedAt         *time.Time `json:"exited_at"`
+	ExitRegisteredBy *uuid.UUID `json:"exit_registered_by"`
+	VisitorRequestID *uuid.UUID `json:"visitor_request_id"`
+	VehicleID        *uuid.UUID `json:"vehicle_id"`
+	Plate            *string    `json:"plate"`
+	OfflineEntryID   *uuid.UUID `json:"offline_entry_id"`
+	SyncedAt         *time.Time `json:"synced_at"`
+	GuestName        string     `json:"guest_name"`
+	GuestType        string     `json:"guest_type"`
+	Block            *string    `json:"block"`
+	ApartmentNumber  string     `json:"apartment_number"`
+}
+
+func (q *Queries) ListPeopleInside(ctx context.Context, arg ListPeopleInsideParams) ([]ListPeopleInsideRow, error) {
+	rows, err := q.db.Query(ctx, listPeopleInside, arg.CondominiumID, arg.EnteredAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPeopleInsideRow
+	for rows.Next() {
+		var i ListPeopleInsideRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.InviteID,
+			&i.CondominiumID,
+			&i.EnteredAt,
+			&i.AuthorizedBy,
+			&i.ApartmentID,
+			&i.ExitedAt,
+			&i.ExitRegisteredBy,
+			&i.VisitorRequestID,
+			&i.VehicleID,
+			&i.Plate,
+			&i.OfflineEntryID,
+			&i.SyncedAt,
+			&i.GuestName,
+			&i.GuestType,
+			&i.Block,
+			&i.ApartmentNumber,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const logAccessEntry = `-- name: LogAccessEntry :one
 INSERT INTO access_logs (
   invite_id,
   condominium_id,
-  authorized_by
+  authorized_by,
+  apartment_id,
+  visitor_request_id,
+  vehicle_id,
+  plate
 ) VALUES (
   $1,
   $2,
-  $3
-) RETURNING id, invite_id, condominium_id, entered_at, authorized_by
+  $3,
+  $4,
+  $5,
+  $6,
+  $7
+) RETURNING id, invite_id, condominium_id, entered_at, authorized_by, apartment_id, exited_at, exit_registered_by, visitor_request_id, vehicle_id, plate, offline_entry_id, synced_at
 `
 
 type LogAccessEntryParams struct {
-	InviteID      uuid.UUID  `json:"invite_id"`
-	CondominiumID uuid.UUID  `json:"condominium_id"`
-	AuthorizedBy  *uuid.UUID `json:"authorized_by"`
+	InviteID         *uuid.UUID `json:"invite_id"`
+	CondominiumID    uuid.UUID  `json:"condominium_id"`
+	AuthorizedBy     *uuid.UUID `json:"authorized_by"`
+	ApartmentID      uuid.UUID  `json:"apartment_id"`
+	VisitorRequestID *uuid.UUID `json:"visitor_request_id"`
+	VehicleID        *uuid.UUID `json:"vehicle_id"`
+	Plate            *string    `json:"plate"`
 }
 
 func (q *Queries) LogAccessEntry(ctx context.Context, arg LogAccessEntryParams) (AccessLog, error) {
-	row := q.db.QueryRow(ctx, logAccessEntry, arg.InviteID, arg.CondominiumID, arg.AuthorizedBy)
+	row := q.db.QueryRow(ctx, logAccessEntry,
+		arg.InviteID,
+		arg.CondominiumID,
+		arg.AuthorizedBy,
+		arg.ApartmentID,
+		arg.VisitorRequestID,
+		arg.VehicleID,
+		arg.Plate,
+	)
+	var i AccessLog
+	err := row.Scan(
+		&i.ID,
+		&i.InviteID,
+		&i.CondominiumID,
+		&i.EnteredAt,
+		&i.AuthorizedBy,
+		&i.ApartmentID,
+		&i.ExitedAt,
+		&i.ExitRegisteredBy,
+		&i.VisitorRequestID,
+		&i.VehicleID,
+		&i.Plate,
+		&i.OfflineEntryID,
+		&i.SyncedAt,
+	)
+	return i, err
+}
+
+const logOfflineAccessEntry = `-- name: LogOfflineAccessEntry :one
+INSERT INTO access_logs (
+  offline_entry_id,
+  invite_id,
+  condominium_id,
+  authorized_by,
+  apartment_id,
+  entered_at,
+  synced_at
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  NOW()
+)
+ON CONFLICT (offline_entry_id) DO NOTHING
+RETURNING id, invite_id, condominium_id, entered_at, authorized_by, apartment_id, exited_at, exit_registered_by, visitor_request_id, vehicle_id, plate, offline_entry_id, synced_at
+`
+
+type LogOfflineAccessEntryParams struct {
+	OfflineEntryID *uuid.UUID `json:"offline_entry_id"`
+	InviteID       *uuid.UUID `json:"invite_id"`
+	CondominiumID  uuid.UUID  `json:"condominium_id"`
+	AuthorizedBy   *uuid.UUID `json:"authorized_by"`
+	ApartmentID    uuid.UUID  `json:"apartment_id"`
+	EnteredAt      time.Time  `json:"entered_at"`
+}
+
+func (q *Queries) LogOfflineAccessEntry(ctx context.Context, arg LogOfflineAccessEntryParams) (AccessLog, error) {
+	row := q.db.QueryRow(ctx, logOfflineAccessEntry,
+		arg.OfflineEntryID,
+		arg.InviteID,
+		arg.CondominiumID,
+		arg.AuthorizedBy,
+		arg.ApartmentID,
+		arg.EnteredAt,
+	)
+	var i AccessLog
+	err := row.Scan(
+		&i.ID,
+		&i.InviteID,
+		&i.CondominiumID,
+		&i.EnteredAt,
+		&i.AuthorizedBy,
+		&i.ApartmentID,
+		&i.ExitedAt,
+		&i.ExitRegisteredBy,
+		&i.VisitorRequestID,
+		&i.VehicleID,
+		&i.Plate,
+		&i.OfflineEntryID,
+		&i.SyncedAt,
+	)
+	return i, err
+}
+
+const registerAccessExit = `-- name: RegisterAccessExit :one
+UPDATE access_logs
+SET exited_at = NOW(),
+    exit_registered_by = $2
+WHERE id = $1 AND exited_at IS NULL
+RETURNING id, invite_id, condominium_id, entered_at, authorized_by, apartment_id, exited_at, exit_registered_by, visitor_request_id, vehicle_id, plate, offline_entry_id, synced_at
+`
+
+type RegisterAccessExitParams struct {
+	ID               uuid.UUID  `json:"id"`
+	ExitRegisteredBy *uuid.UUID `json:"exit_registered_by"`
+}
+
+func (q *Queries) RegisterAccessExit(ctx context.Context, arg RegisterAccessExitParams) (AccessLog, error) {
+	row := q.db.QueryRow(ctx, registerAccessExit, arg.ID, arg.ExitRegisteredBy)
 	var i AccessLog
 	err := row.Scan(
 		&i.ID,
@@ -38,6 +362,14 @@ func (q *Queries) LogAccessEntry(ctx context.Context, arg LogAccessEntryParams)
 		&i.CondominiumID,
 		&i.EnteredAt,
 		&i.AuthorizedBy,
+		&i.ApartmentID,
+		&i.ExitedAt,
+		&i.ExitRegisteredBy,
+		&i.VisitorRequestID,
+		&i.VehicleID,
+		&i.Plate,
+		&i.OfflineEntryID,
+		&i.SyncedAt,
 	)
 	return i, err
 }
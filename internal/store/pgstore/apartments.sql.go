@@ -15,11 +15,13 @@ const createApartment = `-- name: CreateApartment :one
 INSERT INTO apartments (
   condominium_id,
   block,
-  number
+  number,
+  voting_weight
 ) VALUES (
   $1,
   $2,
-  $3
+  $3,
+  COALESCE($4::float8, 1)
 ) RETURNING id
 `
 
@@ -27,10 +29,16 @@ type CreateApartmentParams struct {
 	CondominiumID uuid.UUID `json:"condominium_id"`
 	Block         *string   `json:"block"`
 	Number        string    `json:"number"`
+	VotingWeight  *float64  `json:"voting_weight"`
 }
 
 func (q *Queries) CreateApartment(ctx context.Context, arg CreateApartmentParams) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, createApartment, arg.CondominiumID, arg.Block, arg.Number)
+	row := q.db.QueryRow(ctx, createApartment,
+		arg.CondominiumID,
+		arg.Block,
+		arg.Number,
+		arg.VotingWeight,
+	)
 	var id uuid.UUID
 	err := row.Scan(&id)
 	return id, err
@@ -38,7 +46,7 @@ func (q *Queries) CreateApartment(ctx context.Context, arg CreateApartmentParams
 
 const getApartmentById = `-- name: GetApartmentById :one
 SELECT
-  id, condominium_id, block, number, created_at, updated_at
+  id, condominium_id, block, number, created_at, updated_at, voting_weight
 FROM apartments
 WHERE id = $1
 `
@@ -53,6 +61,7 @@ func (q *Queries) GetApartmentById(ctx context.Context, id uuid.UUID) (Apartment
 		&i.Number,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.VotingWeight,
 	)
 	return i, err
 }
@@ -12,6 +12,20 @@ import (
 	"github.com/google/uuid"
 )
 
+const cancelOpenBills = `-- name: CancelOpenBills :exec
+UPDATE bills
+SET
+  status = 'cancelled',
+  updated_at = NOW()
+WHERE id = ANY($1::uuid[])
+  AND status IN ('pending', 'overdue')
+`
+
+func (q *Queries) CancelOpenBills(ctx context.Context, ids []uuid.UUID) error {
+	_, err := q.db.Exec(ctx, cancelOpenBills, ids)
+	return err
+}
+
 const createBill = `-- name: CreateBill :one
 INSERT INTO bills (
   condominium_id,
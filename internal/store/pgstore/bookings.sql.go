@@ -12,17 +12,85 @@ import (
 	"github.com/google/uuid"
 )
 
+const cancelBooking = `-- name: CancelBooking :one
+UPDATE bookings
+SET
+  status = 'cancelled',
+  cancelled_at = NOW(),
+  cancelled_by = $2,
+  late_cancellation = $3,
+  cancellation_fine_bill_id = $4,
+  updated_at = NOW()
+WHERE id = $1
+  AND status IN ('pending', 'confirmed')
+RETURNING id, condominium_id, apartment_id, user_id, common_area_id, status, starts_at, ends_at, created_at, updated_at, deleted_at, cancelled_at, cancelled_by, late_cancellation, cancellation_fine_bill_id, fee_bill_id, deposit_bill_id, deposit_released_at, deposit_released_by, deposit_retained_cents, deposit_retention_reason, headcount, exclusive, escalated_at
+`
+
+type CancelBookingParams struct {
+	ID                     uuid.UUID  `json:"id"`
+	CancelledBy            *uuid.UUID `json:"cancelled_by"`
+	LateCancellation       bool       `json:"late_cancellation"`
+	CancellationFineBillID *uuid.UUID `json:"cancellation_fine_bill_id"`
+}
+
+func (q *Queries) CancelBooking(ctx context.Context, arg CancelBookingParams) (Booking, error) {
+	row := q.db.QueryRow(ctx, cancelBooking,
+		arg.ID,
+		arg.CancelledBy,
+		arg.LateCancellation,
+		arg.CancellationFineBillID,
+	)
+	var i Booking
+	err := row.Scan(
+		&i.ID,
+		&i.CondominiumID,
+		&i.ApartmentID,
+		&i.UserID,
+		&i.CommonAreaID,
+		&i.Status,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.CancelledAt,
+		&i.CancelledBy,
+		&i.LateCancellation,
+		&i.CancellationFineBillID,
+		&i.FeeBillID,
+		&i.DepositBillID,
+		&i.DepositReleasedAt,
+		&i.DepositReleasedBy,
+		&i.DepositRetainedCents,
+		&i.DepositRetentionReason,
+		&i.Headcount,
+		&i.Exclusive,
+		&i.EscalatedAt,
+	)
+	return i, err
+}
+
 const checkBookingConflict = `-- name: CheckBookingConflict :one
-SELECT EXISTS (
-  SELECT 1
-  FROM bookings
-  WHERE common_area_id = $1
-  AND deleted_at IS NULL
-  AND status IN ('confirmed', 'pending')
-  AND (
-    (starts_at < $2 AND ends_at > $3)
+SELECT (
+  EXISTS (
+    SELECT 1
+    FROM bookings
+    WHERE common_area_id = $1
+    AND deleted_at IS NULL
+    AND status IN ('confirmed', 'pending')
+    AND (
+      (starts_at < $2 AND ends_at > $3)
+    )
+  ) OR EXISTS (
+    SELECT 1
+    FROM booking_waitlist_entries
+    WHERE common_area_id = $1
+    AND status = 'offered'
+    AND offer_expires_at > NOW()
+    AND starts_at < $2
+    AND ends_at > $3
   )
-)
+)::bool AS has_conflict
 `
 
 type CheckBookingConflictParams struct {
@@ -33,9 +101,40 @@ type CheckBookingConflictParams struct {
 
 func (q *Queries) CheckBookingConflict(ctx context.Context, arg CheckBookingConflictParams) (bool, error) {
 	row := q.db.QueryRow(ctx, checkBookingConflict, arg.CommonAreaID, arg.EndsAt, arg.StartsAt)
-	var exists bool
-	err := row.Scan(&exists)
-	return exists, err
+	var has_conflict bool
+	err := row.Scan(&has_conflict)
+	return has_conflict, err
+}
+
+const countApartmentBookingsInRange = `-- name: CountApartmentBookingsInRange :one
+SELECT
+  COUNT(*)::int AS bookings_count
+FROM bookings
+WHERE apartment_id = $1
+  AND common_area_id = $2
+  AND deleted_at IS NULL
+  AND status IN ('confirmed', 'pending')
+  AND starts_at >= $3
+  AND starts_at < $4
+`
+
+type CountApartmentBookingsInRangeParams struct {
+	ApartmentID  uuid.UUID `json:"apartment_id"`
+	CommonAreaID uuid.UUID `json:"common_area_id"`
+	FromDate     time.Time `json:"from_date"`
+	ToDate       time.Time `json:"to_date"`
+}
+
+func (q *Queries) CountApartmentBookingsInRange(ctx context.Context, arg CountApartmentBookingsInRangeParams) (int32, error) {
+	row := q.db.QueryRow(ctx, countApartmentBookingsInRange,
+		arg.ApartmentID,
+		arg.CommonAreaID,
+		arg.FromDate,
+		arg.ToDate,
+	)
+	var bookings_count int32
+	err := row.Scan(&bookings_count)
+	return bookings_count, err
 }
 
 const createBooking = `-- name: CreateBooking :one
@@ -46,7 +145,9 @@ INSERT INTO bookings (
   common_area_id,
   status,
   starts_at,
-  ends_at
+  ends_at,
+  headcount,
+  exclusive
 ) VALUES (
   $1,
   $2,
@@ -54,8 +155,10 @@ INSERT INTO bookings (
   $4,
   $5,
   $6,
-  $7
-) RETURNING id, condominium_id, apartment_id, user_id, common_area_id, status, starts_at, ends_at, created_at, updated_at, deleted_at
+  $7,
+  $8,
+  $9
+) RETURNING id, condominium_id, apartment_id, user_id, common_area_id, status, starts_at, ends_at, created_at, updated_at, deleted_at, cancelled_at, cancelled_by, late_cancellation, cancellation_fine_bill_id, fee_bill_id, deposit_bill_id, deposit_released_at, deposit_released_by, deposit_retained_cents, deposit_retention_reason, headcount, exclusive, escalated_at
 `
 
 type CreateBookingParams struct {
@@ -66,6 +169,8 @@ type CreateBookingParams struct {
 	Status        string    `json:"status"`
 	StartsAt      time.Time `json:"starts_at"`
 	EndsAt        time.Time `json:"ends_at"`
+	Headcount     int32     `json:"headcount"`
+	Exclusive     bool      `json:"exclusive"`
 }
 
 func (q *Queries) CreateBooking(ctx context.Context, arg CreateBookingParams) (Booking, error) {
@@ -77,7 +182,92 @@ func (q *Queries) CreateBooking(ctx context.Context, arg CreateBookingParams) (B
 		arg.Status,
 		arg.StartsAt,
 		arg.EndsAt,
+		arg.Headcount,
+		arg.Exclusive,
+	)
+	var i Booking
+	err := row.Scan(
+		&i.ID,
+		&i.CondominiumID,
+		&i.ApartmentID,
+		&i.UserID,
+		&i.CommonAreaID,
+		&i.Status,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.CancelledAt,
+		&i.CancelledBy,
+		&i.LateCancellation,
+		&i.CancellationFineBillID,
+		&i.FeeBillID,
+		&i.DepositBillID,
+		&i.DepositReleasedAt,
+		&i.DepositReleasedBy,
+		&i.DepositRetainedCents,
+		&i.DepositRetentionReason,
+		&i.Headcount,
+		&i.Exclusive,
+		&i.EscalatedAt,
 	)
+	return i, err
+}
+
+const denyPendingBooking = `-- name: DenyPendingBooking :one
+UPDATE bookings
+SET
+  status = 'denied',
+  updated_at = NOW()
+WHERE id = $1
+  AND status = 'pending'
+RETURNING id, condominium_id, apartment_id, user_id, common_area_id, status, starts_at, ends_at, created_at, updated_at, deleted_at, cancelled_at, cancelled_by, late_cancellation, cancellation_fine_bill_id, fee_bill_id, deposit_bill_id, deposit_released_at, deposit_released_by, deposit_retained_cents, deposit_retention_reason, headcount, exclusive, escalated_at
+`
+
+func (q *Queries) DenyPendingBooking(ctx context.Context, id uuid.UUID) (Booking, error) {
+	row := q.db.QueryRow(ctx, denyPendingBooking, id)
+	var i Booking
+	err := row.Scan(
+		&i.ID,
+		&i.CondominiumID,
+		&i.ApartmentID,
+		&i.UserID,
+		&i.CommonAreaID,
+		&i.Status,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.CancelledAt,
+		&i.CancelledBy,
+		&i.LateCancellation,
+		&i.CancellationFineBillID,
+		&i.FeeBillID,
+		&i.DepositBillID,
+		&i.DepositReleasedAt,
+		&i.DepositReleasedBy,
+		&i.DepositRetainedCents,
+		&i.DepositRetentionReason,
+		&i.Headcount,
+		&i.Exclusive,
+		&i.EscalatedAt,
+	)
+	return i, err
+}
+
+const escalatePendingBooking = `-- name: EscalatePendingBooking :one
+UPDATE bookings
+SET escalated_at = NOW()
+WHERE id = $1
+  AND status = 'pending'
+  AND escalated_at IS NULL
+RETURNING id, condominium_id, apartment_id, user_id, common_area_id, status, starts_at, ends_at, created_at, updated_at, deleted_at, cancelled_at, cancelled_by, late_cancellation, cancellation_fine_bill_id, fee_bill_id, deposit_bill_id, deposit_released_at, deposit_released_by, deposit_retained_cents, deposit_retention_reason, headcount, exclusive, escalated_at
+`
+
+func (q *Queries) EscalatePendingBooking(ctx context.Context, id uuid.UUID) (Booking, error) {
+	row := q.db.QueryRow(ctx, escalatePendingBooking, id)
 	var i Booking
 	err := row.Scan(
 		&i.ID,
@@ -91,6 +281,19 @@ func (q *Queries) CreateBooking(ctx context.Context, arg CreateBookingParams) (B
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.CancelledAt,
+		&i.CancelledBy,
+		&i.LateCancellation,
+		&i.CancellationFineBillID,
+		&i.FeeBillID,
+		&i.DepositBillID,
+		&i.DepositReleasedAt,
+		&i.DepositReleasedBy,
+		&i.DepositRetainedCents,
+		&i.DepositRetentionReason,
+		&i.Headcount,
+		&i.Exclusive,
+		&i.EscalatedAt,
 	)
 	return i, err
 }
@@ -99,13 +302,26 @@ const getAreaAvailability = `-- name: GetAreaAvailability :many
 SELECT
   starts_at,
   ends_at,
-  status
+  status,
+  headcount
 FROM bookings
 WHERE common_area_id = $1
   AND status IN ('confirmed', 'pending')
   AND deleted_at IS NULL
-  AND starts_at >= $2
-  AND ends_at <= $3
+  AND ends_at > $2
+  AND starts_at < $3
+UNION ALL
+SELECT
+  starts_at,
+  ends_at,
+  'held' AS status,
+  headcount
+FROM booking_waitlist_entries
+WHERE common_area_id = $1
+  AND status = 'offered'
+  AND offer_expires_at > NOW()
+  AND ends_at > $2
+  AND starts_at < $3
 ORDER BY starts_at ASC
 `
 
@@ -116,9 +332,10 @@ type GetAreaAvailabilityParams struct {
 }
 
 type GetAreaAvailabilityRow struct {
-	StartsAt time.Time `json:"starts_at"`
-	EndsAt   time.Time `json:"ends_at"`
-	Status   string    `json:"status"`
+	StartsAt  time.Time `json:"starts_at"`
+	EndsAt    time.Time `json:"ends_at"`
+	Status    string    `json:"status"`
+	Headcount int32     `json:"headcount"`
 }
 
 func (q *Queries) GetAreaAvailability(ctx context.Context, arg GetAreaAvailabilityParams) ([]GetAreaAvailabilityRow, error) {
@@ -130,7 +347,12 @@ func (q *Queries) GetAreaAvailability(ctx context.Context, arg GetAreaAvailabili
 	var items []GetAreaAvailabilityRow
 	for rows.Next() {
 		var i GetAreaAvailabilityRow
-		if err := rows.Scan(&i.StartsAt, &i.EndsAt, &i.Status); err != nil {
+		if err := rows.Scan(
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.Status,
+			&i.Headcount,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -143,7 +365,7 @@ func (q *Queries) GetAreaAvailability(ctx context.Context, arg GetAreaAvailabili
 
 const getBookingById = `-- name: GetBookingById :one
 SELECT
-  b.id, b.condominium_id, b.apartment_id, b.user_id, b.common_area_id, b.status, b.starts_at, b.ends_at, b.created_at, b.updated_at, b.deleted_at,
+  b.id, b.condominium_id, b.apartment_id, b.user_id, b.common_area_id, b.status, b.starts_at, b.ends_at, b.created_at, b.updated_at, b.deleted_at, b.cancelled_at, b.cancelled_by, b.late_cancellation, b.cancellation_fine_bill_id, b.fee_bill_id, b.deposit_bill_id, b.deposit_released_at, b.deposit_released_by, b.deposit_retained_cents, b.deposit_retention_reason, b.headcount, b.exclusive, b.escalated_at,
   ca.name AS common_area_name
 FROM bookings b
 JOIN common_areas ca ON ca.id = b.common_area_id
@@ -151,18 +373,31 @@ WHERE b.id = $1
 `
 
 type GetBookingByIdRow struct {
-	ID             uuid.UUID  `json:"id"`
-	CondominiumID  uuid.UUID  `json:"condominium_id"`
-	ApartmentID    uuid.UUID  `json:"apartment_id"`
-	UserID         uuid.UUID  `json:"user_id"`
-	CommonAreaID   uuid.UUID  `json:"common_area_id"`
-	Status         string     `json:"status"`
-	StartsAt       time.Time  `json:"starts_at"`
-	EndsAt         time.Time  `json:"ends_at"`
-	CreatedAt      time.Time  `json:"created_at"`
-	UpdatedAt      *time.Time `json:"updated_at"`
-	DeletedAt      *time.Time `json:"deleted_at"`
-	CommonAreaName string     `json:"common_area_name"`
+	ID                     uuid.UUID  `json:"id"`
+	CondominiumID          uuid.UUID  `json:"condominium_id"`
+	ApartmentID            uuid.UUID  `json:"apartment_id"`
+	UserID                 uuid.UUID  `json:"user_id"`
+	CommonAreaID           uuid.UUID  `json:"common_area_id"`
+	Status                 string     `json:"status"`
+	StartsAt               time.Time  `json:"starts_at"`
+	EndsAt                 time.Time  `json:"ends_at"`
+	CreatedAt              time.Time  `json:"created_at"`
+	UpdatedAt              *time.Time `json:"updated_at"`
+	DeletedAt              *time.Time `json:"deleted_at"`
+	CancelledAt            *time.Time `json:"cancelled_at"`
+	CancelledBy            *uuid.UUID `json:"cancelled_by"`
+	LateCancellation       bool       `json:"late_cancellation"`
+	CancellationFineBillID *uuid.UUID `json:"cancellation_fine_bill_id"`
+	FeeBillID              *uuid.UUID `json:"fee_bill_id"`
+	DepositBillID          *uuid.UUID `json:"deposit_bill_id"`
+	DepositReleasedAt      *time.Time `json:"deposit_released_at"`
+	DepositReleasedBy      *uuid.UUID `json:"deposit_released_by"`
+	DepositRetainedCents   *int64     `json:"deposit_retained_cents"`
+	DepositRetentionReason *string    `json:"deposit_retention_reason"`
+	Headcount              int32      `json:"headcount"`
+	Exclusive              bool       `json:"exclusive"`
+	EscalatedAt            *time.Time `json:"escalated_at"`
+	CommonAreaName         string     `json:"common_area_name"`
 }
 
 func (q *Queries) GetBookingById(ctx context.Context, id uuid.UUID) (GetBookingByIdRow, error) {
@@ -180,14 +415,137 @@ func (q *Queries) GetBookingById(ctx context.Context, id uuid.UUID) (GetBookingB
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.CancelledAt,
+		&i.CancelledBy,
+		&i.LateCancellation,
+		&i.CancellationFineBillID,
+		&i.FeeBillID,
+		&i.DepositBillID,
+		&i.DepositReleasedAt,
+		&i.DepositReleasedBy,
+		&i.DepositRetainedCents,
+		&i.DepositRetentionReason,
+		&i.Headcount,
+		&i.Exclusive,
+		&i.EscalatedAt,
 		&i.CommonAreaName,
 	)
 	return i, err
 }
 
+const getPeakBookedHeadcount = `-- name: GetPeakBookedHeadcount :one
+WITH occupied AS (
+  SELECT starts_at, ends_at, headcount
+  FROM bookings
+  WHERE common_area_id = $1
+    AND deleted_at IS NULL
+    AND status IN ('confirmed', 'pending')
+  UNION ALL
+  SELECT starts_at, ends_at, headcount
+  FROM booking_waitlist_entries
+  WHERE common_area_id = $1
+    AND status = 'offered'
+    AND offer_expires_at > NOW()
+)
+SELECT
+  COALESCE(MAX(occupancy), 0)::int AS peak_headcount
+FROM (
+  SELECT
+    (
+      SELECT SUM(o.headcount)
+      FROM occupied o
+      WHERE o.starts_at <= p.at
+        AND o.ends_at > p.at
+    ) AS occupancy
+  FROM (
+    SELECT
+      GREATEST(starts_at, $2) AS at
+    FROM occupied
+    WHERE starts_at < $3
+      AND ends_at > $2
+  ) p
+) c
+`
+
+type GetPeakBookedHeadcountParams struct {
+	CommonAreaID uuid.UUID `json:"common_area_id"`
+	StartsAt     time.Time `json:"starts_at"`
+	EndsAt       time.Time `json:"ends_at"`
+}
+
+func (q *Queries) GetPeakBookedHeadcount(ctx context.Context, arg GetPeakBookedHeadcountParams) (int32, error) {
+	row := q.db.QueryRow(ctx, getPeakBookedHeadcount, arg.CommonAreaID, arg.StartsAt, arg.EndsAt)
+	var peak_headcount int32
+	err := row.Scan(&peak_headcount)
+	return peak_headcount, err
+}
+
+const listActiveAreaBookingsInRange = `-- name: ListActiveAreaBookingsInRange :many
+SELECT
+  id, condominium_id, apartment_id, user_id, common_area_id, status, starts_at, ends_at, created_at, updated_at, deleted_at, cancelled_at, cancelled_by, late_cancellation, cancellation_fine_bill_id, fee_bill_id, deposit_bill_id, deposit_released_at, deposit_released_by, deposit_retained_cents, deposit_retention_reason, headcount, exclusive, escalated_at
+FROM bookings
+WHERE common_area_id = $1
+  AND deleted_at IS NULL
+  AND status IN ('pending', 'confirmed')
+  AND ends_at > $2
+  AND ($3::timestamptz IS NULL OR starts_at < $3)
+ORDER BY starts_at ASC
+`
+
+type ListActiveAreaBookingsInRangeParams struct {
+	CommonAreaID uuid.UUID  `json:"common_area_id"`
+	StartsAt     time.Time  `json:"starts_at"`
+	EndsAt       *time.Time `json:"ends_at"`
+}
+
+func (q *Queries) ListActiveAreaBookingsInRange(ctx context.Context, arg ListActiveAreaBookingsInRangeParams) ([]Booking, error) {
+	rows, err := q.db.Query(ctx, listActiveAreaBookingsInRange, arg.CommonAreaID, arg.StartsAt, arg.EndsAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Booking
+	for rows.Next() {
+		var i Booking
+		if err := rows.Scan(
+			&i.ID,
+			&i.CondominiumID,
+			&i.ApartmentID,
+			&i.UserID,
+			&i.CommonAreaID,
+			&i.Status,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.CancelledAt,
+			&i.CancelledBy,
+			&i.LateCancellation,
+			&i.CancellationFineBillID,
+			&i.FeeBillID,
+			&i.DepositBillID,
+			&i.DepositReleasedAt,
+			&i.DepositReleasedBy,
+			&i.DepositRetainedCents,
+			&i.DepositRetentionReason,
+			&i.Headcount,
+			&i.Exclusive,
+			&i.EscalatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listBookings = `-- name: ListBookings :many
 SELECT
-  b.id, b.condominium_id, b.apartment_id, b.user_id, b.common_area_id, b.status, b.starts_at, b.ends_at, b.created_at, b.updated_at, b.deleted_at,
+  b.id, b.condominium_id, b.apartment_id, b.user_id, b.common_area_id, b.status, b.starts_at, b.ends_at, b.created_at, b.updated_at, b.deleted_at, b.cancelled_at, b.cancelled_by, b.late_cancellation, b.cancellation_fine_bill_id, b.fee_bill_id, b.deposit_bill_id, b.deposit_released_at, b.deposit_released_by, b.deposit_retained_cents, b.deposit_retention_reason, b.headcount, b.exclusive, b.escalated_at,
   ca.name as common_area_name,
   u.name as user_name,
   a.number as apartment_number,
@@ -213,21 +571,34 @@ type ListBookingsParams struct {
 }
 
 type ListBookingsRow struct {
-	ID              uuid.UUID  `json:"id"`
-	CondominiumID   uuid.UUID  `json:"condominium_id"`
-	ApartmentID     uuid.UUID  `json:"apartment_id"`
-	UserID          uuid.UUID  `json:"user_id"`
-	CommonAreaID    uuid.UUID  `json:"common_area_id"`
-	Status          string     `json:"status"`
-	StartsAt        time.Time  `json:"starts_at"`
-	EndsAt          time.Time  `json:"ends_at"`
-	CreatedAt       time.Time  `json:"created_at"`
-	UpdatedAt       *time.Time `json:"updated_at"`
-	DeletedAt       *time.Time `json:"deleted_at"`
-	CommonAreaName  string     `json:"common_area_name"`
-	UserName        string     `json:"user_name"`
-	ApartmentNumber string     `json:"apartment_number"`
-	ApartmentBlock  *string    `json:"apartment_block"`
+	ID                     uuid.UUID  `json:"id"`
+	CondominiumID          uuid.UUID  `json:"condominium_id"`
+	ApartmentID            uuid.UUID  `json:"apartment_id"`
+	UserID                 uuid.UUID  `json:"user_id"`
+	CommonAreaID           uuid.UUID  `json:"common_area_id"`
+	Status                 string     `json:"status"`
+	StartsAt               time.Time  `json:"starts_at"`
+	EndsAt                 time.Time  `json:"ends_at"`
+	CreatedAt              time.Time  `json:"created_at"`
+	UpdatedAt              *time.Time `json:"updated_at"`
+	DeletedAt              *time.Time `json:"deleted_at"`
+	CancelledAt            *time.Time `json:"cancelled_at"`
+	CancelledBy            *uuid.UUID `json:"cancelled_by"`
+	LateCancellation       bool       `json:"late_cancellation"`
+	CancellationFineBillID *uuid.UUID `json:"cancellation_fine_bill_id"`
+	FeeBillID              *uuid.UUID `json:"fee_bill_id"`
+	DepositBillID          *uuid.UUID `json:"deposit_bill_id"`
+	DepositReleasedAt      *time.Time `json:"deposit_released_at"`
+	DepositReleasedBy      *uuid.UUID `json:"deposit_released_by"`
+	DepositRetainedCents   *int64     `json:"deposit_retained_cents"`
+	DepositRetentionReason *string    `json:"deposit_retention_reason"`
+	Headcount              int32      `json:"headcount"`
+	Exclusive              bool       `json:"exclusive"`
+	EscalatedAt            *time.Time `json:"escalated_at"`
+	CommonAreaName         string     `json:"common_area_name"`
+	UserName               string     `json:"user_name"`
+	ApartmentNumber        string     `json:"apartment_number"`
+	ApartmentBlock         *string    `json:"apartment_block"`
 }
 
 func (q *Queries) ListBookings(ctx context.Context, arg ListBookingsParams) ([]ListBookingsRow, error) {
@@ -257,6 +628,19 @@ func (q *Queries) ListBookings(ctx context.Context, arg ListBookingsParams) ([]L
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.CancelledAt,
+			&i.CancelledBy,
+			&i.LateCancellation,
+			&i.CancellationFineBillID,
+			&i.FeeBillID,
+			&i.DepositBillID,
+			&i.DepositReleasedAt,
+			&i.DepositReleasedBy,
+			&i.DepositRetainedCents,
+			&i.DepositRetentionReason,
+			&i.Headcount,
+			&i.Exclusive,
+			&i.EscalatedAt,
 			&i.CommonAreaName,
 			&i.UserName,
 			&i.ApartmentNumber,
@@ -272,13 +656,176 @@ func (q *Queries) ListBookings(ctx context.Context, arg ListBookingsParams) ([]L
 	return items, nil
 }
 
+const listExpiredPendingBookings = `-- name: ListExpiredPendingBookings :many
+SELECT b.id, b.condominium_id, b.apartment_id, b.user_id, b.common_area_id, b.status, b.starts_at, b.ends_at, b.created_at, b.updated_at, b.deleted_at, b.cancelled_at, b.cancelled_by, b.late_cancellation, b.cancellation_fine_bill_id, b.fee_bill_id, b.deposit_bill_id, b.deposit_released_at, b.deposit_released_by, b.deposit_retained_cents, b.deposit_retention_reason, b.headcount, b.exclusive, b.escalated_at
+FROM bookings b
+JOIN common_areas ca ON ca.id = b.common_area_id
+WHERE b.status = 'pending'
+  AND b.deleted_at IS NULL
+  AND ca.pending_expiry_hours IS NOT NULL
+  AND b.starts_at <= NOW() + make_interval(hours => ca.pending_expiry_hours)
+  AND (ca.pending_expiry_action = 'deny' OR b.escalated_at IS NULL OR b.starts_at <= NOW())
+ORDER BY b.starts_at ASC
+`
+
+func (q *Queries) ListExpiredPendingBookings(ctx context.Context) ([]Booking, error) {
+	rows, err := q.db.Query(ctx, listExpiredPendingBookings)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Booking
+	for rows.Next() {
+		var i Booking
+		if err := rows.Scan(
+			&i.ID,
+			&i.CondominiumID,
+			&i.ApartmentID,
+			&i.UserID,
+			&i.CommonAreaID,
+			&i.Status,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.CancelledAt,
+			&i.CancelledBy,
+			&i.LateCancellation,
+			&i.CancellationFineBillID,
+			&i.FeeBillID,
+			&i.DepositBillID,
+			&i.DepositReleasedAt,
+			&i.DepositReleasedBy,
+			&i.DepositRetainedCents,
+			&i.DepositRetentionReason,
+			&i.Headcount,
+			&i.Exclusive,
+			&i.EscalatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const releaseBookingDeposit = `-- name: ReleaseBookingDeposit :one
+UPDATE bookings
+SET
+  deposit_released_at = NOW(),
+  deposit_released_by = $2,
+  deposit_retained_cents = $3,
+  deposit_retention_reason = $4,
+  updated_at = NOW()
+WHERE id = $1
+  AND deposit_released_at IS NULL
+RETURNING id, condominium_id, apartment_id, user_id, common_area_id, status, starts_at, ends_at, created_at, updated_at, deleted_at, cancelled_at, cancelled_by, late_cancellation, cancellation_fine_bill_id, fee_bill_id, deposit_bill_id, deposit_released_at, deposit_released_by, deposit_retained_cents, deposit_retention_reason, headcount, exclusive, escalated_at
+`
+
+type ReleaseBookingDepositParams struct {
+	ID                     uuid.UUID  `json:"id"`
+	DepositReleasedBy      *uuid.UUID `json:"deposit_released_by"`
+	DepositRetainedCents   *int64     `json:"deposit_retained_cents"`
+	DepositRetentionReason *string    `json:"deposit_retention_reason"`
+}
+
+func (q *Queries) ReleaseBookingDeposit(ctx context.Context, arg ReleaseBookingDepositParams) (Booking, error) {
+	row := q.db.QueryRow(ctx, releaseBookingDeposit,
+		arg.ID,
+		arg.DepositReleasedBy,
+		arg.DepositRetainedCents,
+		arg.DepositRetentionReason,
+	)
+	var i Booking
+	err := row.Scan(
+		&i.ID,
+		&i.CondominiumID,
+		&i.ApartmentID,
+		&i.UserID,
+		&i.CommonAreaID,
+		&i.Status,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.CancelledAt,
+		&i.CancelledBy,
+		&i.LateCancellation,
+		&i.CancellationFineBillID,
+		&i.FeeBillID,
+		&i.DepositBillID,
+		&i.DepositReleasedAt,
+		&i.DepositReleasedBy,
+		&i.DepositRetainedCents,
+		&i.DepositRetentionReason,
+		&i.Headcount,
+		&i.Exclusive,
+		&i.EscalatedAt,
+	)
+	return i, err
+}
+
+const setBookingBills = `-- name: SetBookingBills :one
+UPDATE bookings
+SET
+  fee_bill_id = $2,
+  deposit_bill_id = $3,
+  updated_at = NOW()
+WHERE id = $1
+RETURNING id, condominium_id, apartment_id, user_id, common_area_id, status, starts_at, ends_at, created_at, updated_at, deleted_at, cancelled_at, cancelled_by, late_cancellation, cancellation_fine_bill_id, fee_bill_id, deposit_bill_id, deposit_released_at, deposit_released_by, deposit_retained_cents, deposit_retention_reason, headcount, exclusive, escalated_at
+`
+
+type SetBookingBillsParams struct {
+	ID            uuid.UUID  `json:"id"`
+	FeeBillID     *uuid.UUID `json:"fee_bill_id"`
+	DepositBillID *uuid.UUID `json:"deposit_bill_id"`
+}
+
+func (q *Queries) SetBookingBills(ctx context.Context, arg SetBookingBillsParams) (Booking, error) {
+	row := q.db.QueryRow(ctx, setBookingBills, arg.ID, arg.FeeBillID, arg.DepositBillID)
+	var i Booking
+	err := row.Scan(
+		&i.ID,
+		&i.CondominiumID,
+		&i.ApartmentID,
+		&i.UserID,
+		&i.CommonAreaID,
+		&i.Status,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.CancelledAt,
+		&i.CancelledBy,
+		&i.LateCancellation,
+		&i.CancellationFineBillID,
+		&i.FeeBillID,
+		&i.DepositBillID,
+		&i.DepositReleasedAt,
+		&i.DepositReleasedBy,
+		&i.DepositRetainedCents,
+		&i.DepositRetentionReason,
+		&i.Headcount,
+		&i.Exclusive,
+		&i.EscalatedAt,
+	)
+	return i, err
+}
+
 const updateBookingStatus = `-- name: UpdateBookingStatus :one
 UPDATE bookings
 SET
   status = $1,
   updated_at = NOW()
 WHERE id = $2
-RETURNING id, condominium_id, apartment_id, user_id, common_area_id, status, starts_at, ends_at, created_at, updated_at, deleted_at
+  AND status = 'pending'
+RETURNING id, condominium_id, apartment_id, user_id, common_area_id, status, starts_at, ends_at, created_at, updated_at, deleted_at, cancelled_at, cancelled_by, late_cancellation, cancellation_fine_bill_id, fee_bill_id, deposit_bill_id, deposit_released_at, deposit_released_by, deposit_retained_cents, deposit_retention_reason, headcount, exclusive, escalated_at
 `
 
 type UpdateBookingStatusParams struct {
@@ -301,6 +848,19 @@ func (q *Queries) UpdateBookingStatus(ctx context.Context, arg UpdateBookingStat
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.CancelledAt,
+		&i.CancelledBy,
+		&i.LateCancellation,
+		&i.CancellationFineBillID,
+		&i.FeeBillID,
+		&i.DepositBillID,
+		&i.DepositReleasedAt,
+		&i.DepositReleasedBy,
+		&i.DepositRetainedCents,
+		&i.DepositRetentionReason,
+		&i.Headcount,
+		&i.Exclusive,
+		&i.EscalatedAt,
 	)
 	return i, err
 }
@@ -11,25 +11,99 @@ import (
 	"github.com/google/uuid"
 )
 
+const archiveCommonArea = `-- name: ArchiveCommonArea :one
+UPDATE common_areas
+SET archived_at = NOW()
+WHERE id = $1
+  AND archived_at IS NULL
+RETURNING id, condominium_id, name, capacity, requires_approval, min_duration_minutes, max_duration_minutes, max_advance_days, cooldown_minutes, max_bookings_per_month, cancellation_deadline_hours, late_cancellation_fee_cents, price_cents, pricing_unit, deposit_cents, allow_overlapping_bookings, archived_at, pending_expiry_hours, pending_expiry_action
+`
+
+func (q *Queries) ArchiveCommonArea(ctx context.Context, id uuid.UUID) (CommonArea, error) {
+	row := q.db.QueryRow(ctx, archiveCommonArea, id)
+	var i CommonArea
+	err := row.Scan(
+		&i.ID,
+		&i.CondominiumID,
+		&i.Name,
+		&i.Capacity,
+		&i.RequiresApproval,
+		&i.MinDurationMinutes,
+		&i.MaxDurationMinutes,
+		&i.MaxAdvanceDays,
+		&i.CooldownMinutes,
+		&i.MaxBookingsPerMonth,
+		&i.CancellationDeadlineHours,
+		&i.LateCancellationFeeCents,
+		&i.PriceCents,
+		&i.PricingUnit,
+		&i.DepositCents,
+		&i.AllowOverlappingBookings,
+		&i.ArchivedAt,
+		&i.PendingExpiryHours,
+		&i.PendingExpiryAction,
+	)
+	return i, err
+}
+
 const createCommonArea = `-- name: CreateCommonArea :one
 INSERT INTO common_areas (
   condominium_id,
   name,
   capacity,
-  requires_approval
+  requires_approval,
+  min_duration_minutes,
+  max_duration_minutes,
+  max_advance_days,
+  cooldown_minutes,
+  max_bookings_per_month,
+  cancellation_deadline_hours,
+  late_cancellation_fee_cents,
+  price_cents,
+  pricing_unit,
+  deposit_cents,
+  allow_overlapping_bookings,
+  pending_expiry_hours,
+  pending_expiry_action
 ) VALUES (
   $1,
   $2,
   $3,
-  $4
-) RETURNING id, condominium_id, name, capacity, requires_approval
+  $4,
+  $5,
+  $6,
+  $7,
+  $8,
+  $9,
+  $10,
+  $11,
+  $12,
+  $13,
+  $14,
+  $15,
+  $16,
+  $17
+) RETURNING id, condominium_id, name, capacity, requires_approval, min_duration_minutes, max_duration_minutes, max_advance_days, cooldown_minutes, max_bookings_per_month, cancellation_deadline_hours, late_cancellation_fee_cents, price_cents, pricing_unit, deposit_cents, allow_overlapping_bookings, archived_at, pending_expiry_hours, pending_expiry_action
 `
 
 type CreateCommonAreaParams struct {
-	CondominiumID    uuid.UUID `json:"condominium_id"`
-	Name             string    `json:"name"`
-	Capacity         *int32    `json:"capacity"`
-	RequiresApproval bool      `json:"requires_approval"`
+	CondominiumID             uuid.UUID `json:"condominium_id"`
+	Name                      string    `json:"name"`
+	Capacity                  *int32    `json:"capacity"`
+	RequiresApproval          bool      `json:"requires_approval"`
+	MinDurationMinutes        *int32    `json:"min_duration_minutes"`
+	MaxDurationMinutes        *int32    `json:"max_duration_minutes"`
+	MaxAdvanceDays            *int32    `json:"max_advance_days"`
+	CooldownMinutes           int32     `json:"cooldown_minutes"`
+	MaxBookingsPerMonth       *int32    `json:"max_bookings_per_month"`
+	CancellationDeadlineHours *int32    `json:"cancellation_deadline_hours"`
+	LateCancellationFeeCents  *int64    `json:"late_cancellation_fee_cents"`
+	PriceCents                *int64    `json:"price_cents"`
+	PricingUnit               string    `json:"pricing_unit"`
+	DepositCents              *int64    `json:"deposit_cents"`
+	AllowOverlappingBookings  bool      `json:"allow_overlapping_bookings"`
+	PendingExpiryHours        *int32    `json:"pending_expiry_hours"`
+	PendingExpiryAction       string    `json:"pending_expiry_action"`
 }
 
 func (q *Queries) CreateCommonArea(ctx context.Context, arg CreateCommonAreaParams) (CommonArea, error) {
@@ -38,7 +112,99 @@ func (q *Queries) CreateCommonArea(ctx context.Context, arg CreateCommonAreaPara
 		arg.Name,
 		arg.Capacity,
 		arg.RequiresApproval,
+		arg.MinDurationMinutes,
+		arg.MaxDurationMinutes,
+		arg.MaxAdvanceDays,
+		arg.CooldownMinutes,
+		arg.MaxBookingsPerMonth,
+		arg.CancellationDeadlineHours,
+		arg.LateCancellationFeeCents,
+		arg.PriceCents,
+		arg.PricingUnit,
+		arg.DepositCents,
+		arg.AllowOverlappingBookings,
+		arg.PendingExpiryHours,
+		arg.PendingExpiryAction,
+	)
+	var i CommonArea
+	err := row.Scan(
+		&i.ID,
+		&i.CondominiumID,
+		&i.Name,
+		&i.Capacity,
+		&i.RequiresApproval,
+		&i.MinDurationMinutes,
+		&i.MaxDurationMinutes,
+		&i.MaxAdvanceDays,
+		&i.CooldownMinutes,
+		&i.MaxBookingsPerMonth,
+		&i.CancellationDeadlineHours,
+		&i.LateCancellationFeeCents,
+		&i.PriceCents,
+		&i.PricingUnit,
+		&i.DepositCents,
+		&i.AllowOverlappingBookings,
+		&i.ArchivedAt,
+		&i.PendingExpiryHours,
+		&i.PendingExpiryAction,
+	)
+	return i, err
+}
+
+const deleteCommonAreaOpeningHours = `-- name: DeleteCommonAreaOpeningHours :exec
+DELETE FROM common_area_opening_hours
+WHERE common_area_id = $1
+`
+
+func (q *Queries) DeleteCommonAreaOpeningHours(ctx context.Context, commonAreaID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteCommonAreaOpeningHours, commonAreaID)
+	return err
+}
+
+const getCommonAreaById = `-- name: GetCommonAreaById :one
+SELECT
+  id, condominium_id, name, capacity, requires_approval, min_duration_minutes, max_duration_minutes, max_advance_days, cooldown_minutes, max_bookings_per_month, cancellation_deadline_hours, late_cancellation_fee_cents, price_cents, pricing_unit, deposit_cents, allow_overlapping_bookings, archived_at, pending_expiry_hours, pending_expiry_action
+FROM common_areas
+WHERE id = $1
+`
+
+func (q *Queries) GetCommonAreaById(ctx context.Context, id uuid.UUID) (CommonArea, error) {
+	row := q.db.QueryRow(ctx, getCommonAreaById, id)
+	var i CommonArea
+	err := row.Scan(
+		&i.ID,
+		&i.CondominiumID,
+		&i.Name,
+		&i.Capacity,
+		&i.RequiresApproval,
+		&i.MinDurationMinutes,
+		&i.MaxDurationMinutes,
+		&i.MaxAdvanceDays,
+		&i.CooldownMinutes,
+		&i.MaxBookingsPerMonth,
+		&i.CancellationDeadlineHours,
+		&i.LateCancellationFeeCents,
+		&i.PriceCents,
+		&i.PricingUnit,
+		&i.DepositCents,
+		&i.AllowOverlappingBookings,
+		&i.ArchivedAt,
+		&i.PendingExpiryHours,
+		&i.PendingExpiryAction,
 	)
+	return i, err
+}
+
+const getCommonAreaForShare = `-- name: GetCommonAreaForShare :one
+SELECT
+  id, condominium_id, name, capacity, requires_approval, min_duration_minutes, max_duration_minutes, max_advance_days, cooldown_minutes, max_bookings_per_month, cancellation_deadline_hours, late_cancellation_fee_cents, price_cents, pricing_unit, deposit_cents, allow_overlapping_bookings, archived_at, pending_expiry_hours, pending_expiry_action
+FROM common_areas
+WHERE id = $1
+FOR SHARE
+`
+
+func (q *Queries) GetCommonAreaForShare(ctx context.Context, id uuid.UUID) (CommonArea, error) {
+	row := q.db.QueryRow(ctx, getCommonAreaForShare, id)
 	var i CommonArea
 	err := row.Scan(
 		&i.ID,
@@ -46,13 +212,27 @@ func (q *Queries) CreateCommonArea(ctx context.Context, arg CreateCommonAreaPara
 		&i.Name,
 		&i.Capacity,
 		&i.RequiresApproval,
+		&i.MinDurationMinutes,
+		&i.MaxDurationMinutes,
+		&i.MaxAdvanceDays,
+		&i.CooldownMinutes,
+		&i.MaxBookingsPerMonth,
+		&i.CancellationDeadlineHours,
+		&i.LateCancellationFeeCents,
+		&i.PriceCents,
+		&i.PricingUnit,
+		&i.DepositCents,
+		&i.AllowOverlappingBookings,
+		&i.ArchivedAt,
+		&i.PendingExpiryHours,
+		&i.PendingExpiryAction,
 	)
 	return i, err
 }
 
 const getCommonAreaIdForUpdate = `-- name: GetCommonAreaIdForUpdate :one
 SELECT
-  id, condominium_id, name, capacity, requires_approval
+  id, condominium_id, name, capacity, requires_approval, min_duration_minutes, max_duration_minutes, max_advance_days, cooldown_minutes, max_bookings_per_month, cancellation_deadline_hours, late_cancellation_fee_cents, price_cents, pricing_unit, deposit_cents, allow_overlapping_bookings, archived_at, pending_expiry_hours, pending_expiry_action
 FROM common_areas
 WHERE id = $1
 FOR UPDATE
@@ -67,15 +247,94 @@ func (q *Queries) GetCommonAreaIdForUpdate(ctx context.Context, id uuid.UUID) (C
 		&i.Name,
 		&i.Capacity,
 		&i.RequiresApproval,
+		&i.MinDurationMinutes,
+		&i.MaxDurationMinutes,
+		&i.MaxAdvanceDays,
+		&i.CooldownMinutes,
+		&i.MaxBookingsPerMonth,
+		&i.CancellationDeadlineHours,
+		&i.LateCancellationFeeCents,
+		&i.PriceCents,
+		&i.PricingUnit,
+		&i.DepositCents,
+		&i.AllowOverlappingBookings,
+		&i.ArchivedAt,
+		&i.PendingExpiryHours,
+		&i.PendingExpiryAction,
 	)
 	return i, err
 }
 
+const insertCommonAreaOpeningHours = `-- name: InsertCommonAreaOpeningHours :exec
+INSERT INTO common_area_opening_hours (
+  common_area_id,
+  weekday,
+  opens_minute,
+  closes_minute
+)
+SELECT
+  $1,
+  unnest($2::smallint[]),
+  unnest($3::smallint[]),
+  unnest($4::smallint[])
+`
+
+type InsertCommonAreaOpeningHoursParams struct {
+	CommonAreaID  uuid.UUID `json:"common_area_id"`
+	Weekdays      []int16   `json:"weekdays"`
+	OpensMinutes  []int16   `json:"opens_minutes"`
+	ClosesMinutes []int16   `json:"closes_minutes"`
+}
+
+func (q *Queries) InsertCommonAreaOpeningHours(ctx context.Context, arg InsertCommonAreaOpeningHoursParams) error {
+	_, err := q.db.Exec(ctx, insertCommonAreaOpeningHours,
+		arg.CommonAreaID,
+		arg.Weekdays,
+		arg.OpensMinutes,
+		arg.ClosesMinutes,
+	)
+	return err
+}
+
+const listCommonAreaOpeningHours = `-- name: ListCommonAreaOpeningHours :many
+SELECT
+  common_area_id, weekday, opens_minute, closes_minute
+FROM common_area_opening_hours
+WHERE common_area_id = $1
+ORDER BY weekday
+`
+
+func (q *Queries) ListCommonAreaOpeningHours(ctx context.Context, commonAreaID uuid.UUID) ([]CommonAreaOpeningHour, error) {
+	rows, err := q.db.Query(ctx, listCommonAreaOpeningHours, commonAreaID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CommonAreaOpeningHour
+	for rows.Next() {
+		var i CommonAreaOpeningHour
+		if err := rows.Scan(
+			&i.CommonAreaID,
+			&i.Weekday,
+			&i.OpensMinute,
+			&i.ClosesMinute,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listCommonAreas = `-- name: ListCommonAreas :many
 SELECT
-  id, condominium_id, name, capacity, requires_approval
+  id, condominium_id, name, capacity, requires_approval, min_duration_minutes, max_duration_minutes, max_advance_days, cooldown_minutes, max_bookings_per_month, cancellation_deadline_hours, late_cancellation_fee_cents, price_cents, pricing_unit, deposit_cents, allow_overlapping_bookings, archived_at, pending_expiry_hours, pending_expiry_action
 FROM common_areas
 WHERE condominium_id = $1
+  AND archived_at IS NULL
 `
 
 func (q *Queries) ListCommonAreas(ctx context.Context, condominiumID uuid.UUID) ([]CommonArea, error) {
@@ -93,6 +352,55 @@ func (q *Queries) ListCommonAreas(ctx context.Context, condominiumID uuid.UUID)
 			&i.Name,
 			&i.Capacity,
 			&i.RequiresApproval,
+			&i.MinDurationMinutes,
+			&i.MaxDurationMinutes,
+			&i.MaxAdvanceDays,
+			&i.CooldownMinutes,
+			&i.MaxBookingsPerMonth,
+			&i.CancellationDeadlineHours,
+			&i.LateCancellationFeeCents,
+			&i.PriceCents,
+			&i.PricingUnit,
+			&i.DepositCents,
+			&i.AllowOverlappingBookings,
+			&i.ArchivedAt,
+			&i.PendingExpiryHours,
+			&i.PendingExpiryAction,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCondominiumOpeningHours = `-- name: ListCondominiumOpeningHours :many
+SELECT
+  h.common_area_id, h.weekday, h.opens_minute, h.closes_minute
+FROM common_area_opening_hours h
+JOIN common_areas ca ON ca.id = h.common_area_id
+WHERE ca.condominium_id = $1
+  AND ca.archived_at IS NULL
+ORDER BY h.common_area_id, h.weekday
+`
+
+func (q *Queries) ListCondominiumOpeningHours(ctx context.Context, condominiumID uuid.UUID) ([]CommonAreaOpeningHour, error) {
+	rows, err := q.db.Query(ctx, listCondominiumOpeningHours, condominiumID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CommonAreaOpeningHour
+	for rows.Next() {
+		var i CommonAreaOpeningHour
+		if err := rows.Scan(
+			&i.CommonAreaID,
+			&i.Weekday,
+			&i.OpensMinute,
+			&i.ClosesMinute,
 		); err != nil {
 			return nil, err
 		}
@@ -103,3 +411,129 @@ func (q *Queries) ListCommonAreas(ctx context.Context, condominiumID uuid.UUID)
 	}
 	return items, nil
 }
+
+const updateCommonArea = `-- name: UpdateCommonArea :one
+UPDATE common_areas
+SET name = $2,
+    capacity = $3,
+    requires_approval = $4
+WHERE id = $1
+RETURNING id, condominium_id, name, capacity, requires_approval, min_duration_minutes, max_duration_minutes, max_advance_days, cooldown_minutes, max_bookings_per_month, cancellation_deadline_hours, late_cancellation_fee_cents, price_cents, pricing_unit, deposit_cents, allow_overlapping_bookings, archived_at, pending_expiry_hours, pending_expiry_action
+`
+
+type UpdateCommonAreaParams struct {
+	ID               uuid.UUID `json:"id"`
+	Name             string    `json:"name"`
+	Capacity         *int32    `json:"capacity"`
+	RequiresApproval bool      `json:"requires_approval"`
+}
+
+func (q *Queries) UpdateCommonArea(ctx context.Context, arg UpdateCommonAreaParams) (CommonArea, error) {
+	row := q.db.QueryRow(ctx, updateCommonArea,
+		arg.ID,
+		arg.Name,
+		arg.Capacity,
+		arg.RequiresApproval,
+	)
+	var i CommonArea
+	err := row.Scan(
+		&i.ID,
+		&i.CondominiumID,
+		&i.Name,
+		&i.Capacity,
+		&i.RequiresApproval,
+		&i.MinDurationMinutes,
+		&i.MaxDurationMinutes,
+		&i.MaxAdvanceDays,
+		&i.CooldownMinutes,
+		&i.MaxBookingsPerMonth,
+		&i.CancellationDeadlineHours,
+		&i.LateCancellationFeeCents,
+		&i.PriceCents,
+		&i.PricingUnit,
+		&i.DepositCents,
+		&i.AllowOverlappingBookings,
+		&i.ArchivedAt,
+		&i.PendingExpiryHours,
+		&i.PendingExpiryAction,
+	)
+	return i, err
+}
+
+const updateCommonAreaRules = `-- name: UpdateCommonAreaRules :one
+UPDATE common_areas
+SET min_duration_minutes = $2,
+    max_duration_minutes = $3,
+    max_advance_days = $4,
+    cooldown_minutes = $5,
+    max_bookings_per_month = $6,
+    cancellation_deadline_hours = $7,
+    late_cancellation_fee_cents = $8,
+    price_cents = $9,
+    pricing_unit = $10,
+    deposit_cents = $11,
+    allow_overlapping_bookings = $12,
+    pending_expiry_hours = $13,
+    pending_expiry_action = $14
+WHERE id = $1
+RETURNING id, condominium_id, name, capacity, requires_approval, min_duration_minutes, max_duration_minutes, max_advance_days, cooldown_minutes, max_bookings_per_month, cancellation_deadline_hours, late_cancellation_fee_cents, price_cents, pricing_unit, deposit_cents, allow_overlapping_bookings, archived_at, pending_expiry_hours, pending_expiry_action
+`
+
+type UpdateCommonAreaRulesParams struct {
+	ID                        uuid.UUID `json:"id"`
+	MinDurationMinutes        *int32    `json:"min_duration_minutes"`
+	MaxDurationMinutes        *int32    `json:"max_duration_minutes"`
+	MaxAdvanceDays            *int32    `json:"max_advance_days"`
+	CooldownMinutes           int32     `json:"cooldown_minutes"`
+	MaxBookingsPerMonth       *int32    `json:"max_bookings_per_month"`
+	CancellationDeadlineHours *int32    `json:"cancellation_deadline_hours"`
+	LateCancellationFeeCents  *int64    `json:"late_cancellation_fee_cents"`
+	PriceCents                *int64    `json:"price_cents"`
+	PricingUnit               string    `json:"pricing_unit"`
+	DepositCents              *int64    `json:"deposit_cents"`
+	AllowOverlappingBookings  bool      `json:"allow_overlapping_bookings"`
+	PendingExpiryHours        *int32    `json:"pending_expiry_hours"`
+	PendingExpiryAction       string    `json:"pending_expiry_action"`
+}
+
+func (q *Queries) UpdateCommonAreaRules(ctx context.Context, arg UpdateCommonAreaRulesParams) (CommonArea, error) {
+	row := q.db.QueryRow(ctx, updateCommonAreaRules,
+		arg.ID,
+		arg.MinDurationMinutes,
+		arg.MaxDurationMinutes,
+		arg.MaxAdvanceDays,
+		arg.CooldownMinutes,
+		arg.MaxBookingsPerMonth,
+		arg.CancellationDeadlineHours,
+		arg.LateCancellationFeeCents,
+		arg.PriceCents,
+		arg.PricingUnit,
+		arg.DepositCents,
+		arg.AllowOverlappingBookings,
+		arg.PendingExpiryHours,
+		arg.PendingExpiryAction,
+	)
+	var i CommonArea
+	err := row.Scan(
+		&i.ID,
+		&i.CondominiumID,
+		&i.Name,
+		&i.Capacity,
+		&i.RequiresApproval,
+		&i.MinDurationMinutes,
+		&i.MaxDurationMinutes,
+		&i.MaxAdvanceDays,
+		&i.CooldownMinutes,
+		&i.MaxBookingsPerMonth,
+		&i.CancellationDeadlineHours,
+		&i.LateCancellationFeeCents,
+		&i.PriceCents,
+		&i.PricingUnit,
+		&i.DepositCents,
+		&i.AllowOverlappingBookings,
+		&i.ArchivedAt,
+		&i.PendingExpiryHours,
+		&i.PendingExpiryAction,
+	)
+	return i, err
+}
@@ -16,13 +16,15 @@ INSERT INTO condominiums (
   name,
   cnpj,
   address,
-  plan_type
+  plan_type,
+  timezone
 )
 VALUES (
   $1,
   $2,
   $3,
-  $4
+  $4,
+  $5
 ) RETURNING id
 `
 
@@ -31,6 +33,7 @@ type CreateCondominiumParams struct {
 	Cnpj     string `json:"cnpj"`
 	Address  string `json:"address"`
 	PlanType string `json:"plan_type"`
+	Timezone string `json:"timezone"`
 }
 
 func (q *Queries) CreateCondominium(ctx context.Context, arg CreateCondominiumParams) (uuid.UUID, error) {
@@ -39,6 +42,7 @@ func (q *Queries) CreateCondominium(ctx context.Context, arg CreateCondominiumPa
 		arg.Cnpj,
 		arg.Address,
 		arg.PlanType,
+		arg.Timezone,
 	)
 	var id uuid.UUID
 	err := row.Scan(&id)
@@ -47,7 +51,7 @@ func (q *Queries) CreateCondominium(ctx context.Context, arg CreateCondominiumPa
 
 const getCondominiumByAddress = `-- name: GetCondominiumByAddress :one
 SELECT
-  id, name, cnpj, address, plan_type, created_at, updated_at
+  id, name, cnpj, address, plan_type, created_at, updated_at, timezone
 FROM condominiums
 WHERE address = $1
 `
@@ -63,13 +67,14 @@ func (q *Queries) GetCondominiumByAddress(ctx context.Context, address string) (
 		&i.PlanType,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Timezone,
 	)
 	return i, err
 }
 
 const getCondominiumById = `-- name: GetCondominiumById :one
 SELECT
-id, name, cnpj, address, plan_type, created_at, updated_at
+id, name, cnpj, address, plan_type, created_at, updated_at, timezone
 FROM condominiums
 WHERE id = $1
 `
@@ -85,6 +90,7 @@ func (q *Queries) GetCondominiumById(ctx context.Context, id uuid.UUID) (Condomi
 		&i.PlanType,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Timezone,
 	)
 	return i, err
 }
@@ -12,6 +12,19 @@ import (
 	"github.com/google/uuid"
 )
 
+const countInviteEntries = `-- name: CountInviteEntries :one
+SELECT COUNT(*)::int AS entries_count
+FROM access_logs
+WHERE invite_id = $1
+`
+
+func (q *Queries) CountInviteEntries(ctx context.Context, inviteID *uuid.UUID) (int32, error) {
+	row := q.db.QueryRow(ctx, countInviteEntries, inviteID)
+	var entries_count int32
+	err := row.Scan(&entries_count)
+	return entries_count, err
+}
+
 const createInvite = `-- name: CreateInvite :one
 INSERT INTO invites (
   condominium_id,
@@ -20,7 +33,12 @@ INSERT INTO invites (
   guest_name,
   guest_type,
   starts_at,
-  ends_at
+  ends_at,
+  recurrence_weekdays,
+  daily_start_minute,
+  daily_end_minute,
+  max_entries,
+  guest_plate
 ) VALUES (
   $1,
   $2,
@@ -28,18 +46,28 @@ INSERT INTO invites (
   $4,
   $5,
   $6,
-  $7
-) RETURNING id, condominium_id, apartment_id, issued_by, guest_name, guest_type, token, starts_at, ends_at, revoked_at, created_at
+  $7,
+  $8,
+  $9,
+  $10,
+  $11,
+  $12
+) RETURNING id, condominium_id, apartment_id, issued_by, guest_name, guest_type, token, starts_at, ends_at, revoked_at, created_at, recurrence_weekdays, daily_start_minute, daily_end_minute, max_entries, guest_plate
 `
 
 type CreateInviteParams struct {
-	CondominiumID uuid.UUID `json:"condominium_id"`
-	ApartmentID   uuid.UUID `json:"apartment_id"`
-	IssuedBy      uuid.UUID `json:"issued_by"`
-	GuestName     string    `json:"guest_name"`
-	GuestType     string    `json:"guest_type"`
-	StartsAt      time.Time `json:"starts_at"`
-	EndsAt        time.Time `json:"ends_at"`
+	CondominiumID      uuid.UUID `json:"condominium_id"`
+	ApartmentID        uuid.UUID `json:"apartment_id"`
+	IssuedBy           uuid.UUID `json:"issued_by"`
+	GuestName          string    `json:"guest_name"`
+	GuestType          string    `json:"guest_type"`
+	StartsAt           time.Time `json:"starts_at"`
+	EndsAt             time.Time `json:"ends_at"`
+	RecurrenceWeekdays []int16   `json:"recurrence_weekdays"`
+	DailyStartMinute   *int16    `json:"daily_start_minute"`
+	DailyEndMinute     *int16    `json:"daily_end_minute"`
+	MaxEntries         *int32    `json:"max_entries"`
+	GuestPlate         *string   `json:"guest_plate"`
 }
 
 func (q *Queries) CreateInvite(ctx context.Context, arg CreateInviteParams) (Invite, error) {
@@ -51,6 +79,11 @@ func (q *Queries) CreateInvite(ctx context.Context, arg CreateInviteParams) (Inv
 		arg.GuestType,
 		arg.StartsAt,
 		arg.EndsAt,
+		arg.RecurrenceWeekdays,
+		arg.DailyStartMinute,
+		arg.DailyEndMinute,
+		arg.MaxEntries,
+		arg.GuestPlate,
 	)
 	var i Invite
 	err := row.Scan(
@@ -65,13 +98,18 @@ func (q *Queries) CreateInvite(ctx context.Context, arg CreateInviteParams) (Inv
 		&i.EndsAt,
 		&i.RevokedAt,
 		&i.CreatedAt,
+		&i.RecurrenceWeekdays,
+		&i.DailyStartMinute,
+		&i.DailyEndMinute,
+		&i.MaxEntries,
+		&i.GuestPlate,
 	)
 	return i, err
 }
 
 const getInviteById = `-- name: GetInviteById :one
 SELECT
-  id, condominium_id, apartment_id, issued_by, guest_name, guest_type, token, starts_at, ends_at, revoked_at, created_at
+  id, condominium_id, apartment_id, issued_by, guest_name, guest_type, token, starts_at, ends_at, revoked_at, created_at, recurrence_weekdays, daily_start_minute, daily_end_minute, max_entries, guest_plate
 FROM invites
 WHERE id = $1
 `
@@ -91,37 +129,50 @@ func (q *Queries) GetInviteById(ctx context.Context, id uuid.UUID) (Invite, erro
 		&i.EndsAt,
 		&i.RevokedAt,
 		&i.CreatedAt,
+		&i.RecurrenceWeekdays,
+		&i.DailyStartMinute,
+		&i.DailyEndMinute,
+		&i.MaxEntries,
+		&i.GuestPlate,
 	)
 	return i, err
 }
 
 const getInviteByToken = `-- name: GetInviteByToken :one
 SELECT
-  i.id, i.condominium_id, i.apartment_id, i.issued_by, i.guest_name, i.guest_type, i.token, i.starts_at, i.ends_at, i.revoked_at, i.created_at,
+  i.id, i.condominium_id, i.apartment_id, i.issued_by, i.guest_name, i.guest_type, i.token, i.starts_at, i.ends_at, i.revoked_at, i.created_at, i.recurrence_weekdays, i.daily_start_minute, i.daily_end_minute, i.max_entries, i.guest_plate,
   a.block,
   a.number AS apartment_number,
-  u.name AS resident_name
+  u.name AS resident_name,
+  c.timezone AS condominium_timezone
 FROM invites i
 JOIN apartments a ON a.id = i.apartment_id
 JOIN users u ON u.id = i.issued_by
+JOIN condominiums c ON c.id = i.condominium_id
 WHERE i.token = $1
 `
 
 type GetInviteByTokenRow struct {
-	ID              uuid.UUID  `json:"id"`
-	CondominiumID   uuid.UUID  `json:"condominium_id"`
-	ApartmentID     uuid.UUID  `json:"apartment_id"`
-	IssuedBy        uuid.UUID  `json:"issued_by"`
-	GuestName       string     `json:"guest_name"`
-	GuestType       string     `json:"guest_type"`
-	Token           uuid.UUID  `json:"token"`
-	StartsAt        time.Time  `json:"starts_at"`
-	EndsAt          time.Time  `json:"ends_at"`
-	RevokedAt       *time.Time `json:"revoked_at"`
-	CreatedAt       time.Time  `json:"created_at"`
-	Block           *string    `json:"block"`
-	ApartmentNumber string     `json:"apartment_number"`
-	ResidentName    string     `json:"resident_name"`
+	ID                  uuid.UUID  `json:"id"`
+	CondominiumID       uuid.UUID  `json:"condominium_id"`
+	ApartmentID         uuid.UUID  `json:"apartment_id"`
+	IssuedBy            uuid.UUID  `json:"issued_by"`
+	GuestName           string     `json:"guest_name"`
+	GuestType           string     `json:"guest_type"`
+	Token               uuid.UUID  `json:"token"`
+	StartsAt            time.Time  `json:"starts_at"`
+	EndsAt              time.Time  `json:"ends_at"`
+	RevokedAt           *time.Time `json:"revoked_at"`
+	CreatedAt           time.Time  `json:"created_at"`
+	RecurrenceWeekdays  []int16    `json:"recurrence_weekdays"`
+	DailyStartMinute    *int16     `json:"daily_start_minute"`
+	DailyEndMinute      *int16     `json:"daily_end_minute"`
+	MaxEntries          *int32     `json:"max_entries"`
+	GuestPlate          *string    `json:"guest_plate"`
+	Block               *string    `json:"block"`
+	ApartmentNumber     string     `json:"apartment_number"`
+	ResidentName        string     `json:"resident_name"`
+	CondominiumTimezone string     `json:"condominium_timezone"`
 }
 
 func (q *Queries) GetInviteByToken(ctx context.Context, token uuid.UUID) (GetInviteByTokenRow, error) {
@@ -139,18 +190,113 @@ func (q *Queries) GetInviteByToken(ctx context.Context, token uuid.UUID) (GetInv
 		&i.EndsAt,
 		&i.RevokedAt,
 		&i.CreatedAt,
+		&i.RecurrenceWeekdays,
+		&i.DailyStartMinute,
+		&i.DailyEndMinute,
+		&i.MaxEntries,
+		&i.GuestPlate,
 		&i.Block,
 		&i.ApartmentNumber,
 		&i.ResidentName,
+		&i.CondominiumTimezone,
 	)
 	return i, err
 }
 
+const listActiveInvitesByPlate = `-- name: ListActiveInvitesByPlate :many
+SELECT
+  i.id, i.condominium_id, i.apartment_id, i.issued_by, i.guest_name, i.guest_type, i.token, i.starts_at, i.ends_at, i.revoked_at, i.created_at, i.recurrence_weekdays, i.daily_start_minute, i.daily_end_minute, i.max_entries, i.guest_plate,
+  a.block,
+  a.number AS apartment_number,
+  u.name AS resident_name,
+  c.timezone AS condominium_timezone
+FROM invites i
+JOIN apartments a ON a.id = i.apartment_id
+JOIN users u ON u.id = i.issued_by
+JOIN condominiums c ON c.id = i.condominium_id
+WHERE i.condominium_id = $1
+  AND i.guest_plate = ANY($2::text[])
+  AND i.revoked_at IS NULL
+  AND i.starts_at <= NOW()
+  AND i.ends_at > NOW()
+ORDER BY i.ends_at
+`
+
+type ListActiveInvitesByPlateParams struct {
+	CondominiumID uuid.UUID `json:"condominium_id"`
+	Plates        []string  `json:"plates"`
+}
+
+type ListActiveInvitesByPlateRow struct {
+	ID                  uuid.UUID  `json:"id"`
+	CondominiumID       uuid.UUID  `json:"condominium_id"`
+	ApartmentID         uuid.UUID  `json:"apartment_id"`
+	IssuedBy            uuid.UUID  `json:"issued_by"`
+	GuestName           string     `json:"guest_name"`
+	GuestType           string     `json:"guest_type"`
+	Token               uuid.UUID  `json:"token"`
+	StartsAt            time.Time  `json:"starts_at"`
+	EndsAt              time.Time  `json:"ends_at"`
+	RevokedAt           *time.Time `json:"revoked_at"`
+	CreatedAt           time.Time  `json:"created_at"`
+	RecurrenceWeekdays  []int16    `json:"recurrence_weekdays"`
+	DailyStartMinute    *int16     `json:"daily_start_minute"`
+	DailyEndMinute      *int16     `json:"daily_end_minute"`
+	MaxEntries          *int32     `json:"max_entries"`
+	GuestPlate          *string    `json:"guest_plate"`
+	Block               *string    `json:"block"`
+	ApartmentNumber     string     `json:"apartment_number"`
+	ResidentName        string     `json:"resident_name"`
+	CondominiumTimezone string     `json:"condominium_timezone"`
+}
+
+func (q *Queries) ListActiveInvitesByPlate(ctx context.Context, arg ListActiveInvitesByPlateParams) ([]ListActiveInvitesByPlateRow, error) {
+	rows, err := q.db.Query(ctx, listActiveInvitesByPlate, arg.CondominiumID, arg.Plates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListActiveInvitesByPlateRow
+	for rows.Next() {
+		var i ListActiveInvitesByPlateRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CondominiumID,
+			&i.ApartmentID,
+			&i.IssuedBy,
+			&i.GuestName,
+			&i.GuestType,
+			&i.Token,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.RevokedAt,
+			&i.CreatedAt,
+			&i.RecurrenceWeekdays,
+			&i.DailyStartMinute,
+			&i.DailyEndMinute,
+			&i.MaxEntries,
+			&i.GuestPlate,
+			&i.Block,
+			&i.ApartmentNumber,
+			&i.ResidentName,
+			&i.CondominiumTimezone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listInvites = `-- name: ListInvites :many
 SELECT
-    i.id, i.condominium_id, i.apartment_id, i.issued_by, i.guest_name, i.guest_type, i.token, i.starts_at, i.ends_at, i.revoked_at, i.created_at,
+    i.id, i.condominium_id, i.apartment_id, i.issued_by, i.guest_name, i.guest_type, i.token, i.starts_at, i.ends_at, i.revoked_at, i.created_at, i.recurrence_weekdays, i.daily_start_minute, i.daily_end_minute, i.max_entries, i.guest_plate,
     a.block,
-    a.number as apartment_number
+    a.number as apartment_number,
+    (SELECT COUNT(*) FROM access_logs l WHERE l.invite_id = i.id)::int AS entries_count
 FROM invites i
 JOIN apartments a ON a.id = i.apartment_id
 WHERE i.condominium_id = $1
@@ -172,19 +318,25 @@ type ListInvitesParams struct {
 }
 
 type ListInvitesRow struct {
-	ID              uuid.UUID  `json:"id"`
-	CondominiumID   uuid.UUID  `json:"condominium_id"`
-	ApartmentID     uuid.UUID  `json:"apartment_id"`
-	IssuedBy        uuid.UUID  `json:"issued_by"`
-	GuestName       string     `json:"guest_name"`
-	GuestType       string     `json:"guest_type"`
-	Token           uuid.UUID  `json:"token"`
-	StartsAt        time.Time  `json:"starts_at"`
-	EndsAt          time.Time  `json:"ends_at"`
-	RevokedAt       *time.Time `json:"revoked_at"`
-	CreatedAt       time.Time  `json:"created_at"`
-	Block           *string    `json:"block"`
-	ApartmentNumber string     `json:"apartment_number"`
+	ID                 uuid.UUID  `json:"id"`
+	CondominiumID      uuid.UUID  `json:"condominium_id"`
+	ApartmentID        uuid.UUID  `json:"apartment_id"`
+	IssuedBy           uuid.UUID  `json:"issued_by"`
+	GuestName          string     `json:"guest_name"`
+	GuestType          string     `json:"guest_type"`
+	Token              uuid.UUID  `json:"token"`
+	StartsAt           time.Time  `json:"starts_at"`
+	EndsAt             time.Time  `json:"ends_at"`
+	RevokedAt          *time.Time `json:"revoked_at"`
+	CreatedAt          time.Time  `json:"created_at"`
+	RecurrenceWeekdays []int16    `json:"recurrence_weekdays"`
+	DailyStartMinute   *int16     `json:"daily_start_minute"`
+	DailyEndMinute     *int16     `json:"daily_end_minute"`
+	MaxEntries         *int32     `json:"max_entries"`
+	GuestPlate         *string    `json:"guest_plate"`
+	Block              *string    `json:"block"`
+	ApartmentNumber    string     `json:"apartment_number"`
+	EntriesCount       int32      `json:"entries_count"`
 }
 
 func (q *Queries) ListInvites(ctx context.Context, arg ListInvitesParams) ([]ListInvitesRow, error) {
@@ -214,8 +366,14 @@ func (q *Queries) ListInvites(ctx context.Context, arg ListInvitesParams) ([]Lis
 			&i.EndsAt,
 			&i.RevokedAt,
 			&i.CreatedAt,
+			&i.RecurrenceWeekdays,
+			&i.DailyStartMinute,
+			&i.DailyEndMinute,
+			&i.MaxEntries,
+			&i.GuestPlate,
 			&i.Block,
 			&i.ApartmentNumber,
+			&i.EntriesCount,
 		); err != nil {
 			return nil, err
 		}
@@ -227,6 +385,19 @@ func (q *Queries) ListInvites(ctx context.Context, arg ListInvitesParams) ([]Lis
 	return items, nil
 }
 
+const lockInviteForEntry = `-- name: LockInviteForEntry :one
+SELECT id
+FROM invites
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) LockInviteForEntry(ctx context.Context, id uuid.UUID) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, lockInviteForEntry, id)
+	err := row.Scan(&id)
+	return id, err
+}
+
 const revokeInvite = `-- name: RevokeInvite :exec
 UPDATE invites
 SET revoked_at = NOW()
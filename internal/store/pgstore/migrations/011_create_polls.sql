@@ -0,0 +1,62 @@
+ALTER TABLE apartments
+ADD COLUMN voting_weight DOUBLE PRECISION NOT NULL DEFAULT 1 CHECK (voting_weight > 0);
+
+CREATE TABLE IF NOT EXISTS polls (
+  id                  UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+  condominium_id      UUID NOT NULL REFERENCES condominiums(id) ON DELETE CASCADE,
+  created_by          UUID REFERENCES users(id) ON DELETE SET NULL,
+  title               VARCHAR(150) NOT NULL,
+  description         TEXT,
+  choice_type         VARCHAR(20) NOT NULL DEFAULT 'single' CHECK (choice_type IN ('single', 'multiple')),
+  is_anonymous        BOOLEAN NOT NULL DEFAULT false,
+  is_weighted         BOOLEAN NOT NULL DEFAULT false,
+  results_visibility  VARCHAR(20) NOT NULL DEFAULT 'after_close' CHECK (results_visibility IN ('live', 'after_close')),
+  opens_at            TIMESTAMPTZ NOT NULL,
+  closes_at           TIMESTAMPTZ NOT NULL,
+  closed_at           TIMESTAMPTZ,
+  open_notified_at    TIMESTAMPTZ,
+  closing_notified_at TIMESTAMPTZ,
+  created_at          TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at          TIMESTAMPTZ,
+
+  CONSTRAINT valid_poll_period CHECK (closes_at > opens_at)
+);
+
+CREATE TABLE IF NOT EXISTS poll_options (
+  id       UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+  poll_id  UUID NOT NULL REFERENCES polls(id) ON DELETE CASCADE,
+  label    VARCHAR(150) NOT NULL,
+  position INT NOT NULL,
+
+  UNIQUE(poll_id, position)
+);
+
+-- One ballot per apartment. user_id is only kept for named polls.
+CREATE TABLE IF NOT EXISTS poll_ballots (
+  id           UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+  poll_id      UUID NOT NULL REFERENCES polls(id) ON DELETE CASCADE,
+  apartment_id UUID NOT NULL REFERENCES apartments(id) ON DELETE CASCADE,
+  user_id      UUID REFERENCES users(id) ON DELETE SET NULL,
+  weight       DOUBLE PRECISION NOT NULL DEFAULT 1,
+  created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+  UNIQUE(poll_id, apartment_id)
+);
+
+CREATE TABLE IF NOT EXISTS poll_ballot_choices (
+  ballot_id UUID NOT NULL REFERENCES poll_ballots(id) ON DELETE CASCADE,
+  option_id UUID NOT NULL REFERENCES poll_options(id) ON DELETE CASCADE,
+
+  PRIMARY KEY (ballot_id, option_id)
+);
+
+CREATE INDEX idx_polls_condo_period ON polls(condominium_id, opens_at, closes_at);
+CREATE INDEX idx_poll_ballot_choices_option ON poll_ballot_choices(option_id);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS poll_ballot_choices;
+DROP TABLE IF EXISTS poll_ballots;
+DROP TABLE IF EXISTS poll_options;
+DROP TABLE IF EXISTS polls;
+ALTER TABLE apartments DROP COLUMN voting_weight;
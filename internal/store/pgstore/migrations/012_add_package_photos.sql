@@ -0,0 +1,7 @@
+ALTER TABLE packages
+  ADD COLUMN photo_key     TEXT,
+  ADD COLUMN thumbnail_key TEXT;
+---- create above / drop below ----
+ALTER TABLE packages
+  DROP COLUMN IF EXISTS thumbnail_key,
+  DROP COLUMN IF EXISTS photo_key;
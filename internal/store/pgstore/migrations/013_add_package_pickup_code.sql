@@ -0,0 +1,13 @@
+ALTER TABLE packages
+  ADD COLUMN pickup_code        VARCHAR(6),
+  ADD COLUMN pickup_attempts    INT NOT NULL DEFAULT 0,
+  ADD COLUMN pickup_locked_at   TIMESTAMPTZ,
+  ADD COLUMN desk_release_until TIMESTAMPTZ,
+  ADD COLUMN desk_released_by   UUID REFERENCES users(id);
+---- create above / drop below ----
+ALTER TABLE packages
+  DROP COLUMN IF EXISTS desk_released_by,
+  DROP COLUMN IF EXISTS desk_release_until,
+  DROP COLUMN IF EXISTS pickup_locked_at,
+  DROP COLUMN IF EXISTS pickup_attempts,
+  DROP COLUMN IF EXISTS pickup_code;
@@ -0,0 +1,23 @@
+ALTER TABLE packages DROP CONSTRAINT IF EXISTS packages_status_check;
+ALTER TABLE packages
+  ADD CONSTRAINT packages_status_check CHECK (status IN ('pending', 'withdrawn', 'returned_to_sender')),
+  ADD COLUMN reminders_sent      INT NOT NULL DEFAULT 0,
+  ADD COLUMN last_reminder_at    TIMESTAMPTZ,
+  ADD COLUMN overdue_notified_at TIMESTAMPTZ,
+  ADD COLUMN returned_at         TIMESTAMPTZ,
+  ADD COLUMN returned_by         UUID REFERENCES users(id);
+
+CREATE INDEX idx_packages_status_received ON packages(status, received_at);
+---- create above / drop below ----
+DROP INDEX IF EXISTS idx_packages_status_received;
+
+UPDATE packages SET status = 'withdrawn' WHERE status = 'returned_to_sender';
+
+ALTER TABLE packages DROP CONSTRAINT IF EXISTS packages_status_check;
+ALTER TABLE packages
+  ADD CONSTRAINT packages_status_check CHECK (status IN ('pending', 'withdrawn')),
+  DROP COLUMN IF EXISTS returned_by,
+  DROP COLUMN IF EXISTS returned_at,
+  DROP COLUMN IF EXISTS overdue_notified_at,
+  DROP COLUMN IF EXISTS last_reminder_at,
+  DROP COLUMN IF EXISTS reminders_sent;
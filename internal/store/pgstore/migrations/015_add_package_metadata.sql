@@ -0,0 +1,19 @@
+ALTER TABLE packages
+  ADD COLUMN carrier          VARCHAR(50),
+  ADD COLUMN tracking_code    VARCHAR(100),
+  ADD COLUMN size_category    VARCHAR(15) CHECK (size_category IN ('envelope', 'small', 'medium', 'large', 'extra_large')),
+  ADD COLUMN is_perishable    BOOLEAN NOT NULL DEFAULT FALSE,
+  ADD COLUMN is_fragile       BOOLEAN NOT NULL DEFAULT FALSE,
+  ADD COLUMN storage_location VARCHAR(50);
+
+CREATE INDEX idx_packages_condo_tracking ON packages(condominium_id, tracking_code) WHERE tracking_code IS NOT NULL;
+---- create above / drop below ----
+DROP INDEX IF EXISTS idx_packages_condo_tracking;
+
+ALTER TABLE packages
+  DROP COLUMN IF EXISTS storage_location,
+  DROP COLUMN IF EXISTS is_fragile,
+  DROP COLUMN IF EXISTS is_perishable,
+  DROP COLUMN IF EXISTS size_category,
+  DROP COLUMN IF EXISTS tracking_code,
+  DROP COLUMN IF EXISTS carrier;
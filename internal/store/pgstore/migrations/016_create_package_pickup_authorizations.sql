@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS package_pickup_authorizations (
+  id                  UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+  condominium_id      UUID NOT NULL REFERENCES condominiums(id) ON DELETE CASCADE,
+  apartment_id        UUID NOT NULL REFERENCES apartments(id) ON DELETE CASCADE,
+  package_id          UUID REFERENCES packages(id) ON DELETE CASCADE,
+  authorized_name     VARCHAR(255) NOT NULL,
+  authorized_document VARCHAR(50) NOT NULL,
+  valid_from          TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  valid_until         TIMESTAMPTZ NOT NULL,
+  created_by          UUID NOT NULL REFERENCES users(id),
+  revoked_at          TIMESTAMPTZ,
+  created_at          TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+  CHECK (valid_until > valid_from)
+);
+
+CREATE INDEX idx_pickup_auth_apartment_document ON package_pickup_authorizations(apartment_id, authorized_document);
+
+ALTER TABLE packages
+  ADD COLUMN collected_by_name       VARCHAR(255),
+  ADD COLUMN collected_by_document   VARCHAR(50),
+  ADD COLUMN pickup_authorization_id UUID REFERENCES package_pickup_authorizations(id) ON DELETE SET NULL;
+---- create above / drop below ----
+ALTER TABLE packages
+  DROP COLUMN IF EXISTS pickup_authorization_id,
+  DROP COLUMN IF EXISTS collected_by_document,
+  DROP COLUMN IF EXISTS collected_by_name;
+
+DROP TABLE IF EXISTS package_pickup_authorizations;
@@ -0,0 +1,27 @@
+ALTER TABLE condominiums
+  ADD COLUMN timezone TEXT NOT NULL DEFAULT 'America/Sao_Paulo';
+
+-- Recurring invites repeat on the given weekdays (0 = Sunday ... 6 = Saturday) inside a daily
+-- window, expressed in minutes since midnight in the condominium's timezone.
+-- starts_at/ends_at still bound the whole validity period.
+ALTER TABLE invites
+  ADD COLUMN recurrence_weekdays SMALLINT[],
+  ADD COLUMN daily_start_minute  SMALLINT CHECK (daily_start_minute BETWEEN 0 AND 1439),
+  ADD COLUMN daily_end_minute    SMALLINT CHECK (daily_end_minute BETWEEN 1 AND 1440),
+  ADD CONSTRAINT invites_recurrence_check CHECK (
+    (recurrence_weekdays IS NULL AND daily_start_minute IS NULL AND daily_end_minute IS NULL)
+    OR (
+      cardinality(recurrence_weekdays) > 0
+      AND recurrence_weekdays <@ ARRAY[0, 1, 2, 3, 4, 5, 6]::SMALLINT[]
+      AND daily_start_minute < daily_end_minute
+    )
+  );
+---- create above / drop below ----
+ALTER TABLE invites
+  DROP CONSTRAINT IF EXISTS invites_recurrence_check,
+  DROP COLUMN IF EXISTS daily_end_minute,
+  DROP COLUMN IF EXISTS daily_start_minute,
+  DROP COLUMN IF EXISTS recurrence_weekdays;
+
+ALTER TABLE condominiums
+  DROP COLUMN IF EXISTS timezone;
@@ -0,0 +1,25 @@
+ALTER TABLE access_logs
+  ADD COLUMN apartment_id       UUID REFERENCES apartments(id),
+  ADD COLUMN exited_at          TIMESTAMPTZ,
+  ADD COLUMN exit_registered_by UUID REFERENCES users(id);
+
+UPDATE access_logs l
+SET apartment_id = i.apartment_id
+FROM invites i
+WHERE i.id = l.invite_id;
+
+ALTER TABLE access_logs
+  ALTER COLUMN apartment_id SET NOT NULL;
+
+CREATE INDEX idx_access_logs_condo_entered ON access_logs(condominium_id, entered_at DESC);
+CREATE INDEX idx_access_logs_apartment_entered ON access_logs(apartment_id, entered_at DESC);
+CREATE INDEX idx_access_logs_inside ON access_logs(condominium_id) WHERE exited_at IS NULL;
+---- create above / drop below ----
+DROP INDEX IF EXISTS idx_access_logs_inside;
+DROP INDEX IF EXISTS idx_access_logs_apartment_entered;
+DROP INDEX IF EXISTS idx_access_logs_condo_entered;
+
+ALTER TABLE access_logs
+  DROP COLUMN IF EXISTS exit_registered_by,
+  DROP COLUMN IF EXISTS exited_at,
+  DROP COLUMN IF EXISTS apartment_id;
@@ -0,0 +1,10 @@
+-- NULL means unlimited entries inside the validity window.
+ALTER TABLE invites
+  ADD COLUMN max_entries INTEGER CHECK (max_entries > 0);
+
+CREATE INDEX idx_access_logs_invite ON access_logs(invite_id);
+---- create above / drop below ----
+DROP INDEX IF EXISTS idx_access_logs_invite;
+
+ALTER TABLE invites
+  DROP COLUMN IF EXISTS max_entries;
@@ -0,0 +1,33 @@
+-- Walk-in visitors registered at the front desk, waiting for a resident to approve or deny.
+CREATE TABLE IF NOT EXISTS visitor_requests (
+  id               UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+  condominium_id   UUID NOT NULL REFERENCES condominiums(id) ON DELETE CASCADE,
+  apartment_id     UUID NOT NULL REFERENCES apartments(id) ON DELETE CASCADE,
+  visitor_name     VARCHAR(255) NOT NULL,
+  visitor_document VARCHAR(50),
+  photo_key        TEXT,
+  status           VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'denied', 'expired')),
+  requested_by     UUID NOT NULL REFERENCES users(id),
+  decided_by       UUID REFERENCES users(id),
+  decided_at       TIMESTAMPTZ,
+  expires_at       TIMESTAMPTZ NOT NULL,
+  created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_visitor_requests_condo_created ON visitor_requests(condominium_id, created_at DESC);
+CREATE INDEX idx_visitor_requests_pending ON visitor_requests(expires_at) WHERE status = 'pending';
+
+-- An entry now comes either from an invite or from an approved walk-in.
+ALTER TABLE access_logs
+  ALTER COLUMN invite_id DROP NOT NULL,
+  ADD COLUMN visitor_request_id UUID REFERENCES visitor_requests(id),
+  ADD CONSTRAINT access_logs_origin_check CHECK (num_nonnulls(invite_id, visitor_request_id) = 1);
+---- create above / drop below ----
+DELETE FROM access_logs WHERE invite_id IS NULL;
+
+ALTER TABLE access_logs
+  DROP CONSTRAINT IF EXISTS access_logs_origin_check,
+  DROP COLUMN IF EXISTS visitor_request_id,
+  ALTER COLUMN invite_id SET NOT NULL;
+
+DROP TABLE IF EXISTS visitor_requests;
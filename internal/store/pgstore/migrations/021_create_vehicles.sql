@@ -0,0 +1,42 @@
+CREATE TABLE IF NOT EXISTS vehicles (
+  id             UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+  condominium_id UUID NOT NULL REFERENCES condominiums(id) ON DELETE CASCADE,
+  apartment_id   UUID NOT NULL REFERENCES apartments(id) ON DELETE CASCADE,
+  plate          VARCHAR(7) NOT NULL,
+  model          VARCHAR(100) NOT NULL,
+  color          VARCHAR(50) NOT NULL,
+  created_by     UUID NOT NULL REFERENCES users(id),
+  -- Kept instead of deleted so access logs keep pointing at the vehicle.
+  removed_at     TIMESTAMPTZ,
+  created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_vehicles_condo_plate ON vehicles(condominium_id, plate) WHERE removed_at IS NULL;
+CREATE INDEX idx_vehicles_apartment ON vehicles(apartment_id) WHERE removed_at IS NULL;
+
+ALTER TABLE invites
+  ADD COLUMN guest_plate VARCHAR(7);
+
+CREATE INDEX idx_invites_guest_plate ON invites(condominium_id, guest_plate) WHERE guest_plate IS NOT NULL;
+
+-- Resident vehicles are a third entry origin; plate records the plate read at the gate.
+ALTER TABLE access_logs
+  ADD COLUMN vehicle_id UUID REFERENCES vehicles(id),
+  ADD COLUMN plate      VARCHAR(7),
+  DROP CONSTRAINT access_logs_origin_check,
+  ADD CONSTRAINT access_logs_origin_check CHECK (num_nonnulls(invite_id, visitor_request_id, vehicle_id) = 1);
+---- create above / drop below ----
+DELETE FROM access_logs WHERE vehicle_id IS NOT NULL;
+
+ALTER TABLE access_logs
+  DROP CONSTRAINT IF EXISTS access_logs_origin_check,
+  DROP COLUMN IF EXISTS plate,
+  DROP COLUMN IF EXISTS vehicle_id,
+  ADD CONSTRAINT access_logs_origin_check CHECK (num_nonnulls(invite_id, visitor_request_id) = 1);
+
+DROP INDEX IF EXISTS idx_invites_guest_plate;
+
+ALTER TABLE invites
+  DROP COLUMN IF EXISTS guest_plate;
+
+DROP TABLE IF EXISTS vehicles;
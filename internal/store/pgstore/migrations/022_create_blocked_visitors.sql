@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS blocked_visitors (
+  id             UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+  condominium_id UUID NOT NULL REFERENCES condominiums(id) ON DELETE CASCADE,
+  name           VARCHAR(255) NOT NULL,
+  document       VARCHAR(50),
+  plate          VARCHAR(7),
+  reason         TEXT NOT NULL,
+  created_by     UUID NOT NULL REFERENCES users(id),
+  -- NULL keeps the visitor blocked until the entry is removed.
+  expires_at     TIMESTAMPTZ,
+  removed_at     TIMESTAMPTZ,
+  removed_by     UUID REFERENCES users(id),
+  created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_blocked_visitors_condo ON blocked_visitors(condominium_id) WHERE removed_at IS NULL;
+---- create above / drop below ----
+DROP TABLE IF EXISTS blocked_visitors;
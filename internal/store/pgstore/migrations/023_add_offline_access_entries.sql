@@ -0,0 +1,9 @@
+-- Entries recorded by gate devices while offline. The device generates offline_entry_id, so
+-- uploading the same batch twice does not duplicate entries.
+ALTER TABLE access_logs
+  ADD COLUMN offline_entry_id UUID UNIQUE,
+  ADD COLUMN synced_at TIMESTAMPTZ;
+---- create above / drop below ----
+ALTER TABLE access_logs
+  DROP COLUMN IF EXISTS synced_at,
+  DROP COLUMN IF EXISTS offline_entry_id;
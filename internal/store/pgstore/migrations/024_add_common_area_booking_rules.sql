@@ -0,0 +1,36 @@
+-- NULL disables the rule.
+ALTER TABLE common_areas
+  ADD COLUMN min_duration_minutes   INT CHECK (min_duration_minutes > 0),
+  ADD COLUMN max_duration_minutes   INT CHECK (max_duration_minutes > 0),
+  ADD COLUMN max_advance_days       INT CHECK (max_advance_days > 0),
+  -- Free time required between two bookings of the area (cleaning, setup).
+  ADD COLUMN cooldown_minutes       INT NOT NULL DEFAULT 0 CHECK (cooldown_minutes >= 0),
+  ADD COLUMN max_bookings_per_month INT CHECK (max_bookings_per_month > 0),
+  ADD CONSTRAINT common_areas_duration_check CHECK (min_duration_minutes <= max_duration_minutes);
+
+-- Areas without rows are always open. Otherwise weekdays without a row are closed. Minutes since
+-- midnight in the condominium timezone; closes_minute 1440 is midnight at the end of the day.
+CREATE TABLE IF NOT EXISTS common_area_opening_hours (
+  common_area_id UUID NOT NULL REFERENCES common_areas(id) ON DELETE CASCADE,
+  weekday        SMALLINT NOT NULL CHECK (weekday BETWEEN 0 AND 6),
+  opens_minute   SMALLINT NOT NULL CHECK (opens_minute >= 0),
+  closes_minute  SMALLINT NOT NULL CHECK (closes_minute <= 1440),
+
+  PRIMARY KEY (common_area_id, weekday),
+  CHECK (closes_minute > opens_minute)
+);
+
+CREATE INDEX idx_bookings_apartment_area ON bookings(apartment_id, common_area_id, starts_at)
+WHERE deleted_at IS NULL AND status IN ('confirmed', 'pending');
+---- create above / drop below ----
+DROP INDEX IF EXISTS idx_bookings_apartment_area;
+
+DROP TABLE IF EXISTS common_area_opening_hours;
+
+ALTER TABLE common_areas
+  DROP CONSTRAINT IF EXISTS common_areas_duration_check,
+  DROP COLUMN IF EXISTS max_bookings_per_month,
+  DROP COLUMN IF EXISTS cooldown_minutes,
+  DROP COLUMN IF EXISTS max_advance_days,
+  DROP COLUMN IF EXISTS max_duration_minutes,
+  DROP COLUMN IF EXISTS min_duration_minutes;
@@ -0,0 +1,20 @@
+-- NULL disables the rule: bookings can be cancelled any time before they start, free of charge.
+ALTER TABLE common_areas
+  ADD COLUMN cancellation_deadline_hours INT CHECK (cancellation_deadline_hours > 0),
+  ADD COLUMN late_cancellation_fee_cents BIGINT CHECK (late_cancellation_fee_cents > 0);
+
+ALTER TABLE bookings
+  ADD COLUMN cancelled_at              TIMESTAMPTZ,
+  ADD COLUMN cancelled_by              UUID REFERENCES users(id) ON DELETE SET NULL,
+  ADD COLUMN late_cancellation         BOOLEAN NOT NULL DEFAULT false,
+  ADD COLUMN cancellation_fine_bill_id UUID REFERENCES bills(id) ON DELETE SET NULL;
+---- create above / drop below ----
+ALTER TABLE bookings
+  DROP COLUMN IF EXISTS cancellation_fine_bill_id,
+  DROP COLUMN IF EXISTS late_cancellation,
+  DROP COLUMN IF EXISTS cancelled_by,
+  DROP COLUMN IF EXISTS cancelled_at;
+
+ALTER TABLE common_areas
+  DROP COLUMN IF EXISTS late_cancellation_fee_cents,
+  DROP COLUMN IF EXISTS cancellation_deadline_hours;
@@ -0,0 +1,36 @@
+-- NULL price or deposit means the area is free to use / requires no deposit.
+ALTER TABLE common_areas
+  ADD COLUMN price_cents   BIGINT CHECK (price_cents > 0),
+  ADD COLUMN pricing_unit  VARCHAR(20) NOT NULL DEFAULT 'booking' CHECK (pricing_unit IN ('booking', 'hour')),
+  ADD COLUMN deposit_cents BIGINT CHECK (deposit_cents > 0);
+
+ALTER TABLE bills DROP CONSTRAINT IF EXISTS bills_bill_type_check;
+ALTER TABLE bills ADD CONSTRAINT bills_bill_type_check
+  CHECK (bill_type IN ('rent', 'condominium_fee', 'water', 'electricity', 'gas', 'fine', 'booking_fee', 'booking_deposit'));
+
+-- Bills created when the booking was confirmed, and how the deposit was given back.
+ALTER TABLE bookings
+  ADD COLUMN fee_bill_id              UUID REFERENCES bills(id) ON DELETE SET NULL,
+  ADD COLUMN deposit_bill_id          UUID REFERENCES bills(id) ON DELETE SET NULL,
+  ADD COLUMN deposit_released_at      TIMESTAMPTZ,
+  ADD COLUMN deposit_released_by      UUID REFERENCES users(id) ON DELETE SET NULL,
+  ADD COLUMN deposit_retained_cents   BIGINT CHECK (deposit_retained_cents >= 0),
+  ADD COLUMN deposit_retention_reason TEXT;
+---- create above / drop below ----
+ALTER TABLE bookings
+  DROP COLUMN IF EXISTS deposit_retention_reason,
+  DROP COLUMN IF EXISTS deposit_retained_cents,
+  DROP COLUMN IF EXISTS deposit_released_by,
+  DROP COLUMN IF EXISTS deposit_released_at,
+  DROP COLUMN IF EXISTS deposit_bill_id,
+  DROP COLUMN IF EXISTS fee_bill_id;
+
+DELETE FROM bills WHERE bill_type IN ('booking_fee', 'booking_deposit');
+ALTER TABLE bills DROP CONSTRAINT IF EXISTS bills_bill_type_check;
+ALTER TABLE bills ADD CONSTRAINT bills_bill_type_check
+  CHECK (bill_type IN ('rent', 'condominium_fee', 'water', 'electricity', 'gas', 'fine'));
+
+ALTER TABLE common_areas
+  DROP COLUMN IF EXISTS deposit_cents,
+  DROP COLUMN IF EXISTS pricing_unit,
+  DROP COLUMN IF EXISTS price_cents;
@@ -0,0 +1,15 @@
+-- Areas allowing overlapping bookings (gym, pool) take bookings while the headcount of the
+-- overlapping ones fits the capacity. Other areas stay exclusive: one booking at a time.
+ALTER TABLE common_areas
+  ADD COLUMN allow_overlapping_bookings BOOLEAN NOT NULL DEFAULT false,
+  ADD CONSTRAINT common_areas_overlapping_capacity_check CHECK (NOT allow_overlapping_bookings OR capacity IS NOT NULL);
+
+ALTER TABLE bookings
+  ADD COLUMN headcount INT NOT NULL DEFAULT 1 CHECK (headcount > 0);
+---- create above / drop below ----
+ALTER TABLE bookings
+  DROP COLUMN IF EXISTS headcount;
+
+ALTER TABLE common_areas
+  DROP CONSTRAINT IF EXISTS common_areas_overlapping_capacity_check,
+  DROP COLUMN IF EXISTS allow_overlapping_bookings;
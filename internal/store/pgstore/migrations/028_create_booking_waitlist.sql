@@ -0,0 +1,29 @@
+-- Residents waiting for a taken time range. While an offer is open the range is held for them:
+-- offered entries count as occupied until offer_expires_at.
+CREATE TABLE IF NOT EXISTS booking_waitlist_entries (
+  id               UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+  condominium_id   UUID NOT NULL REFERENCES condominiums(id) ON DELETE CASCADE,
+  common_area_id   UUID NOT NULL REFERENCES common_areas(id) ON DELETE CASCADE,
+  apartment_id     UUID NOT NULL REFERENCES apartments(id) ON DELETE CASCADE,
+  user_id          UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  starts_at        TIMESTAMPTZ NOT NULL,
+  ends_at          TIMESTAMPTZ NOT NULL,
+  headcount        INT NOT NULL DEFAULT 1 CHECK (headcount > 0),
+  status           VARCHAR(20) NOT NULL DEFAULT 'waiting' CHECK (status IN ('waiting', 'offered', 'accepted', 'expired', 'cancelled')),
+  offered_at       TIMESTAMPTZ,
+  offer_expires_at TIMESTAMPTZ,
+  booking_id       UUID REFERENCES bookings(id) ON DELETE SET NULL,
+  created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at       TIMESTAMPTZ,
+
+  CONSTRAINT valid_time_range CHECK (ends_at > starts_at),
+  CHECK (status <> 'offered' OR offer_expires_at IS NOT NULL)
+);
+
+CREATE INDEX idx_booking_waitlist_area ON booking_waitlist_entries(common_area_id, starts_at, ends_at)
+WHERE status IN ('waiting', 'offered');
+
+CREATE UNIQUE INDEX idx_booking_waitlist_unique_active ON booking_waitlist_entries(apartment_id, common_area_id, starts_at, ends_at)
+WHERE status IN ('waiting', 'offered');
+---- create above / drop below ----
+DROP TABLE IF EXISTS booking_waitlist_entries;
@@ -0,0 +1,25 @@
+-- Archived areas are kept for the booking history but take no new bookings.
+ALTER TABLE common_areas
+  ADD COLUMN archived_at TIMESTAMPTZ;
+
+-- Periods an area is closed (maintenance, private events). Bookings overlapping a blackout are
+-- cancelled when it is created and new ones are refused.
+CREATE TABLE IF NOT EXISTS common_area_blackouts (
+  id             UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+  condominium_id UUID NOT NULL REFERENCES condominiums(id) ON DELETE CASCADE,
+  common_area_id UUID NOT NULL REFERENCES common_areas(id) ON DELETE CASCADE,
+  starts_at      TIMESTAMPTZ NOT NULL,
+  ends_at        TIMESTAMPTZ NOT NULL,
+  reason         TEXT,
+  created_by     UUID REFERENCES users(id) ON DELETE SET NULL,
+  created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+  CONSTRAINT valid_time_range CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX idx_common_area_blackouts_area ON common_area_blackouts(common_area_id, starts_at, ends_at);
+---- create above / drop below ----
+DROP TABLE IF EXISTS common_area_blackouts;
+
+ALTER TABLE common_areas
+  DROP COLUMN IF EXISTS archived_at;
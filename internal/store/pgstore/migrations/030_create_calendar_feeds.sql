@@ -0,0 +1,15 @@
+-- Secret iCalendar feed URLs calendar apps subscribe to without a Bearer header. A feed covers
+-- the bookings of its owner, or of the whole condominium for admins and syndics.
+CREATE TABLE IF NOT EXISTS calendar_feeds (
+  id             UUID PRIMARY KEY NOT NULL DEFAULT gen_random_uuid(),
+  condominium_id UUID NOT NULL REFERENCES condominiums(id) ON DELETE CASCADE,
+  user_id        UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  scope          VARCHAR(20) NOT NULL CHECK (scope IN ('user', 'condominium')),
+  token          UUID NOT NULL UNIQUE DEFAULT gen_random_uuid(),
+  created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  revoked_at     TIMESTAMPTZ
+);
+
+CREATE INDEX idx_calendar_feeds_user ON calendar_feeds(user_id, condominium_id) WHERE revoked_at IS NULL;
+---- create above / drop below ----
+DROP TABLE IF EXISTS calendar_feeds;
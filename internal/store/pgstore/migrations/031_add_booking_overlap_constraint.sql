@@ -0,0 +1,22 @@
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+-- Whether the booking was made while its area was exclusive. Active exclusive bookings of an area
+-- cannot overlap, whichever code path writes them. Kept per booking so changing the area mode
+-- does not touch existing bookings.
+ALTER TABLE bookings
+  ADD COLUMN exclusive BOOLEAN NOT NULL DEFAULT true;
+
+UPDATE bookings b
+SET exclusive = NOT ca.allow_overlapping_bookings
+FROM common_areas ca
+WHERE ca.id = b.common_area_id;
+
+ALTER TABLE bookings
+  ADD CONSTRAINT bookings_no_overlap EXCLUDE USING gist (
+    common_area_id WITH =,
+    tstzrange(starts_at, ends_at) WITH &&
+  ) WHERE (exclusive AND deleted_at IS NULL AND status IN ('confirmed', 'pending'));
+---- create above / drop below ----
+ALTER TABLE bookings
+  DROP CONSTRAINT IF EXISTS bookings_no_overlap,
+  DROP COLUMN IF EXISTS exclusive;
@@ -0,0 +1,21 @@
+-- Lead time before the start at which bookings still waiting for approval expire. NULL keeps them
+-- pending until an admin decides. 'deny' refuses them and frees the slot; 'escalate' warns the
+-- admins and refuses them only if they are still pending when they start.
+ALTER TABLE common_areas
+  ADD COLUMN pending_expiry_hours  INT CHECK (pending_expiry_hours > 0),
+  ADD COLUMN pending_expiry_action VARCHAR(20) NOT NULL DEFAULT 'deny' CHECK (pending_expiry_action IN ('deny', 'escalate'));
+
+ALTER TABLE bookings
+  ADD COLUMN escalated_at TIMESTAMPTZ;
+
+CREATE INDEX idx_bookings_pending ON bookings(starts_at)
+WHERE deleted_at IS NULL AND status = 'pending';
+---- create above / drop below ----
+DROP INDEX IF EXISTS idx_bookings_pending;
+
+ALTER TABLE bookings
+  DROP COLUMN IF EXISTS escalated_at;
+
+ALTER TABLE common_areas
+  DROP COLUMN IF EXISTS pending_expiry_action,
+  DROP COLUMN IF EXISTS pending_expiry_hours;
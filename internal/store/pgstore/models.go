@@ -12,11 +12,19 @@ import (
 )
 
 type AccessLog struct {
-	ID            uuid.UUID  `json:"id"`
-	InviteID      uuid.UUID  `json:"invite_id"`
-	CondominiumID uuid.UUID  `json:"condominium_id"`
-	EnteredAt     time.Time  `json:"entered_at"`
-	AuthorizedBy  *uuid.UUID `json:"authorized_by"`
+	ID               uuid.UUID  `json:"id"`
+	InviteID         *uuid.UUID `json:"invite_id"`
+	CondominiumID    uuid.UUID  `json:"condominium_id"`
+	EnteredAt        time.Time  `json:"entered_at"`
+	AuthorizedBy     *uuid.UUID `json:"authorized_by"`
+	ApartmentID      uuid.UUID  `json:"apartment_id"`
+	ExitedAt         *time.Time `json:"exited_at"`
+	ExitRegisteredBy *uuid.UUID `json:"exit_registered_by"`
+	VisitorRequestID *uuid.UUID `json:"visitor_request_id"`
+	VehicleID        *uuid.UUID `json:"vehicle_id"`
+	Plate            *string    `json:"plate"`
+	OfflineEntryID   *uuid.UUID `json:"offline_entry_id"`
+	SyncedAt         *time.Time `json:"synced_at"`
 }
 
 type AccessRequest struct {
@@ -65,6 +73,7 @@ type Apartment struct {
 	Number        string     `json:"number"`
 	CreatedAt     time.Time  `json:"created_at"`
 	UpdatedAt     *time.Time `json:"updated_at"`
+	VotingWeight  float64    `json:"voting_weight"`
 }
 
 type Bill struct {
@@ -82,26 +91,112 @@ type Bill struct {
 	Status        string     `json:"status"`
 }
 
+type BlockedVisitor struct {
+	ID            uuid.UUID  `json:"id"`
+	CondominiumID uuid.UUID  `json:"condominium_id"`
+	Name          string     `json:"name"`
+	Document      *string    `json:"document"`
+	Plate         *string    `json:"plate"`
+	Reason        string     `json:"reason"`
+	CreatedBy     uuid.UUID  `json:"created_by"`
+	ExpiresAt     *time.Time `json:"expires_at"`
+	RemovedAt     *time.Time `json:"removed_at"`
+	RemovedBy     *uuid.UUID `json:"removed_by"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
 type Booking struct {
+	ID                     uuid.UUID  `json:"id"`
+	CondominiumID          uuid.UUID  `json:"condominium_id"`
+	ApartmentID            uuid.UUID  `json:"apartment_id"`
+	UserID                 uuid.UUID  `json:"user_id"`
+	CommonAreaID           uuid.UUID  `json:"common_area_id"`
+	Status                 string     `json:"status"`
+	StartsAt               time.Time  `json:"starts_at"`
+	EndsAt                 time.Time  `json:"ends_at"`
+	CreatedAt              time.Time  `json:"created_at"`
+	UpdatedAt              *time.Time `json:"updated_at"`
+	DeletedAt              *time.Time `json:"deleted_at"`
+	CancelledAt            *time.Time `json:"cancelled_at"`
+	CancelledBy            *uuid.UUID `json:"cancelled_by"`
+	LateCancellation       bool       `json:"late_cancellation"`
+	CancellationFineBillID *uuid.UUID `json:"cancellation_fine_bill_id"`
+	FeeBillID              *uuid.UUID `json:"fee_bill_id"`
+	DepositBillID          *uuid.UUID `json:"deposit_bill_id"`
+	DepositReleasedAt      *time.Time `json:"deposit_released_at"`
+	DepositReleasedBy      *uuid.UUID `json:"deposit_released_by"`
+	DepositRetainedCents   *int64     `json:"deposit_retained_cents"`
+	DepositRetentionReason *string    `json:"deposit_retention_reason"`
+	Headcount              int32      `json:"headcount"`
+	Exclusive              bool       `json:"exclusive"`
+	EscalatedAt            *time.Time `json:"escalated_at"`
+}
+
+type BookingWaitlistEntry struct {
+	ID             uuid.UUID  `json:"id"`
+	CondominiumID  uuid.UUID  `json:"condominium_id"`
+	CommonAreaID   uuid.UUID  `json:"common_area_id"`
+	ApartmentID    uuid.UUID  `json:"apartment_id"`
+	UserID         uuid.UUID  `json:"user_id"`
+	StartsAt       time.Time  `json:"starts_at"`
+	EndsAt         time.Time  `json:"ends_at"`
+	Headcount      int32      `json:"headcount"`
+	Status         string     `json:"status"`
+	OfferedAt      *time.Time `json:"offered_at"`
+	OfferExpiresAt *time.Time `json:"offer_expires_at"`
+	BookingID      *uuid.UUID `json:"booking_id"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      *time.Time `json:"updated_at"`
+}
+
+type CalendarFeed struct {
 	ID            uuid.UUID  `json:"id"`
 	CondominiumID uuid.UUID  `json:"condominium_id"`
-	ApartmentID   uuid.UUID  `json:"apartment_id"`
 	UserID        uuid.UUID  `json:"user_id"`
+	Scope         string     `json:"scope"`
+	Token         uuid.UUID  `json:"token"`
+	CreatedAt     time.Time  `json:"created_at"`
+	RevokedAt     *time.Time `json:"revoked_at"`
+}
+
+type CommonArea struct {
+	ID                        uuid.UUID  `json:"id"`
+	CondominiumID             uuid.UUID  `json:"condominium_id"`
+	Name                      string     `json:"name"`
+	Capacity                  *int32     `json:"capacity"`
+	RequiresApproval          bool       `json:"requires_approval"`
+	MinDurationMinutes        *int32     `json:"min_duration_minutes"`
+	MaxDurationMinutes        *int32     `json:"max_duration_minutes"`
+	MaxAdvanceDays            *int32     `json:"max_advance_days"`
+	CooldownMinutes           int32      `json:"cooldown_minutes"`
+	MaxBookingsPerMonth       *int32     `json:"max_bookings_per_month"`
+	CancellationDeadlineHours *int32     `json:"cancellation_deadline_hours"`
+	LateCancellationFeeCents  *int64     `json:"late_cancellation_fee_cents"`
+	PriceCents                *int64     `json:"price_cents"`
+	PricingUnit               string     `json:"pricing_unit"`
+	DepositCents              *int64     `json:"deposit_cents"`
+	AllowOverlappingBookings  bool       `json:"allow_overlapping_bookings"`
+	ArchivedAt                *time.Time `json:"archived_at"`
+	PendingExpiryHours        *int32     `json:"pending_expiry_hours"`
+	PendingExpiryAction       string     `json:"pending_expiry_action"`
+}
+
+type CommonAreaBlackout struct {
+	ID            uuid.UUID  `json:"id"`
+	CondominiumID uuid.UUID  `json:"condominium_id"`
 	CommonAreaID  uuid.UUID  `json:"common_area_id"`
-	Status        string     `json:"status"`
 	StartsAt      time.Time  `json:"starts_at"`
 	EndsAt        time.Time  `json:"ends_at"`
+	Reason        *string    `json:"reason"`
+	CreatedBy     *uuid.UUID `json:"created_by"`
 	CreatedAt     time.Time  `json:"created_at"`
-	UpdatedAt     *time.Time `json:"updated_at"`
-	DeletedAt     *time.Time `json:"deleted_at"`
 }
 
-type CommonArea struct {
-	ID               uuid.UUID `json:"id"`
-	CondominiumID    uuid.UUID `json:"condominium_id"`
-	Name             string    `json:"name"`
-	Capacity         *int32    `json:"capacity"`
-	RequiresApproval bool      `json:"requires_approval"`
+type CommonAreaOpeningHour struct {
+	CommonAreaID uuid.UUID `json:"common_area_id"`
+	Weekday      int16     `json:"weekday"`
+	OpensMinute  int16     `json:"opens_minute"`
+	ClosesMinute int16     `json:"closes_minute"`
 }
 
 type Condominium struct {
@@ -112,6 +207,7 @@ type Condominium struct {
 	PlanType  string     `json:"plan_type"`
 	CreatedAt time.Time  `json:"created_at"`
 	UpdatedAt *time.Time `json:"updated_at"`
+	Timezone  string     `json:"timezone"`
 }
 
 type CondominiumMember struct {
@@ -124,30 +220,110 @@ type CondominiumMember struct {
 }
 
 type Invite struct {
-	ID            uuid.UUID  `json:"id"`
-	CondominiumID uuid.UUID  `json:"condominium_id"`
-	ApartmentID   uuid.UUID  `json:"apartment_id"`
-	IssuedBy      uuid.UUID  `json:"issued_by"`
-	GuestName     string     `json:"guest_name"`
-	GuestType     string     `json:"guest_type"`
-	Token         uuid.UUID  `json:"token"`
-	StartsAt      time.Time  `json:"starts_at"`
-	EndsAt        time.Time  `json:"ends_at"`
-	RevokedAt     *time.Time `json:"revoked_at"`
-	CreatedAt     time.Time  `json:"created_at"`
+	ID                 uuid.UUID  `json:"id"`
+	CondominiumID      uuid.UUID  `json:"condominium_id"`
+	ApartmentID        uuid.UUID  `json:"apartment_id"`
+	IssuedBy           uuid.UUID  `json:"issued_by"`
+	GuestName          string     `json:"guest_name"`
+	GuestType          string     `json:"guest_type"`
+	Token              uuid.UUID  `json:"token"`
+	StartsAt           time.Time  `json:"starts_at"`
+	EndsAt             time.Time  `json:"ends_at"`
+	RevokedAt          *time.Time `json:"revoked_at"`
+	CreatedAt          time.Time  `json:"created_at"`
+	RecurrenceWeekdays []int16    `json:"recurrence_weekdays"`
+	DailyStartMinute   *int16     `json:"daily_start_minute"`
+	DailyEndMinute     *int16     `json:"daily_end_minute"`
+	MaxEntries         *int32     `json:"max_entries"`
+	GuestPlate         *string    `json:"guest_plate"`
 }
 
 type Package struct {
-	ID            uuid.UUID  `json:"id"`
-	CondominiumID uuid.UUID  `json:"condominium_id"`
-	ApartmentID   uuid.UUID  `json:"apartment_id"`
-	ReceivedBy    uuid.UUID  `json:"received_by"`
-	ReceivedAt    time.Time  `json:"received_at"`
-	RecipientName *string    `json:"recipient_name"`
-	PhotoUrl      *string    `json:"photo_url"`
-	Status        string     `json:"status"`
-	WithdrawnAt   *time.Time `json:"withdrawn_at"`
-	WithdrawnBy   *uuid.UUID `json:"withdrawn_by"`
+	ID                    uuid.UUID  `json:"id"`
+	CondominiumID         uuid.UUID  `json:"condominium_id"`
+	ApartmentID           uuid.UUID  `json:"apartment_id"`
+	ReceivedBy            uuid.UUID  `json:"received_by"`
+	ReceivedAt            time.Time  `json:"received_at"`
+	RecipientName         *string    `json:"recipient_name"`
+	PhotoUrl              *string    `json:"photo_url"`
+	Status                string     `json:"status"`
+	WithdrawnAt           *time.Time `json:"withdrawn_at"`
+	WithdrawnBy           *uuid.UUID `json:"withdrawn_by"`
+	PhotoKey              *string    `json:"photo_key"`
+	ThumbnailKey          *string    `json:"thumbnail_key"`
+	PickupCode            *string    `json:"pickup_code"`
+	PickupAttempts        int32      `json:"pickup_attempts"`
+	PickupLockedAt        *time.Time `json:"pickup_locked_at"`
+	DeskReleaseUntil      *time.Time `json:"desk_release_until"`
+	DeskReleasedBy        *uuid.UUID `json:"desk_released_by"`
+	RemindersSent         int32      `json:"reminders_sent"`
+	LastReminderAt        *time.Time `json:"last_reminder_at"`
+	OverdueNotifiedAt     *time.Time `json:"overdue_notified_at"`
+	ReturnedAt            *time.Time `json:"returned_at"`
+	ReturnedBy            *uuid.UUID `json:"returned_by"`
+	Carrier               *string    `json:"carrier"`
+	TrackingCode          *string    `json:"tracking_code"`
+	SizeCategory          *string    `json:"size_category"`
+	IsPerishable          bool       `json:"is_perishable"`
+	IsFragile             bool       `json:"is_fragile"`
+	StorageLocation       *string    `json:"storage_location"`
+	CollectedByName       *string    `json:"collected_by_name"`
+	CollectedByDocument   *string    `json:"collected_by_document"`
+	PickupAuthorizationID *uuid.UUID `json:"pickup_authorization_id"`
+}
+
+type PackagePickupAuthorization struct {
+	ID                 uuid.UUID  `json:"id"`
+	CondominiumID      uuid.UUID  `json:"condominium_id"`
+	ApartmentID        uuid.UUID  `json:"apartment_id"`
+	PackageID          *uuid.UUID `json:"package_id"`
+	AuthorizedName     string     `json:"authorized_name"`
+	AuthorizedDocument string     `json:"authorized_document"`
+	ValidFrom          time.Time  `json:"valid_from"`
+	ValidUntil         time.Time  `json:"valid_until"`
+	CreatedBy          uuid.UUID  `json:"created_by"`
+	RevokedAt          *time.Time `json:"revoked_at"`
+	CreatedAt          time.Time  `json:"created_at"`
+}
+
+type Poll struct {
+	ID                uuid.UUID  `json:"id"`
+	CondominiumID     uuid.UUID  `json:"condominium_id"`
+	CreatedBy         *uuid.UUID `json:"created_by"`
+	Title             string     `json:"title"`
+	Description       *string    `json:"description"`
+	ChoiceType        string     `json:"choice_type"`
+	IsAnonymous       bool       `json:"is_anonymous"`
+	IsWeighted        bool       `json:"is_weighted"`
+	ResultsVisibility string     `json:"results_visibility"`
+	OpensAt           time.Time  `json:"opens_at"`
+	ClosesAt          time.Time  `json:"closes_at"`
+	ClosedAt          *time.Time `json:"closed_at"`
+	OpenNotifiedAt    *time.Time `json:"open_notified_at"`
+	ClosingNotifiedAt *time.Time `json:"closing_notified_at"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         *time.Time `json:"updated_at"`
+}
+
+type PollBallot struct {
+	ID          uuid.UUID  `json:"id"`
+	PollID      uuid.UUID  `json:"poll_id"`
+	ApartmentID uuid.UUID  `json:"apartment_id"`
+	UserID      *uuid.UUID `json:"user_id"`
+	Weight      float64    `json:"weight"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+type PollBallotChoice struct {
+	BallotID uuid.UUID `json:"ballot_id"`
+	OptionID uuid.UUID `json:"option_id"`
+}
+
+type PollOption struct {
+	ID       uuid.UUID `json:"id"`
+	PollID   uuid.UUID `json:"poll_id"`
+	Label    string    `json:"label"`
+	Position int32     `json:"position"`
 }
 
 type Resident struct {
@@ -189,6 +365,18 @@ type UserDevice struct {
 	CreatedAt  time.Time `json:"created_at"`
 }
 
+type Vehicle struct {
+	ID            uuid.UUID  `json:"id"`
+	CondominiumID uuid.UUID  `json:"condominium_id"`
+	ApartmentID   uuid.UUID  `json:"apartment_id"`
+	Plate         string     `json:"plate"`
+	Model         string     `json:"model"`
+	Color         string     `json:"color"`
+	CreatedBy     uuid.UUID  `json:"created_by"`
+	RemovedAt     *time.Time `json:"removed_at"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
 type Verification struct {
 	ID         uuid.UUID  `json:"id"`
 	Identifier string     `json:"identifier"`
@@ -197,3 +385,18 @@ type Verification struct {
 	CreatedAt  time.Time  `json:"created_at"`
 	UpdatedAt  *time.Time `json:"updated_at"`
 }
+
+type VisitorRequest struct {
+	ID              uuid.UUID  `json:"id"`
+	CondominiumID   uuid.UUID  `json:"condominium_id"`
+	ApartmentID     uuid.UUID  `json:"apartment_id"`
+	VisitorName     string     `json:"visitor_name"`
+	VisitorDocument *string    `json:"visitor_document"`
+	PhotoKey        *string    `json:"photo_key"`
+	Status          string     `json:"status"`
+	RequestedBy     uuid.UUID  `json:"requested_by"`
+	DecidedBy       *uuid.UUID `json:"decided_by"`
+	DecidedAt       *time.Time `json:"decided_at"`
+	ExpiresAt       time.Time  `json:"expires_at"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
@@ -19,6 +19,13 @@ INSERT INTO packages (
   received_by,
   recipient_name,
   photo_url,
+  pickup_code,
+  carrier,
+  tracking_code,
+  size_category,
+  is_perishable,
+  is_fragile,
+  storage_location,
   status
 ) VALUES (
   $1,
@@ -26,16 +33,30 @@ INSERT INTO packages (
   $3,
   $4,
   $5,
+  $6,
+  $7,
+  $8,
+  $9,
+  $10,
+  $11,
+  $12,
   'pending'
-) RETURNING id, condominium_id, apartment_id, received_by, received_at, recipient_name, photo_url, status, withdrawn_at, withdrawn_by
+) RETURNING id, condominium_id, apartment_id, received_by, received_at, recipient_name, photo_url, status, withdrawn_at, withdrawn_by, photo_key, thumbnail_key, pickup_code, pickup_attempts, pickup_locked_at, desk_release_until, desk_released_by, reminders_sent, last_reminder_at, overdue_notified_at, returned_at, returned_by, carrier, tracking_code, size_category, is_perishable, is_fragile, storage_location, collected_by_name, collected_by_document, pickup_authorization_id
 `
 
 type CreatePackageParams struct {
-	CondominiumID uuid.UUID `json:"condominium_id"`
-	ApartmentID   uuid.UUID `json:"apartment_id"`
-	ReceivedBy    uuid.UUID `json:"received_by"`
-	RecipientName *string   `json:"recipient_name"`
-	PhotoUrl      *string   `json:"photo_url"`
+	CondominiumID   uuid.UUID `json:"condominium_id"`
+	ApartmentID     uuid.UUID `json:"apartment_id"`
+	ReceivedBy      uuid.UUID `json:"received_by"`
+	RecipientName   *string   `json:"recipient_name"`
+	PhotoUrl        *string   `json:"photo_url"`
+	PickupCode      *string   `json:"pickup_code"`
+	Carrier         *string   `json:"carrier"`
+	TrackingCode    *string   `json:"tracking_code"`
+	SizeCategory    *string   `json:"size_category"`
+	IsPerishable    bool      `json:"is_perishable"`
+	IsFragile       bool      `json:"is_fragile"`
+	StorageLocation *string   `json:"storage_location"`
 }
 
 func (q *Queries) CreatePackage(ctx context.Context, arg CreatePackageParams) (Package, error) {
@@ -45,6 +66,13 @@ func (q *Queries) CreatePackage(ctx context.Context, arg CreatePackageParams) (P
 		arg.ReceivedBy,
 		arg.RecipientName,
 		arg.PhotoUrl,
+		arg.PickupCode,
+		arg.Carrier,
+		arg.TrackingCode,
+		arg.SizeCategory,
+		arg.IsPerishable,
+		arg.IsFragile,
+		arg.StorageLocation,
 	)
 	var i Package
 	err := row.Scan(
@@ -58,13 +86,34 @@ func (q *Queries) CreatePackage(ctx context.Context, arg CreatePackageParams) (P
 		&i.Status,
 		&i.WithdrawnAt,
 		&i.WithdrawnBy,
+		&i.PhotoKey,
+		&i.ThumbnailKey,
+		&i.PickupCode,
+		&i.PickupAttempts,
+		&i.PickupLockedAt,
+		&i.DeskReleaseUntil,
+		&i.DeskReleasedBy,
+		&i.RemindersSent,
+		&i.LastReminderAt,
+		&i.OverdueNotifiedAt,
+		&i.ReturnedAt,
+		&i.ReturnedBy,
+		&i.Carrier,
+		&i.TrackingCode,
+		&i.SizeCategory,
+		&i.IsPerishable,
+		&i.IsFragile,
+		&i.StorageLocation,
+		&i.CollectedByName,
+		&i.CollectedByDocument,
+		&i.PickupAuthorizationID,
 	)
 	return i, err
 }
 
 const getPackageById = `-- name: GetPackageById :one
 SELECT
-  p.id, p.condominium_id, p.apartment_id, p.received_by, p.received_at, p.recipient_name, p.photo_url, p.status, p.withdrawn_at, p.withdrawn_by,
+  p.id, p.condominium_id, p.apartment_id, p.received_by, p.received_at, p.recipient_name, p.photo_url, p.status, p.withdrawn_at, p.withdrawn_by, p.photo_key, p.thumbnail_key, p.pickup_code, p.pickup_attempts, p.pickup_locked_at, p.desk_release_until, p.desk_released_by, p.reminders_sent, p.last_reminder_at, p.overdue_notified_at, p.returned_at, p.returned_by, p.carrier, p.tracking_code, p.size_category, p.is_perishable, p.is_fragile, p.storage_location, p.collected_by_name, p.collected_by_document, p.pickup_authorization_id,
   a.block,
   a.number AS apartment_number,
   u.name AS received_by_name
@@ -75,19 +124,40 @@ WHERE p.id = $1
 `
 
 type GetPackageByIdRow struct {
-	ID              uuid.UUID  `json:"id"`
-	CondominiumID   uuid.UUID  `json:"condominium_id"`
-	ApartmentID     uuid.UUID  `json:"apartment_id"`
-	ReceivedBy      uuid.UUID  `json:"received_by"`
-	ReceivedAt      time.Time  `json:"received_at"`
-	RecipientName   *string    `json:"recipient_name"`
-	PhotoUrl        *string    `json:"photo_url"`
-	Status          string     `json:"status"`
-	WithdrawnAt     *time.Time `json:"withdrawn_at"`
-	WithdrawnBy     *uuid.UUID `json:"withdrawn_by"`
-	Block           *string    `json:"block"`
-	ApartmentNumber string     `json:"apartment_number"`
-	ReceivedByName  string     `json:"received_by_name"`
+	ID                    uuid.UUID  `json:"id"`
+	CondominiumID         uuid.UUID  `json:"condominium_id"`
+	ApartmentID           uuid.UUID  `json:"apartment_id"`
+	ReceivedBy            uuid.UUID  `json:"received_by"`
+	ReceivedAt            time.Time  `json:"received_at"`
+	RecipientName         *string    `json:"recipient_name"`
+	PhotoUrl              *string    `json:"photo_url"`
+	Status                string     `json:"status"`
+	WithdrawnAt           *time.Time `json:"withdrawn_at"`
+	WithdrawnBy           *uuid.UUID `json:"withdrawn_by"`
+	PhotoKey              *string    `json:"photo_key"`
+	ThumbnailKey          *string    `json:"thumbnail_key"`
+	PickupCode            *string    `json:"pickup_code"`
+	PickupAttempts        int32      `json:"pickup_attempts"`
+	PickupLockedAt        *time.Time `json:"pickup_locked_at"`
+	DeskReleaseUntil      *time.Time `json:"desk_release_until"`
+	DeskReleasedBy        *uuid.UUID `json:"desk_released_by"`
+	RemindersSent         int32      `json:"reminders_sent"`
+	LastReminderAt        *time.Time `json:"last_reminder_at"`
+	OverdueNotifiedAt     *time.Time `json:"overdue_notified_at"`
+	ReturnedAt            *time.Time `json:"returned_at"`
+	ReturnedBy            *uuid.UUID `json:"returned_by"`
+	Carrier               *string    `json:"carrier"`
+	TrackingCode          *string    `json:"tracking_code"`
+	SizeCategory          *string    `json:"size_category"`
+	IsPerishable          bool       `json:"is_perishable"`
+	IsFragile             bool       `json:"is_fragile"`
+	StorageLocation       *string    `json:"storage_location"`
+	CollectedByName       *string    `json:"collected_by_name"`
+	CollectedByDocument   *string    `json:"collected_by_document"`
+	PickupAuthorizationID *uuid.UUID `json:"pickup_authorization_id"`
+	Block                 *string    `json:"block"`
+	ApartmentNumber       string     `json:"apartment_number"`
+	ReceivedByName        string     `json:"received_by_name"`
 }
 
 func (q *Queries) GetPackageById(ctx context.Context, id uuid.UUID) (GetPackageByIdRow, error) {
@@ -104,6 +174,27 @@ func (q *Queries) GetPackageById(ctx context.Context, id uuid.UUID) (GetPackageB
 		&i.Status,
 		&i.WithdrawnAt,
 		&i.WithdrawnBy,
+		&i.PhotoKey,
+		&i.ThumbnailKey,
+		&i.PickupCode,
+		&i.PickupAttempts,
+		&i.PickupLockedAt,
+		&i.DeskReleaseUntil,
+		&i.DeskReleasedBy,
+		&i.RemindersSent,
+		&i.LastReminderAt,
+		&i.OverdueNotifiedAt,
+		&i.ReturnedAt,
+		&i.ReturnedBy,
+		&i.Carrier,
+		&i.TrackingCode,
+		&i.SizeCategory,
+		&i.IsPerishable,
+		&i.IsFragile,
+		&i.StorageLocation,
+		&i.CollectedByName,
+		&i.CollectedByDocument,
+		&i.PickupAuthorizationID,
 		&i.Block,
 		&i.ApartmentNumber,
 		&i.ReceivedByName,
@@ -111,6 +202,55 @@ func (q *Queries) GetPackageById(ctx context.Context, id uuid.UUID) (GetPackageB
 	return i, err
 }
 
+const listOverduePackages = `-- name: ListOverduePackages :many
+SELECT
+  p.id,
+  p.condominium_id,
+  p.received_at,
+  a.block,
+  a.number AS apartment_number
+FROM packages p
+JOIN apartments a ON a.id = p.apartment_id
+WHERE p.status = 'pending'
+  AND p.overdue_notified_at IS NULL
+  AND p.received_at <= NOW() - make_interval(days => $1::int)
+ORDER BY p.condominium_id, p.received_at
+`
+
+type ListOverduePackagesRow struct {
+	ID              uuid.UUID `json:"id"`
+	CondominiumID   uuid.UUID `json:"condominium_id"`
+	ReceivedAt      time.Time `json:"received_at"`
+	Block           *string   `json:"block"`
+	ApartmentNumber string    `json:"apartment_number"`
+}
+
+func (q *Queries) ListOverduePackages(ctx context.Context, storageLimitDays int32) ([]ListOverduePackagesRow, error) {
+	rows, err := q.db.Query(ctx, listOverduePackages, storageLimitDays)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOverduePackagesRow
+	for rows.Next() {
+		var i ListOverduePackagesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CondominiumID,
+			&i.ReceivedAt,
+			&i.Block,
+			&i.ApartmentNumber,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listPackagesByApartment = `-- name: ListPackagesByApartment :many
 SELECT
   p.id,
@@ -120,17 +260,25 @@ SELECT
   p.photo_url,
   u.name as received_by_name,
   p.withdrawn_at,
-  p.withdrawn_by
+  p.withdrawn_by,
+  p.pickup_code,
+  p.carrier,
+  p.tracking_code,
+  p.size_category,
+  floor(EXTRACT(EPOCH FROM (COALESCE(p.withdrawn_at, p.returned_at, NOW()) - p.received_at)) / 86400)::int AS days_waiting
 FROM packages p
 JOIN users u ON u.id = p.received_by
 WHERE p.apartment_id = $1
   AND ($2::text IS NULL OR p.status = $2::text)
-ORDER BY p.received_at DESC
+ORDER BY
+  CASE WHEN $3::text = 'days_waiting' THEN COALESCE(p.withdrawn_at, p.returned_at, NOW()) - p.received_at END DESC,
+  p.received_at DESC
 `
 
 type ListPackagesByApartmentParams struct {
 	ApartmentID uuid.UUID `json:"apartment_id"`
 	Status      *string   `json:"status"`
+	Sort        *string   `json:"sort"`
 }
 
 type ListPackagesByApartmentRow struct {
@@ -142,10 +290,15 @@ type ListPackagesByApartmentRow struct {
 	ReceivedByName string     `json:"received_by_name"`
 	WithdrawnAt    *time.Time `json:"withdrawn_at"`
 	WithdrawnBy    *uuid.UUID `json:"withdrawn_by"`
+	PickupCode     *string    `json:"pickup_code"`
+	Carrier        *string    `json:"carrier"`
+	TrackingCode   *string    `json:"tracking_code"`
+	SizeCategory   *string    `json:"size_category"`
+	DaysWaiting    int32      `json:"days_waiting"`
 }
 
 func (q *Queries) ListPackagesByApartment(ctx context.Context, arg ListPackagesByApartmentParams) ([]ListPackagesByApartmentRow, error) {
-	rows, err := q.db.Query(ctx, listPackagesByApartment, arg.ApartmentID, arg.Status)
+	rows, err := q.db.Query(ctx, listPackagesByApartment, arg.ApartmentID, arg.Status, arg.Sort)
 	if err != nil {
 		return nil, err
 	}
@@ -162,6 +315,11 @@ func (q *Queries) ListPackagesByApartment(ctx context.Context, arg ListPackagesB
 			&i.ReceivedByName,
 			&i.WithdrawnAt,
 			&i.WithdrawnBy,
+			&i.PickupCode,
+			&i.Carrier,
+			&i.TrackingCode,
+			&i.SizeCategory,
+			&i.DaysWaiting,
 		); err != nil {
 			return nil, err
 		}
@@ -182,17 +340,39 @@ SELECT
   a.block,
   a.number as apartment_number,
   p.withdrawn_at,
-  p.withdrawn_by
+  p.withdrawn_by,
+  p.carrier,
+  p.tracking_code,
+  p.size_category,
+  p.is_perishable,
+  p.is_fragile,
+  p.storage_location,
+  floor(EXTRACT(EPOCH FROM (COALESCE(p.withdrawn_at, p.returned_at, NOW()) - p.received_at)) / 86400)::int AS days_waiting
 FROM packages p
 JOIN apartments a ON a.id = p.apartment_id
 WHERE p.condominium_id = $1
   AND ($2::text IS NULL OR p.status = $2::text)
-ORDER BY p.received_at DESC
+  AND ($3::text IS NULL OR p.carrier ILIKE $3::text)
+  AND ($4::text IS NULL OR p.tracking_code LIKE '%' || replace(replace(replace($4::text, '\', '\\'), '%', '\%'), '_', '\_') || '%' ESCAPE '\')
+  AND ($5::text IS NULL OR p.size_category = $5::text)
+  AND ($6::boolean IS NULL OR p.is_perishable = $6::boolean)
+  AND ($7::boolean IS NULL OR p.is_fragile = $7::boolean)
+  AND ($8::text IS NULL OR p.storage_location ILIKE $8::text)
+ORDER BY
+  CASE WHEN $9::text = 'days_waiting' THEN COALESCE(p.withdrawn_at, p.returned_at, NOW()) - p.received_at END DESC,
+  p.received_at DESC
 `
 
 type ListPackagesByCondominiumParams struct {
-	CondominiumID uuid.UUID `json:"condominium_id"`
-	Status        *string   `json:"status"`
+	CondominiumID   uuid.UUID `json:"condominium_id"`
+	Status          *string   `json:"status"`
+	Carrier         *string   `json:"carrier"`
+	TrackingCode    *string   `json:"tracking_code"`
+	SizeCategory    *string   `json:"size_category"`
+	IsPerishable    *bool     `json:"is_perishable"`
+	IsFragile       *bool     `json:"is_fragile"`
+	StorageLocation *string   `json:"storage_location"`
+	Sort            *string   `json:"sort"`
 }
 
 type ListPackagesByCondominiumRow struct {
@@ -204,10 +384,27 @@ type ListPackagesByCondominiumRow struct {
 	ApartmentNumber string     `json:"apartment_number"`
 	WithdrawnAt     *time.Time `json:"withdrawn_at"`
 	WithdrawnBy     *uuid.UUID `json:"withdrawn_by"`
+	Carrier         *string    `json:"carrier"`
+	TrackingCode    *string    `json:"tracking_code"`
+	SizeCategory    *string    `json:"size_category"`
+	IsPerishable    bool       `json:"is_perishable"`
+	IsFragile       bool       `json:"is_fragile"`
+	StorageLocation *string    `json:"storage_location"`
+	DaysWaiting     int32      `json:"days_waiting"`
 }
 
 func (q *Queries) ListPackagesByCondominium(ctx context.Context, arg ListPackagesByCondominiumParams) ([]ListPackagesByCondominiumRow, error) {
-	rows, err := q.db.Query(ctx, listPackagesByCondominium, arg.CondominiumID, arg.Status)
+	rows, err := q.db.Query(ctx, listPackagesByCondominium,
+		arg.CondominiumID,
+		arg.Status,
+		arg.Carrier,
+		arg.TrackingCode,
+		arg.SizeCategory,
+		arg.IsPerishable,
+		arg.IsFragile,
+		arg.StorageLocation,
+		arg.Sort,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -224,6 +421,103 @@ func (q *Queries) ListPackagesByCondominium(ctx context.Context, arg ListPackage
 			&i.ApartmentNumber,
 			&i.WithdrawnAt,
 			&i.WithdrawnBy,
+			&i.Carrier,
+			&i.TrackingCode,
+			&i.SizeCategory,
+			&i.IsPerishable,
+			&i.IsFragile,
+			&i.StorageLocation,
+			&i.DaysWaiting,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPackagesByTrackingCode = `-- name: ListPackagesByTrackingCode :many
+SELECT
+  p.id,
+  p.status,
+  p.received_at
+FROM packages p
+WHERE p.condominium_id = $1
+  AND p.tracking_code = $2
+ORDER BY p.received_at DESC
+`
+
+type ListPackagesByTrackingCodeParams struct {
+	CondominiumID uuid.UUID `json:"condominium_id"`
+	TrackingCode  *string   `json:"tracking_code"`
+}
+
+type ListPackagesByTrackingCodeRow struct {
+	ID         uuid.UUID `json:"id"`
+	Status     string    `json:"status"`
+	ReceivedAt time.Time `json:"received_at"`
+}
+
+func (q *Queries) ListPackagesByTrackingCode(ctx context.Context, arg ListPackagesByTrackingCodeParams) ([]ListPackagesByTrackingCodeRow, error) {
+	rows, err := q.db.Query(ctx, listPackagesByTrackingCode, arg.CondominiumID, arg.TrackingCode)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPackagesByTrackingCodeRow
+	for rows.Next() {
+		var i ListPackagesByTrackingCodeRow
+		if err := rows.Scan(&i.ID, &i.Status, &i.ReceivedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPackagesDueForReminder = `-- name: ListPackagesDueForReminder :many
+SELECT
+  p.id,
+  p.apartment_id,
+  p.recipient_name,
+  p.received_at,
+  p.reminders_sent
+FROM packages p
+WHERE p.status = 'pending'
+  AND p.reminders_sent < cardinality($1::int[])
+  AND p.received_at <= NOW() - make_interval(days => ($1::int[])[p.reminders_sent + 1])
+ORDER BY p.received_at
+`
+
+type ListPackagesDueForReminderRow struct {
+	ID            uuid.UUID `json:"id"`
+	ApartmentID   uuid.UUID `json:"apartment_id"`
+	RecipientName *string   `json:"recipient_name"`
+	ReceivedAt    time.Time `json:"received_at"`
+	RemindersSent int32     `json:"reminders_sent"`
+}
+
+func (q *Queries) ListPackagesDueForReminder(ctx context.Context, reminderDays []int32) ([]ListPackagesDueForReminderRow, error) {
+	rows, err := q.db.Query(ctx, listPackagesDueForReminder, reminderDays)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPackagesDueForReminderRow
+	for rows.Next() {
+		var i ListPackagesDueForReminderRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ApartmentID,
+			&i.RecipientName,
+			&i.ReceivedAt,
+			&i.RemindersSent,
 		); err != nil {
 			return nil, err
 		}
@@ -235,22 +529,234 @@ func (q *Queries) ListPackagesByCondominium(ctx context.Context, arg ListPackage
 	return items, nil
 }
 
-const updatePackageToWithdrawn = `-- name: UpdatePackageToWithdrawn :exec
+const markPackageReminderSent = `-- name: MarkPackageReminderSent :exec
+UPDATE packages
+SET
+  reminders_sent = $2,
+  last_reminder_at = NOW()
+WHERE id = $1
+`
+
+type MarkPackageReminderSentParams struct {
+	ID            uuid.UUID `json:"id"`
+	RemindersSent int32     `json:"reminders_sent"`
+}
+
+func (q *Queries) MarkPackageReminderSent(ctx context.Context, arg MarkPackageReminderSentParams) error {
+	_, err := q.db.Exec(ctx, markPackageReminderSent, arg.ID, arg.RemindersSent)
+	return err
+}
+
+const markPackagesOverdueNotified = `-- name: MarkPackagesOverdueNotified :exec
+UPDATE packages
+SET overdue_notified_at = NOW()
+WHERE id = ANY($1::uuid[])
+`
+
+func (q *Queries) MarkPackagesOverdueNotified(ctx context.Context, ids []uuid.UUID) error {
+	_, err := q.db.Exec(ctx, markPackagesOverdueNotified, ids)
+	return err
+}
+
+const registerPackagePickupAttempt = `-- name: RegisterPackagePickupAttempt :one
+UPDATE packages
+SET
+  pickup_attempts = pickup_attempts + 1,
+  pickup_locked_at = CASE
+    WHEN pickup_attempts + 1 >= $2::int THEN NOW()
+    ELSE pickup_locked_at
+  END
+WHERE id = $1
+RETURNING pickup_attempts
+`
+
+type RegisterPackagePickupAttemptParams struct {
+	ID          uuid.UUID `json:"id"`
+	MaxAttempts int32     `json:"max_attempts"`
+}
+
+func (q *Queries) RegisterPackagePickupAttempt(ctx context.Context, arg RegisterPackagePickupAttemptParams) (int32, error) {
+	row := q.db.QueryRow(ctx, registerPackagePickupAttempt, arg.ID, arg.MaxAttempts)
+	var pickup_attempts int32
+	err := row.Scan(&pickup_attempts)
+	return pickup_attempts, err
+}
+
+const releasePackageAtDesk = `-- name: ReleasePackageAtDesk :exec
+UPDATE packages
+SET
+  desk_release_until = $2,
+  desk_released_by = $3
+WHERE id = $1
+`
+
+type ReleasePackageAtDeskParams struct {
+	ID               uuid.UUID  `json:"id"`
+	DeskReleaseUntil *time.Time `json:"desk_release_until"`
+	DeskReleasedBy   *uuid.UUID `json:"desk_released_by"`
+}
+
+func (q *Queries) ReleasePackageAtDesk(ctx context.Context, arg ReleasePackageAtDeskParams) error {
+	_, err := q.db.Exec(ctx, releasePackageAtDesk, arg.ID, arg.DeskReleaseUntil, arg.DeskReleasedBy)
+	return err
+}
+
+const resetPackagePickupCode = `-- name: ResetPackagePickupCode :exec
+UPDATE packages
+SET
+  pickup_code = $2,
+  pickup_attempts = 0,
+  pickup_locked_at = NULL
+WHERE id = $1
+`
+
+type ResetPackagePickupCodeParams struct {
+	ID         uuid.UUID `json:"id"`
+	PickupCode *string   `json:"pickup_code"`
+}
+
+func (q *Queries) ResetPackagePickupCode(ctx context.Context, arg ResetPackagePickupCodeParams) error {
+	_, err := q.db.Exec(ctx, resetPackagePickupCode, arg.ID, arg.PickupCode)
+	return err
+}
+
+const updatePackagePhoto = `-- name: UpdatePackagePhoto :exec
+UPDATE packages
+SET
+  photo_key = $2,
+  thumbnail_key = $3
+WHERE id = $1
+`
+
+type UpdatePackagePhotoParams struct {
+	ID           uuid.UUID `json:"id"`
+	PhotoKey     *string   `json:"photo_key"`
+	ThumbnailKey *string   `json:"thumbnail_key"`
+}
+
+func (q *Queries) UpdatePackagePhoto(ctx context.Context, arg UpdatePackagePhotoParams) error {
+	_, err := q.db.Exec(ctx, updatePackagePhoto, arg.ID, arg.PhotoKey, arg.ThumbnailKey)
+	return err
+}
+
+const updatePackageToReturned = `-- name: UpdatePackageToReturned :one
+UPDATE packages
+SET
+  status = 'returned_to_sender',
+  returned_at = NOW(),
+  returned_by = $2
+WHERE id = $1
+  AND status = 'pending'
+RETURNING id, condominium_id, apartment_id, received_by, received_at, recipient_name, photo_url, status, withdrawn_at, withdrawn_by, photo_key, thumbnail_key, pickup_code, pickup_attempts, pickup_locked_at, desk_release_until, desk_released_by, reminders_sent, last_reminder_at, overdue_notified_at, returned_at, returned_by, carrier, tracking_code, size_category, is_perishable, is_fragile, storage_location, collected_by_name, collected_by_document, pickup_authorization_id
+`
+
+type UpdatePackageToReturnedParams struct {
+	ID         uuid.UUID  `json:"id"`
+	ReturnedBy *uuid.UUID `json:"returned_by"`
+}
+
+func (q *Queries) UpdatePackageToReturned(ctx context.Context, arg UpdatePackageToReturnedParams) (Package, error) {
+	row := q.db.QueryRow(ctx, updatePackageToReturned, arg.ID, arg.ReturnedBy)
+	var i Package
+	err := row.Scan(
+		&i.ID,
+		&i.CondominiumID,
+		&i.ApartmentID,
+		&i.ReceivedBy,
+		&i.ReceivedAt,
+		&i.RecipientName,
+		&i.PhotoUrl,
+		&i.Status,
+		&i.WithdrawnAt,
+		&i.WithdrawnBy,
+		&i.PhotoKey,
+		&i.ThumbnailKey,
+		&i.PickupCode,
+		&i.PickupAttempts,
+		&i.PickupLockedAt,
+		&i.DeskReleaseUntil,
+		&i.DeskReleasedBy,
+		&i.RemindersSent,
+		&i.LastReminderAt,
+		&i.OverdueNotifiedAt,
+		&i.ReturnedAt,
+		&i.ReturnedBy,
+		&i.Carrier,
+		&i.TrackingCode,
+		&i.SizeCategory,
+		&i.IsPerishable,
+		&i.IsFragile,
+		&i.StorageLocation,
+		&i.CollectedByName,
+		&i.CollectedByDocument,
+		&i.PickupAuthorizationID,
+	)
+	return i, err
+}
+
+const updatePackageToWithdrawn = `-- name: UpdatePackageToWithdrawn :one
 UPDATE packages
 SET
   status = 'withdrawn',
   withdrawn_at = NOW(),
-  withdrawn_by = $2
+  withdrawn_by = $2,
+  collected_by_name = $3,
+  collected_by_document = $4,
+  pickup_authorization_id = $5
 WHERE id = $1
-RETURNING id, condominium_id, apartment_id, received_by, received_at, recipient_name, photo_url, status, withdrawn_at, withdrawn_by
+  AND status = 'pending'
+RETURNING id, condominium_id, apartment_id, received_by, received_at, recipient_name, photo_url, status, withdrawn_at, withdrawn_by, photo_key, thumbnail_key, pickup_code, pickup_attempts, pickup_locked_at, desk_release_until, desk_released_by, reminders_sent, last_reminder_at, overdue_notified_at, returned_at, returned_by, carrier, tracking_code, size_category, is_perishable, is_fragile, storage_location, collected_by_name, collected_by_document, pickup_authorization_id
 `
 
 type UpdatePackageToWithdrawnParams struct {
-	ID          uuid.UUID  `json:"id"`
-	WithdrawnBy *uuid.UUID `json:"withdrawn_by"`
+	ID                    uuid.UUID  `json:"id"`
+	WithdrawnBy           *uuid.UUID `json:"withdrawn_by"`
+	CollectedByName       *string    `json:"collected_by_name"`
+	CollectedByDocument   *string    `json:"collected_by_document"`
+	PickupAuthorizationID *uuid.UUID `json:"pickup_authorization_id"`
 }
 
-func (q *Queries) UpdatePackageToWithdrawn(ctx context.Context, arg UpdatePackageToWithdrawnParams) error {
-	_, err := q.db.Exec(ctx, updatePackageToWithdrawn, arg.ID, arg.WithdrawnBy)
-	return err
+func (q *Queries) UpdatePackageToWithdrawn(ctx context.Context, arg UpdatePackageToWithdrawnParams) (Package, error) {
+	row := q.db.QueryRow(ctx, updatePackageToWithdrawn,
+		arg.ID,
+		arg.WithdrawnBy,
+		arg.CollectedByName,
+		arg.CollectedByDocument,
+		arg.PickupAuthorizationID,
+	)
+	var i Package
+	err := row.Scan(
+		&i.ID,
+		&i.CondominiumID,
+		&i.ApartmentID,
+		&i.ReceivedBy,
+		&i.ReceivedAt,
+		&i.RecipientName,
+		&i.PhotoUrl,
+		&i.Status,
+		&i.WithdrawnAt,
+		&i.WithdrawnBy,
+		&i.PhotoKey,
+		&i.ThumbnailKey,
+		&i.PickupCode,
+		&i.PickupAttempts,
+		&i.PickupLockedAt,
+		&i.DeskReleaseUntil,
+		&i.DeskReleasedBy,
+		&i.RemindersSent,
+		&i.LastReminderAt,
+		&i.OverdueNotifiedAt,
+		&i.ReturnedAt,
+		&i.ReturnedBy,
+		&i.Carrier,
+		&i.TrackingCode,
+		&i.SizeCategory,
+		&i.IsPerishable,
+		&i.IsFragile,
+		&i.StorageLocation,
+		&i.CollectedByName,
+		&i.CollectedByDocument,
+		&i.PickupAuthorizationID,
+	)
+	return i, err
 }
@@ -6,30 +6,64 @@ package pgstore
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
+	AcceptWaitlistOffer(ctx context.Context, id uuid.UUID) (BookingWaitlistEntry, error)
+	ArchiveCommonArea(ctx context.Context, id uuid.UUID) (CommonArea, error)
+	CancelBooking(ctx context.Context, arg CancelBookingParams) (Booking, error)
+	CancelOpenBills(ctx context.Context, ids []uuid.UUID) error
+	CancelWaitlistEntriesInRange(ctx context.Context, arg CancelWaitlistEntriesInRangeParams) ([]BookingWaitlistEntry, error)
+	CancelWaitlistEntry(ctx context.Context, id uuid.UUID) (BookingWaitlistEntry, error)
+	CheckBlackoutConflict(ctx context.Context, arg CheckBlackoutConflictParams) (bool, error)
 	CheckBookingConflict(ctx context.Context, arg CheckBookingConflictParams) (bool, error)
 	CheckIsResident(ctx context.Context, arg CheckIsResidentParams) (bool, error)
 	CheckUserAccessToCondo(ctx context.Context, arg CheckUserAccessToCondoParams) (bool, error)
+	ClosePoll(ctx context.Context, id uuid.UUID) (Poll, error)
+	CountApartmentBookingsInRange(ctx context.Context, arg CountApartmentBookingsInRangeParams) (int32, error)
+	CountInviteEntries(ctx context.Context, inviteID *uuid.UUID) (int32, error)
+	CountPollBallots(ctx context.Context, pollID uuid.UUID) (int64, error)
 	CreateAccessRequest(ctx context.Context, arg CreateAccessRequestParams) (uuid.UUID, error)
 	CreateAccountWithCredentials(ctx context.Context, arg CreateAccountWithCredentialsParams) error
 	CreateAnnouncement(ctx context.Context, arg CreateAnnouncementParams) (CreateAnnouncementRow, error)
 	CreateApartment(ctx context.Context, arg CreateApartmentParams) (uuid.UUID, error)
 	CreateBill(ctx context.Context, arg CreateBillParams) (Bill, error)
+	CreateBlockedVisitor(ctx context.Context, arg CreateBlockedVisitorParams) (BlockedVisitor, error)
 	CreateBooking(ctx context.Context, arg CreateBookingParams) (Booking, error)
+	CreateCalendarFeed(ctx context.Context, arg CreateCalendarFeedParams) (CalendarFeed, error)
 	CreateCommonArea(ctx context.Context, arg CreateCommonAreaParams) (CommonArea, error)
+	CreateCommonAreaBlackout(ctx context.Context, arg CreateCommonAreaBlackoutParams) (CommonAreaBlackout, error)
 	CreateCondominium(ctx context.Context, arg CreateCondominiumParams) (uuid.UUID, error)
 	CreateCondominiumMember(ctx context.Context, arg CreateCondominiumMemberParams) error
 	CreateInvite(ctx context.Context, arg CreateInviteParams) (Invite, error)
 	CreatePackage(ctx context.Context, arg CreatePackageParams) (Package, error)
+	CreatePackagePickupAuthorization(ctx context.Context, arg CreatePackagePickupAuthorizationParams) (PackagePickupAuthorization, error)
+	CreatePoll(ctx context.Context, arg CreatePollParams) (Poll, error)
+	CreatePollBallot(ctx context.Context, arg CreatePollBallotParams) (PollBallot, error)
+	CreatePollBallotChoice(ctx context.Context, arg CreatePollBallotChoiceParams) error
+	CreatePollOption(ctx context.Context, arg CreatePollOptionParams) (PollOption, error)
 	CreateResident(ctx context.Context, arg CreateResidentParams) error
 	CreateSession(ctx context.Context, arg CreateSessionParams) error
 	CreateUser(ctx context.Context, arg CreateUserParams) (uuid.UUID, error)
+	CreateVehicle(ctx context.Context, arg CreateVehicleParams) (Vehicle, error)
+	CreateVisitorRequest(ctx context.Context, arg CreateVisitorRequestParams) (VisitorRequest, error)
+	CreateWaitlistEntry(ctx context.Context, arg CreateWaitlistEntryParams) (BookingWaitlistEntry, error)
+	DecideVisitorRequest(ctx context.Context, arg DecideVisitorRequestParams) (VisitorRequest, error)
 	DeleteAnnouncement(ctx context.Context, arg DeleteAnnouncementParams) error
+	DeleteCommonAreaBlackout(ctx context.Context, arg DeleteCommonAreaBlackoutParams) (CommonAreaBlackout, error)
+	DeleteCommonAreaOpeningHours(ctx context.Context, commonAreaID uuid.UUID) error
 	DeleteSession(ctx context.Context, token string) error
+	DenyPendingBooking(ctx context.Context, id uuid.UUID) (Booking, error)
+	EscalatePendingBooking(ctx context.Context, id uuid.UUID) (Booking, error)
+	ExpireVisitorRequests(ctx context.Context) ([]VisitorRequest, error)
+	ExpireWaitlistEntries(ctx context.Context) ([]BookingWaitlistEntry, error)
+	FindActivePickupAuthorization(ctx context.Context, arg FindActivePickupAuthorizationParams) (PackagePickupAuthorization, error)
+	FindBlockedVisitors(ctx context.Context, arg FindBlockedVisitorsParams) ([]BlockedVisitor, error)
+	FindVehicleByPlate(ctx context.Context, arg FindVehicleByPlateParams) (FindVehicleByPlateRow, error)
+	GetAccessLogById(ctx context.Context, id uuid.UUID) (AccessLog, error)
 	GetAccessRequestById(ctx context.Context, id uuid.UUID) (AccessRequest, error)
 	GetAccountByUserId(ctx context.Context, userID uuid.UUID) (Account, error)
 	GetAnnouncementById(ctx context.Context, id uuid.UUID) (Announcement, error)
@@ -37,7 +71,11 @@ type Querier interface {
 	GetApartmentsByUserId(ctx context.Context, arg GetApartmentsByUserIdParams) ([]GetApartmentsByUserIdRow, error)
 	GetAreaAvailability(ctx context.Context, arg GetAreaAvailabilityParams) ([]GetAreaAvailabilityRow, error)
 	GetBillById(ctx context.Context, arg GetBillByIdParams) (Bill, error)
+	GetBlockedVisitorById(ctx context.Context, id uuid.UUID) (BlockedVisitor, error)
 	GetBookingById(ctx context.Context, id uuid.UUID) (GetBookingByIdRow, error)
+	GetCalendarFeedByToken(ctx context.Context, token uuid.UUID) (CalendarFeed, error)
+	GetCommonAreaById(ctx context.Context, id uuid.UUID) (CommonArea, error)
+	GetCommonAreaForShare(ctx context.Context, id uuid.UUID) (CommonArea, error)
 	GetCommonAreaIdForUpdate(ctx context.Context, id uuid.UUID) (CommonArea, error)
 	GetCondoAdminTokens(ctx context.Context, condominiumID uuid.UUID) ([]string, error)
 	GetCondoResidentsTokens(ctx context.Context, condominiumID uuid.UUID) ([]string, error)
@@ -49,30 +87,83 @@ type Querier interface {
 	GetManyAnnouncementsByCondoId(ctx context.Context, arg GetManyAnnouncementsByCondoIdParams) ([]GetManyAnnouncementsByCondoIdRow, error)
 	GetManyTokensByApartmentId(ctx context.Context, apartmentID uuid.UUID) ([]string, error)
 	GetPackageById(ctx context.Context, id uuid.UUID) (GetPackageByIdRow, error)
+	GetPackagePickupAuthorizationById(ctx context.Context, id uuid.UUID) (PackagePickupAuthorization, error)
+	GetPeakBookedHeadcount(ctx context.Context, arg GetPeakBookedHeadcountParams) (int32, error)
+	GetPollById(ctx context.Context, id uuid.UUID) (Poll, error)
+	GetPollResults(ctx context.Context, pollID uuid.UUID) ([]GetPollResultsRow, error)
 	GetResidencesByUserId(ctx context.Context, userID uuid.UUID) ([]GetResidencesByUserIdRow, error)
 	GetSessionByToken(ctx context.Context, token string) (Session, error)
 	GetUserByEmail(ctx context.Context, email string) (User, error)
 	GetUserByID(ctx context.Context, id uuid.UUID) (User, error)
 	GetUserDeviceTokens(ctx context.Context, userID uuid.UUID) ([]string, error)
 	GetUserMemberships(ctx context.Context, userID uuid.UUID) ([]GetUserMembershipsRow, error)
+	GetVehicleById(ctx context.Context, id uuid.UUID) (Vehicle, error)
+	GetVisitorRequestById(ctx context.Context, id uuid.UUID) (VisitorRequest, error)
+	GetWaitlistEntryById(ctx context.Context, id uuid.UUID) (GetWaitlistEntryByIdRow, error)
+	InsertCommonAreaOpeningHours(ctx context.Context, arg InsertCommonAreaOpeningHoursParams) error
+	ListAccessLogs(ctx context.Context, arg ListAccessLogsParams) ([]ListAccessLogsRow, error)
+	ListActiveAreaBookingsInRange(ctx context.Context, arg ListActiveAreaBookingsInRangeParams) ([]Booking, error)
+	ListActiveInvitesByPlate(ctx context.Context, arg ListActiveInvitesByPlateParams) ([]ListActiveInvitesByPlateRow, error)
 	ListBills(ctx context.Context, arg ListBillsParams) ([]Bill, error)
 	ListBillsByApartmentId(ctx context.Context, arg ListBillsByApartmentIdParams) ([]Bill, error)
 	ListBillsByCondominiumId(ctx context.Context, arg ListBillsByCondominiumIdParams) ([]Bill, error)
+	ListBlockedVisitors(ctx context.Context, condominiumID uuid.UUID) ([]BlockedVisitor, error)
 	ListBookings(ctx context.Context, arg ListBookingsParams) ([]ListBookingsRow, error)
+	ListCommonAreaBlackouts(ctx context.Context, arg ListCommonAreaBlackoutsParams) ([]CommonAreaBlackout, error)
+	ListCommonAreaOpeningHours(ctx context.Context, commonAreaID uuid.UUID) ([]CommonAreaOpeningHour, error)
 	ListCommonAreas(ctx context.Context, condominiumID uuid.UUID) ([]CommonArea, error)
+	ListCondominiumOpeningHours(ctx context.Context, condominiumID uuid.UUID) ([]CommonAreaOpeningHour, error)
 	ListCondominiunsByUserId(ctx context.Context, userID uuid.UUID) ([]ListCondominiunsByUserIdRow, error)
+	ListExpiredPendingBookings(ctx context.Context) ([]Booking, error)
 	ListInvites(ctx context.Context, arg ListInvitesParams) ([]ListInvitesRow, error)
+	ListOverduePackages(ctx context.Context, storageLimitDays int32) ([]ListOverduePackagesRow, error)
+	ListPackagePickupAuthorizations(ctx context.Context, arg ListPackagePickupAuthorizationsParams) ([]PackagePickupAuthorization, error)
 	ListPackagesByApartment(ctx context.Context, arg ListPackagesByApartmentParams) ([]ListPackagesByApartmentRow, error)
 	ListPackagesByCondominium(ctx context.Context, arg ListPackagesByCondominiumParams) ([]ListPackagesByCondominiumRow, error)
+	ListPackagesByTrackingCode(ctx context.Context, arg ListPackagesByTrackingCodeParams) ([]ListPackagesByTrackingCodeRow, error)
+	ListPackagesDueForReminder(ctx context.Context, reminderDays []int32) ([]ListPackagesDueForReminderRow, error)
 	ListPendingRequestsByCondo(ctx context.Context, condominiumID uuid.UUID) ([]ListPendingRequestsByCondoRow, error)
+	ListPeopleInside(ctx context.Context, arg ListPeopleInsideParams) ([]ListPeopleInsideRow, error)
+	ListPollOptions(ctx context.Context, pollID uuid.UUID) ([]PollOption, error)
+	ListPollVoters(ctx context.Context, pollID uuid.UUID) ([]ListPollVotersRow, error)
+	ListPollsByCondominium(ctx context.Context, arg ListPollsByCondominiumParams) ([]Poll, error)
+	ListPollsClosingSoon(ctx context.Context, closesBefore time.Time) ([]Poll, error)
+	ListPollsToAnnounceOpening(ctx context.Context) ([]Poll, error)
+	ListUserCalendarFeeds(ctx context.Context, arg ListUserCalendarFeedsParams) ([]CalendarFeed, error)
+	ListUserWaitlistEntries(ctx context.Context, arg ListUserWaitlistEntriesParams) ([]ListUserWaitlistEntriesRow, error)
+	ListVehiclesByApartment(ctx context.Context, apartmentID uuid.UUID) ([]Vehicle, error)
+	ListVisitorRequests(ctx context.Context, arg ListVisitorRequestsParams) ([]VisitorRequest, error)
+	ListWaitingEntriesInRange(ctx context.Context, arg ListWaitingEntriesInRangeParams) ([]BookingWaitlistEntry, error)
+	LockInviteForEntry(ctx context.Context, id uuid.UUID) (uuid.UUID, error)
 	LogAccessEntry(ctx context.Context, arg LogAccessEntryParams) (AccessLog, error)
+	LogOfflineAccessEntry(ctx context.Context, arg LogOfflineAccessEntryParams) (AccessLog, error)
+	MarkPackageReminderSent(ctx context.Context, arg MarkPackageReminderSentParams) error
+	MarkPackagesOverdueNotified(ctx context.Context, ids []uuid.UUID) error
+	MarkPollClosingNotified(ctx context.Context, id uuid.UUID) error
+	MarkPollOpenNotified(ctx context.Context, id uuid.UUID) error
+	OfferWaitlistEntry(ctx context.Context, arg OfferWaitlistEntryParams) (BookingWaitlistEntry, error)
+	RegisterAccessExit(ctx context.Context, arg RegisterAccessExitParams) (AccessLog, error)
+	RegisterPackagePickupAttempt(ctx context.Context, arg RegisterPackagePickupAttemptParams) (int32, error)
+	ReleaseBookingDeposit(ctx context.Context, arg ReleaseBookingDepositParams) (Booking, error)
+	ReleasePackageAtDesk(ctx context.Context, arg ReleasePackageAtDeskParams) error
+	RemoveBlockedVisitor(ctx context.Context, arg RemoveBlockedVisitorParams) error
+	RemoveVehicle(ctx context.Context, id uuid.UUID) error
+	ResetPackagePickupCode(ctx context.Context, arg ResetPackagePickupCodeParams) error
+	RevokeCalendarFeed(ctx context.Context, arg RevokeCalendarFeedParams) (CalendarFeed, error)
 	RevokeInvite(ctx context.Context, arg RevokeInviteParams) error
+	RevokePackagePickupAuthorization(ctx context.Context, id uuid.UUID) error
 	SaveUserDevice(ctx context.Context, arg SaveUserDeviceParams) error
+	SetBookingBills(ctx context.Context, arg SetBookingBillsParams) (Booking, error)
+	SetWaitlistEntryBooking(ctx context.Context, arg SetWaitlistEntryBookingParams) error
 	UpdateAccessRequestStatus(ctx context.Context, arg UpdateAccessRequestStatusParams) error
 	UpdateAnnouncement(ctx context.Context, arg UpdateAnnouncementParams) error
 	UpdateBillStatus(ctx context.Context, arg UpdateBillStatusParams) (Bill, error)
 	UpdateBookingStatus(ctx context.Context, arg UpdateBookingStatusParams) (Booking, error)
-	UpdatePackageToWithdrawn(ctx context.Context, arg UpdatePackageToWithdrawnParams) error
+	UpdateCommonArea(ctx context.Context, arg UpdateCommonAreaParams) (CommonArea, error)
+	UpdateCommonAreaRules(ctx context.Context, arg UpdateCommonAreaRulesParams) (CommonArea, error)
+	UpdatePackagePhoto(ctx context.Context, arg UpdatePackagePhotoParams) error
+	UpdatePackageToReturned(ctx context.Context, arg UpdatePackageToReturnedParams) (Package, error)
+	UpdatePackageToWithdrawn(ctx context.Context, arg UpdatePackageToWithdrawnParams) (Package, error)
 	UpdateRefreshToken(ctx context.Context, arg UpdateRefreshTokenParams) error
 }
 
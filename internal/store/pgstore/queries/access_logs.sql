@@ -2,9 +2,90 @@
 INSERT INTO access_logs (
   invite_id,
   condominium_id,
-  authorized_by
+  authorized_by,
+  apartment_id,
+  visitor_request_id,
+  vehicle_id,
+  plate
 ) VALUES (
   $1,
   $2,
-  $3
+  $3,
+  $4,
+  $5,
+  $6,
+  $7
 ) RETURNING *;
+
+-- name: GetAccessLogById :one
+SELECT
+  *
+FROM access_logs
+WHERE id = $1;
+
+-- name: ListAccessLogs :many
+SELECT
+  l.*,
+  COALESCE(i.guest_name, v.visitor_name, ve.model)::text AS guest_name,
+  (CASE WHEN l.invite_id IS NOT NULL THEN i.guest_type WHEN l.vehicle_id IS NOT NULL THEN 'resident' ELSE 'walk_in' END)::text AS guest_type,
+  a.block,
+  a.number AS apartment_number
+FROM access_logs l
+LEFT JOIN invites i ON i.id = l.invite_id
+LEFT JOIN visitor_requests v ON v.id = l.visitor_request_id
+LEFT JOIN vehicles ve ON ve.id = l.vehicle_id
+JOIN apartments a ON a.id = l.apartment_id
+WHERE l.condominium_id = $1
+  AND (sqlc.narg('apartment_id')::uuid IS NULL OR l.apartment_id = sqlc.narg('apartment_id'))
+  AND (sqlc.narg('invite_id')::uuid IS NULL OR l.invite_id = sqlc.narg('invite_id'))
+  AND (sqlc.narg('guest_type')::text IS NULL OR (CASE WHEN l.invite_id IS NOT NULL THEN i.guest_type WHEN l.vehicle_id IS NOT NULL THEN 'resident' ELSE 'walk_in' END) = sqlc.narg('guest_type'))
+  AND (sqlc.narg('from')::timestamptz IS NULL OR l.entered_at >= sqlc.narg('from'))
+  AND (sqlc.narg('to')::timestamptz IS NULL OR l.entered_at < sqlc.narg('to'))
+ORDER BY l.entered_at DESC
+LIMIT $2 OFFSET $3;
+
+-- name: ListPeopleInside :many
+SELECT
+  l.*,
+  COALESCE(i.guest_name, v.visitor_name, ve.model)::text AS guest_name,
+  (CASE WHEN l.invite_id IS NOT NULL THEN i.guest_type WHEN l.vehicle_id IS NOT NULL THEN 'resident' ELSE 'walk_in' END)::text AS guest_type,
+  a.block,
+  a.number AS apartment_number
+FROM access_logs l
+LEFT JOIN invites i ON i.id = l.invite_id
+LEFT JOIN visitor_requests v ON v.id = l.visitor_request_id
+LEFT JOIN vehicles ve ON ve.id = l.vehicle_id
+JOIN apartments a ON a.id = l.apartment_id
+WHERE l.condominium_id = $1
+  AND l.exited_at IS NULL
+  AND l.vehicle_id IS NULL
+  AND l.entered_at >= $2
+ORDER BY a.block, a.number, l.entered_at;
+
+-- name: RegisterAccessExit :one
+UPDATE access_logs
+SET exited_at = NOW(),
+    exit_registered_by = $2
+WHERE id = $1 AND exited_at IS NULL
+RETURNING *;
+
+-- name: LogOfflineAccessEntry :one
+INSERT INTO access_logs (
+  offline_entry_id,
+  invite_id,
+  condominium_id,
+  authorized_by,
+  apartment_id,
+  entered_at,
+  synced_at
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  NOW()
+)
+ON CONFLICT (offline_entry_id) DO NOTHING
+RETURNING *;
@@ -2,11 +2,13 @@
 INSERT INTO apartments (
   condominium_id,
   block,
-  number
+  number,
+  voting_weight
 ) VALUES (
   $1,
   $2,
-  $3
+  $3,
+  COALESCE(sqlc.narg('voting_weight')::float8, 1)
 ) RETURNING id;
 
 -- name: GetApartmentById :one
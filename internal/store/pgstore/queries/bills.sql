@@ -19,6 +19,14 @@ INSERT INTO bills (
   'pending'
 ) RETURNING *;
 
+-- name: CancelOpenBills :exec
+UPDATE bills
+SET
+  status = 'cancelled',
+  updated_at = NOW()
+WHERE id = ANY(sqlc.arg('ids')::uuid[])
+  AND status IN ('pending', 'overdue');
+
 -- name: GetBillById :one
 SELECT
   *
@@ -0,0 +1,53 @@
+-- name: CreateBlockedVisitor :one
+INSERT INTO blocked_visitors (
+  condominium_id,
+  name,
+  document,
+  plate,
+  reason,
+  created_by,
+  expires_at
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7
+) RETURNING *;
+
+-- name: GetBlockedVisitorById :one
+SELECT
+  *
+FROM blocked_visitors
+WHERE id = $1;
+
+-- name: ListBlockedVisitors :many
+SELECT
+  *
+FROM blocked_visitors
+WHERE condominium_id = $1
+  AND removed_at IS NULL
+  AND (expires_at IS NULL OR expires_at > NOW())
+ORDER BY created_at DESC;
+
+-- name: FindBlockedVisitors :many
+SELECT
+  *
+FROM blocked_visitors
+WHERE condominium_id = $1
+  AND removed_at IS NULL
+  AND (expires_at IS NULL OR expires_at > NOW())
+  AND (
+    (sqlc.narg('document')::text IS NOT NULL AND document = sqlc.narg('document'))
+    OR plate = ANY(sqlc.arg('plates')::text[])
+    OR (sqlc.narg('name')::text IS NOT NULL AND LOWER(name) = LOWER(sqlc.narg('name')))
+  )
+ORDER BY created_at DESC;
+
+-- name: RemoveBlockedVisitor :exec
+UPDATE blocked_visitors
+SET removed_at = NOW(),
+    removed_by = $2
+WHERE id = $1 AND removed_at IS NULL;
@@ -0,0 +1,103 @@
+-- name: CreateWaitlistEntry :one
+INSERT INTO booking_waitlist_entries (
+  condominium_id,
+  common_area_id,
+  apartment_id,
+  user_id,
+  starts_at,
+  ends_at,
+  headcount
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7
+) RETURNING *;
+
+-- name: GetWaitlistEntryById :one
+SELECT
+  e.*,
+  ca.name AS common_area_name
+FROM booking_waitlist_entries e
+JOIN common_areas ca ON ca.id = e.common_area_id
+WHERE e.id = $1;
+
+-- name: ListUserWaitlistEntries :many
+SELECT
+  e.*,
+  ca.name AS common_area_name
+FROM booking_waitlist_entries e
+JOIN common_areas ca ON ca.id = e.common_area_id
+WHERE e.condominium_id = $1
+  AND e.user_id = $2
+  AND e.status IN ('waiting', 'offered')
+ORDER BY e.starts_at ASC;
+
+-- name: ListWaitingEntriesInRange :many
+SELECT
+  *
+FROM booking_waitlist_entries
+WHERE common_area_id = $1
+  AND status = 'waiting'
+  AND starts_at > NOW()
+  AND ends_at > sqlc.arg('starts_at')
+  AND starts_at < sqlc.arg('ends_at')
+ORDER BY created_at ASC;
+
+-- name: OfferWaitlistEntry :one
+UPDATE booking_waitlist_entries
+SET
+  status = 'offered',
+  offered_at = NOW(),
+  offer_expires_at = $2,
+  updated_at = NOW()
+WHERE id = $1
+  AND status = 'waiting'
+RETURNING *;
+
+-- name: AcceptWaitlistOffer :one
+UPDATE booking_waitlist_entries
+SET
+  status = 'accepted',
+  updated_at = NOW()
+WHERE id = $1
+  AND status = 'offered'
+  AND offer_expires_at > NOW()
+RETURNING *;
+
+-- name: SetWaitlistEntryBooking :exec
+UPDATE booking_waitlist_entries
+SET booking_id = $2
+WHERE id = $1;
+
+-- name: CancelWaitlistEntriesInRange :many
+UPDATE booking_waitlist_entries
+SET
+  status = 'cancelled',
+  updated_at = NOW()
+WHERE common_area_id = sqlc.arg('common_area_id')
+  AND status IN ('waiting', 'offered')
+  AND ends_at > sqlc.arg('starts_at')
+  AND (sqlc.narg('ends_at')::timestamptz IS NULL OR starts_at < sqlc.narg('ends_at'))
+RETURNING *;
+
+-- name: CancelWaitlistEntry :one
+UPDATE booking_waitlist_entries
+SET
+  status = 'cancelled',
+  updated_at = NOW()
+WHERE id = $1
+  AND status IN ('waiting', 'offered')
+RETURNING *;
+
+-- name: ExpireWaitlistEntries :many
+UPDATE booking_waitlist_entries
+SET
+  status = 'expired',
+  updated_at = NOW()
+WHERE (status = 'offered' AND offer_expires_at <= NOW())
+   OR (status = 'waiting' AND starts_at <= NOW())
+RETURNING *;
@@ -1,14 +1,37 @@
+-- name: CancelBooking :one
+UPDATE bookings
+SET
+  status = 'cancelled',
+  cancelled_at = NOW(),
+  cancelled_by = $2,
+  late_cancellation = $3,
+  cancellation_fine_bill_id = $4,
+  updated_at = NOW()
+WHERE id = $1
+  AND status IN ('pending', 'confirmed')
+RETURNING *;
+
 -- name: CheckBookingConflict :one
-SELECT EXISTS (
-  SELECT 1
-  FROM bookings
-  WHERE common_area_id = $1
-  AND deleted_at IS NULL
-  AND status IN ('confirmed', 'pending')
-  AND (
-    (starts_at < sqlc.arg('ends_at') AND ends_at > sqlc.arg('starts_at'))
+SELECT (
+  EXISTS (
+    SELECT 1
+    FROM bookings
+    WHERE common_area_id = $1
+    AND deleted_at IS NULL
+    AND status IN ('confirmed', 'pending')
+    AND (
+      (starts_at < sqlc.arg('ends_at') AND ends_at > sqlc.arg('starts_at'))
+    )
+  ) OR EXISTS (
+    SELECT 1
+    FROM booking_waitlist_entries
+    WHERE common_area_id = $1
+    AND status = 'offered'
+    AND offer_expires_at > NOW()
+    AND starts_at < sqlc.arg('ends_at')
+    AND ends_at > sqlc.arg('starts_at')
   )
-);
+)::bool AS has_conflict;
 
 -- name: CreateBooking :one
 INSERT INTO bookings (
@@ -18,7 +41,9 @@ INSERT INTO bookings (
   common_area_id,
   status,
   starts_at,
-  ends_at
+  ends_at,
+  headcount,
+  exclusive
 ) VALUES (
   $1,
   $2,
@@ -26,7 +51,9 @@ INSERT INTO bookings (
   $4,
   $5,
   $6,
-  $7
+  $7,
+  $8,
+  $9
 ) RETURNING *;
 
 -- name: GetBookingById :one
@@ -37,14 +64,108 @@ FROM bookings b
 JOIN common_areas ca ON ca.id = b.common_area_id
 WHERE b.id = $1;
 
+-- name: GetPeakBookedHeadcount :one
+WITH occupied AS (
+  SELECT starts_at, ends_at, headcount
+  FROM bookings
+  WHERE common_area_id = $1
+    AND deleted_at IS NULL
+    AND status IN ('confirmed', 'pending')
+  UNION ALL
+  SELECT starts_at, ends_at, headcount
+  FROM booking_waitlist_entries
+  WHERE common_area_id = $1
+    AND status = 'offered'
+    AND offer_expires_at > NOW()
+)
+SELECT
+  COALESCE(MAX(occupancy), 0)::int AS peak_headcount
+FROM (
+  SELECT
+    (
+      SELECT SUM(o.headcount)
+      FROM occupied o
+      WHERE o.starts_at <= p.at
+        AND o.ends_at > p.at
+    ) AS occupancy
+  FROM (
+    SELECT
+      GREATEST(starts_at, sqlc.arg('starts_at')) AS at
+    FROM occupied
+    WHERE starts_at < sqlc.arg('ends_at')
+      AND ends_at > sqlc.arg('starts_at')
+  ) p
+) c;
+
+-- name: ReleaseBookingDeposit :one
+UPDATE bookings
+SET
+  deposit_released_at = NOW(),
+  deposit_released_by = $2,
+  deposit_retained_cents = $3,
+  deposit_retention_reason = $4,
+  updated_at = NOW()
+WHERE id = $1
+  AND deposit_released_at IS NULL
+RETURNING *;
+
+-- name: SetBookingBills :one
+UPDATE bookings
+SET
+  fee_bill_id = $2,
+  deposit_bill_id = $3,
+  updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
 -- name: UpdateBookingStatus :one
 UPDATE bookings
 SET
   status = $1,
   updated_at = NOW()
 WHERE id = $2
+  AND status = 'pending'
 RETURNING *;
 
+-- name: DenyPendingBooking :one
+UPDATE bookings
+SET
+  status = 'denied',
+  updated_at = NOW()
+WHERE id = $1
+  AND status = 'pending'
+RETURNING *;
+
+-- name: EscalatePendingBooking :one
+UPDATE bookings
+SET escalated_at = NOW()
+WHERE id = $1
+  AND status = 'pending'
+  AND escalated_at IS NULL
+RETURNING *;
+
+-- name: ListExpiredPendingBookings :many
+SELECT b.*
+FROM bookings b
+JOIN common_areas ca ON ca.id = b.common_area_id
+WHERE b.status = 'pending'
+  AND b.deleted_at IS NULL
+  AND ca.pending_expiry_hours IS NOT NULL
+  AND b.starts_at <= NOW() + make_interval(hours => ca.pending_expiry_hours)
+  AND (ca.pending_expiry_action = 'deny' OR b.escalated_at IS NULL OR b.starts_at <= NOW())
+ORDER BY b.starts_at ASC;
+
+-- name: ListActiveAreaBookingsInRange :many
+SELECT
+  *
+FROM bookings
+WHERE common_area_id = sqlc.arg('common_area_id')
+  AND deleted_at IS NULL
+  AND status IN ('pending', 'confirmed')
+  AND ends_at > sqlc.arg('starts_at')
+  AND (sqlc.narg('ends_at')::timestamptz IS NULL OR starts_at < sqlc.narg('ends_at'))
+ORDER BY starts_at ASC;
+
 -- name: ListBookings :many
 SELECT
   b.*,
@@ -67,11 +188,35 @@ ORDER BY b.starts_at DESC;
 SELECT
   starts_at,
   ends_at,
-  status
+  status,
+  headcount
 FROM bookings
 WHERE common_area_id = $1
   AND status IN ('confirmed', 'pending')
   AND deleted_at IS NULL
-  AND starts_at >= sqlc.arg('starts_at')
-  AND ends_at <= sqlc.arg('ends_at')
+  AND ends_at > sqlc.arg('starts_at')
+  AND starts_at < sqlc.arg('ends_at')
+UNION ALL
+SELECT
+  starts_at,
+  ends_at,
+  'held' AS status,
+  headcount
+FROM booking_waitlist_entries
+WHERE common_area_id = $1
+  AND status = 'offered'
+  AND offer_expires_at > NOW()
+  AND ends_at > sqlc.arg('starts_at')
+  AND starts_at < sqlc.arg('ends_at')
 ORDER BY starts_at ASC;
+
+-- name: CountApartmentBookingsInRange :one
+SELECT
+  COUNT(*)::int AS bookings_count
+FROM bookings
+WHERE apartment_id = $1
+  AND common_area_id = $2
+  AND deleted_at IS NULL
+  AND status IN ('confirmed', 'pending')
+  AND starts_at >= sqlc.arg('from_date')
+  AND starts_at < sqlc.arg('to_date');
@@ -0,0 +1,33 @@
+-- name: CreateCalendarFeed :one
+INSERT INTO calendar_feeds (
+  condominium_id,
+  user_id,
+  scope
+) VALUES (
+  $1,
+  $2,
+  $3
+) RETURNING *;
+
+-- name: GetCalendarFeedByToken :one
+SELECT
+  *
+FROM calendar_feeds
+WHERE token = $1;
+
+-- name: ListUserCalendarFeeds :many
+SELECT
+  *
+FROM calendar_feeds
+WHERE user_id = $1
+  AND condominium_id = $2
+  AND revoked_at IS NULL
+ORDER BY created_at DESC;
+
+-- name: RevokeCalendarFeed :one
+UPDATE calendar_feeds
+SET revoked_at = NOW()
+WHERE id = $1
+  AND user_id = $2
+  AND revoked_at IS NULL
+RETURNING *;
@@ -0,0 +1,40 @@
+-- name: CreateCommonAreaBlackout :one
+INSERT INTO common_area_blackouts (
+  condominium_id,
+  common_area_id,
+  starts_at,
+  ends_at,
+  reason,
+  created_by
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6
+) RETURNING *;
+
+-- name: ListCommonAreaBlackouts :many
+SELECT
+  *
+FROM common_area_blackouts
+WHERE common_area_id = sqlc.arg('common_area_id')
+  AND ends_at > sqlc.arg('from_date')
+  AND (sqlc.narg('to_date')::timestamptz IS NULL OR starts_at < sqlc.narg('to_date'))
+ORDER BY starts_at ASC;
+
+-- name: CheckBlackoutConflict :one
+SELECT EXISTS (
+  SELECT 1
+  FROM common_area_blackouts
+  WHERE common_area_id = $1
+    AND starts_at < sqlc.arg('ends_at')
+    AND ends_at > sqlc.arg('starts_at')
+)::bool AS has_blackout;
+
+-- name: DeleteCommonAreaBlackout :one
+DELETE FROM common_area_blackouts
+WHERE id = $1
+  AND common_area_id = $2
+RETURNING *;
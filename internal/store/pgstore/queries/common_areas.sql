@@ -3,19 +3,52 @@ INSERT INTO common_areas (
   condominium_id,
   name,
   capacity,
-  requires_approval
+  requires_approval,
+  min_duration_minutes,
+  max_duration_minutes,
+  max_advance_days,
+  cooldown_minutes,
+  max_bookings_per_month,
+  cancellation_deadline_hours,
+  late_cancellation_fee_cents,
+  price_cents,
+  pricing_unit,
+  deposit_cents,
+  allow_overlapping_bookings,
+  pending_expiry_hours,
+  pending_expiry_action
 ) VALUES (
   $1,
   $2,
   $3,
-  $4
+  $4,
+  $5,
+  $6,
+  $7,
+  $8,
+  $9,
+  $10,
+  $11,
+  $12,
+  $13,
+  $14,
+  $15,
+  $16,
+  $17
 ) RETURNING *;
 
 -- name: ListCommonAreas :many
 SELECT
   *
 FROM common_areas
-WHERE condominium_id = $1;
+WHERE condominium_id = $1
+  AND archived_at IS NULL;
+
+-- name: GetCommonAreaById :one
+SELECT
+  *
+FROM common_areas
+WHERE id = $1;
 
 -- name: GetCommonAreaIdForUpdate :one
 SELECT
@@ -23,3 +56,76 @@ SELECT
 FROM common_areas
 WHERE id = $1
 FOR UPDATE;
+
+-- name: GetCommonAreaForShare :one
+SELECT
+  *
+FROM common_areas
+WHERE id = $1
+FOR SHARE;
+
+-- name: UpdateCommonArea :one
+UPDATE common_areas
+SET name = $2,
+    capacity = $3,
+    requires_approval = $4
+WHERE id = $1
+RETURNING *;
+
+-- name: ArchiveCommonArea :one
+UPDATE common_areas
+SET archived_at = NOW()
+WHERE id = $1
+  AND archived_at IS NULL
+RETURNING *;
+
+-- name: UpdateCommonAreaRules :one
+UPDATE common_areas
+SET min_duration_minutes = $2,
+    max_duration_minutes = $3,
+    max_advance_days = $4,
+    cooldown_minutes = $5,
+    max_bookings_per_month = $6,
+    cancellation_deadline_hours = $7,
+    late_cancellation_fee_cents = $8,
+    price_cents = $9,
+    pricing_unit = $10,
+    deposit_cents = $11,
+    allow_overlapping_bookings = $12,
+    pending_expiry_hours = $13,
+    pending_expiry_action = $14
+WHERE id = $1
+RETURNING *;
+
+-- name: ListCommonAreaOpeningHours :many
+SELECT
+  *
+FROM common_area_opening_hours
+WHERE common_area_id = $1
+ORDER BY weekday;
+
+-- name: ListCondominiumOpeningHours :many
+SELECT
+  h.*
+FROM common_area_opening_hours h
+JOIN common_areas ca ON ca.id = h.common_area_id
+WHERE ca.condominium_id = $1
+  AND ca.archived_at IS NULL
+ORDER BY h.common_area_id, h.weekday;
+
+-- name: DeleteCommonAreaOpeningHours :exec
+DELETE FROM common_area_opening_hours
+WHERE common_area_id = $1;
+
+-- name: InsertCommonAreaOpeningHours :exec
+INSERT INTO common_area_opening_hours (
+  common_area_id,
+  weekday,
+  opens_minute,
+  closes_minute
+)
+SELECT
+  $1,
+  unnest(sqlc.arg('weekdays')::smallint[]),
+  unnest(sqlc.arg('opens_minutes')::smallint[]),
+  unnest(sqlc.arg('closes_minutes')::smallint[]);
@@ -3,13 +3,15 @@ INSERT INTO condominiums (
   name,
   cnpj,
   address,
-  plan_type
+  plan_type,
+  timezone
 )
 VALUES (
   $1,
   $2,
   $3,
-  $4
+  $4,
+  $5
 ) RETURNING id;
 
 -- name: GetCondominiumById :one
@@ -6,7 +6,12 @@ INSERT INTO invites (
   guest_name,
   guest_type,
   starts_at,
-  ends_at
+  ends_at,
+  recurrence_weekdays,
+  daily_start_minute,
+  daily_end_minute,
+  max_entries,
+  guest_plate
 ) VALUES (
   $1,
   $2,
@@ -14,7 +19,12 @@ INSERT INTO invites (
   $4,
   $5,
   $6,
-  $7
+  $7,
+  $8,
+  $9,
+  $10,
+  $11,
+  $12
 ) RETURNING *;
 
 -- name: GetInviteByToken :one
@@ -22,10 +32,12 @@ SELECT
   i.*,
   a.block,
   a.number AS apartment_number,
-  u.name AS resident_name
+  u.name AS resident_name,
+  c.timezone AS condominium_timezone
 FROM invites i
 JOIN apartments a ON a.id = i.apartment_id
 JOIN users u ON u.id = i.issued_by
+JOIN condominiums c ON c.id = i.condominium_id
 WHERE i.token = $1;
 
 -- name: GetInviteById :one
@@ -34,11 +46,41 @@ SELECT
 FROM invites
 WHERE id = $1;
 
+-- name: ListActiveInvitesByPlate :many
+SELECT
+  i.*,
+  a.block,
+  a.number AS apartment_number,
+  u.name AS resident_name,
+  c.timezone AS condominium_timezone
+FROM invites i
+JOIN apartments a ON a.id = i.apartment_id
+JOIN users u ON u.id = i.issued_by
+JOIN condominiums c ON c.id = i.condominium_id
+WHERE i.condominium_id = $1
+  AND i.guest_plate = ANY(sqlc.arg('plates')::text[])
+  AND i.revoked_at IS NULL
+  AND i.starts_at <= NOW()
+  AND i.ends_at > NOW()
+ORDER BY i.ends_at;
+
+-- name: LockInviteForEntry :one
+SELECT id
+FROM invites
+WHERE id = $1
+FOR UPDATE;
+
+-- name: CountInviteEntries :one
+SELECT COUNT(*)::int AS entries_count
+FROM access_logs
+WHERE invite_id = $1;
+
 -- name: ListInvites :many
 SELECT
     i.*,
     a.block,
-    a.number as apartment_number
+    a.number as apartment_number,
+    (SELECT COUNT(*) FROM access_logs l WHERE l.invite_id = i.id)::int AS entries_count
 FROM invites i
 JOIN apartments a ON a.id = i.apartment_id
 WHERE i.condominium_id = $1
@@ -0,0 +1,45 @@
+-- name: CreatePackagePickupAuthorization :one
+INSERT INTO package_pickup_authorizations (
+  condominium_id,
+  apartment_id,
+  package_id,
+  authorized_name,
+  authorized_document,
+  valid_from,
+  valid_until,
+  created_by
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7,
+  $8
+) RETURNING *;
+
+-- name: GetPackagePickupAuthorizationById :one
+SELECT * FROM package_pickup_authorizations
+WHERE id = $1;
+
+-- name: ListPackagePickupAuthorizations :many
+SELECT * FROM package_pickup_authorizations
+WHERE apartment_id = $1
+  AND (sqlc.narg('only_active')::boolean IS NOT TRUE OR (revoked_at IS NULL AND valid_until > NOW()))
+ORDER BY created_at DESC;
+
+-- name: FindActivePickupAuthorization :one
+SELECT * FROM package_pickup_authorizations
+WHERE apartment_id = $1
+  AND authorized_document = $2
+  AND (package_id IS NULL OR package_id = sqlc.arg('package_id'))
+  AND revoked_at IS NULL
+  AND NOW() BETWEEN valid_from AND valid_until
+ORDER BY package_id NULLS LAST
+LIMIT 1;
+
+-- name: RevokePackagePickupAuthorization :exec
+UPDATE package_pickup_authorizations
+SET revoked_at = NOW()
+WHERE id = $1;
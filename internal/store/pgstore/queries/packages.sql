@@ -5,6 +5,13 @@ INSERT INTO packages (
   received_by,
   recipient_name,
   photo_url,
+  pickup_code,
+  carrier,
+  tracking_code,
+  size_category,
+  is_perishable,
+  is_fragile,
+  storage_location,
   status
 ) VALUES (
   $1,
@@ -12,16 +19,27 @@ INSERT INTO packages (
   $3,
   $4,
   $5,
+  $6,
+  $7,
+  $8,
+  $9,
+  $10,
+  $11,
+  $12,
   'pending'
 ) RETURNING *;
 
--- name: UpdatePackageToWithdrawn :exec
+-- name: UpdatePackageToWithdrawn :one
 UPDATE packages
 SET
   status = 'withdrawn',
   withdrawn_at = NOW(),
-  withdrawn_by = sqlc.narg('withdrawn_by')
+  withdrawn_by = sqlc.narg('withdrawn_by'),
+  collected_by_name = sqlc.narg('collected_by_name'),
+  collected_by_document = sqlc.narg('collected_by_document'),
+  pickup_authorization_id = sqlc.narg('pickup_authorization_id')
 WHERE id = $1
+  AND status = 'pending'
 RETURNING *;
 
 -- name: GetPackageById :one
@@ -44,12 +62,27 @@ SELECT
   a.block,
   a.number as apartment_number,
   p.withdrawn_at,
-  p.withdrawn_by
+  p.withdrawn_by,
+  p.carrier,
+  p.tracking_code,
+  p.size_category,
+  p.is_perishable,
+  p.is_fragile,
+  p.storage_location,
+  floor(EXTRACT(EPOCH FROM (COALESCE(p.withdrawn_at, p.returned_at, NOW()) - p.received_at)) / 86400)::int AS days_waiting
 FROM packages p
 JOIN apartments a ON a.id = p.apartment_id
 WHERE p.condominium_id = $1
   AND (sqlc.narg('status')::text IS NULL OR p.status = sqlc.narg('status')::text)
-ORDER BY p.received_at DESC;
+  AND (sqlc.narg('carrier')::text IS NULL OR p.carrier ILIKE sqlc.narg('carrier')::text)
+  AND (sqlc.narg('tracking_code')::text IS NULL OR p.tracking_code LIKE '%' || replace(replace(replace(sqlc.narg('tracking_code')::text, '\', '\\'), '%', '\%'), '_', '\_') || '%' ESCAPE '\')
+  AND (sqlc.narg('size_category')::text IS NULL OR p.size_category = sqlc.narg('size_category')::text)
+  AND (sqlc.narg('is_perishable')::boolean IS NULL OR p.is_perishable = sqlc.narg('is_perishable')::boolean)
+  AND (sqlc.narg('is_fragile')::boolean IS NULL OR p.is_fragile = sqlc.narg('is_fragile')::boolean)
+  AND (sqlc.narg('storage_location')::text IS NULL OR p.storage_location ILIKE sqlc.narg('storage_location')::text)
+ORDER BY
+  CASE WHEN sqlc.narg('sort')::text = 'days_waiting' THEN COALESCE(p.withdrawn_at, p.returned_at, NOW()) - p.received_at END DESC,
+  p.received_at DESC;
 
 -- name: ListPackagesByApartment :many
 SELECT
@@ -60,9 +93,108 @@ SELECT
   p.photo_url,
   u.name as received_by_name,
   p.withdrawn_at,
-  p.withdrawn_by
+  p.withdrawn_by,
+  p.pickup_code,
+  p.carrier,
+  p.tracking_code,
+  p.size_category,
+  floor(EXTRACT(EPOCH FROM (COALESCE(p.withdrawn_at, p.returned_at, NOW()) - p.received_at)) / 86400)::int AS days_waiting
 FROM packages p
 JOIN users u ON u.id = p.received_by
 WHERE p.apartment_id = $1
   AND (sqlc.narg('status')::text IS NULL OR p.status = sqlc.narg('status')::text)
+ORDER BY
+  CASE WHEN sqlc.narg('sort')::text = 'days_waiting' THEN COALESCE(p.withdrawn_at, p.returned_at, NOW()) - p.received_at END DESC,
+  p.received_at DESC;
+
+-- name: UpdatePackagePhoto :exec
+UPDATE packages
+SET
+  photo_key = $2,
+  thumbnail_key = $3
+WHERE id = $1;
+
+-- name: RegisterPackagePickupAttempt :one
+UPDATE packages
+SET
+  pickup_attempts = pickup_attempts + 1,
+  pickup_locked_at = CASE
+    WHEN pickup_attempts + 1 >= sqlc.arg('max_attempts')::int THEN NOW()
+    ELSE pickup_locked_at
+  END
+WHERE id = $1
+RETURNING pickup_attempts;
+
+-- name: ResetPackagePickupCode :exec
+UPDATE packages
+SET
+  pickup_code = $2,
+  pickup_attempts = 0,
+  pickup_locked_at = NULL
+WHERE id = $1;
+
+-- name: ReleasePackageAtDesk :exec
+UPDATE packages
+SET
+  desk_release_until = $2,
+  desk_released_by = $3
+WHERE id = $1;
+
+-- name: UpdatePackageToReturned :one
+UPDATE packages
+SET
+  status = 'returned_to_sender',
+  returned_at = NOW(),
+  returned_by = $2
+WHERE id = $1
+  AND status = 'pending'
+RETURNING *;
+
+-- name: ListPackagesDueForReminder :many
+SELECT
+  p.id,
+  p.apartment_id,
+  p.recipient_name,
+  p.received_at,
+  p.reminders_sent
+FROM packages p
+WHERE p.status = 'pending'
+  AND p.reminders_sent < cardinality(sqlc.arg('reminder_days')::int[])
+  AND p.received_at <= NOW() - make_interval(days => (sqlc.arg('reminder_days')::int[])[p.reminders_sent + 1])
+ORDER BY p.received_at;
+
+-- name: MarkPackageReminderSent :exec
+UPDATE packages
+SET
+  reminders_sent = $2,
+  last_reminder_at = NOW()
+WHERE id = $1;
+
+-- name: ListOverduePackages :many
+SELECT
+  p.id,
+  p.condominium_id,
+  p.received_at,
+  a.block,
+  a.number AS apartment_number
+FROM packages p
+JOIN apartments a ON a.id = p.apartment_id
+WHERE p.status = 'pending'
+  AND p.overdue_notified_at IS NULL
+  AND p.received_at <= NOW() - make_interval(days => sqlc.arg('storage_limit_days')::int)
+ORDER BY p.condominium_id, p.received_at;
+
+-- name: MarkPackagesOverdueNotified :exec
+UPDATE packages
+SET overdue_notified_at = NOW()
+WHERE id = ANY(sqlc.arg('ids')::uuid[]);
+
+-- name: ListPackagesByTrackingCode :many
+SELECT
+  p.id,
+  p.status,
+  p.received_at
+FROM packages p
+WHERE p.condominium_id = $1
+  AND p.tracking_code = $2
 ORDER BY p.received_at DESC;
@@ -0,0 +1,151 @@
+-- name: CreatePoll :one
+INSERT INTO polls (
+  condominium_id,
+  created_by,
+  title,
+  description,
+  choice_type,
+  is_anonymous,
+  is_weighted,
+  results_visibility,
+  opens_at,
+  closes_at
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7,
+  $8,
+  $9,
+  $10
+) RETURNING *;
+
+-- name: CreatePollOption :one
+INSERT INTO poll_options (
+  poll_id,
+  label,
+  position
+) VALUES (
+  $1,
+  $2,
+  $3
+) RETURNING *;
+
+-- name: GetPollById :one
+SELECT
+  *
+FROM polls
+WHERE id = $1;
+
+-- name: ListPollsByCondominium :many
+SELECT
+  *
+FROM polls
+WHERE condominium_id = $1
+  AND (sqlc.narg('only_open')::boolean IS NOT TRUE OR (opens_at <= NOW() AND closes_at > NOW() AND closed_at IS NULL))
+ORDER BY opens_at DESC
+LIMIT $2 OFFSET $3;
+
+-- name: ListPollOptions :many
+SELECT
+  *
+FROM poll_options
+WHERE poll_id = $1
+ORDER BY position ASC;
+
+-- name: ClosePoll :one
+UPDATE polls
+SET
+  closed_at = NOW(),
+  updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: CreatePollBallot :one
+INSERT INTO poll_ballots (
+  poll_id,
+  apartment_id,
+  user_id,
+  weight
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4
+) RETURNING *;
+
+-- name: CreatePollBallotChoice :exec
+INSERT INTO poll_ballot_choices (
+  ballot_id,
+  option_id
+) VALUES (
+  $1,
+  $2
+);
+
+-- name: CountPollBallots :one
+SELECT
+  COUNT(*)
+FROM poll_ballots
+WHERE poll_id = $1;
+
+-- name: GetPollResults :many
+SELECT
+  o.id AS option_id,
+  o.label,
+  o.position,
+  COUNT(c.ballot_id)::int AS votes,
+  COALESCE(SUM(b.weight), 0)::float8 AS weighted_votes
+FROM poll_options o
+LEFT JOIN poll_ballot_choices c ON c.option_id = o.id
+LEFT JOIN poll_ballots b ON b.id = c.ballot_id
+WHERE o.poll_id = $1
+GROUP BY o.id
+ORDER BY o.position ASC;
+
+-- name: ListPollVoters :many
+SELECT
+  b.apartment_id,
+  a.block,
+  a.number AS apartment_number,
+  u.name AS voter_name,
+  c.option_id,
+  b.created_at
+FROM poll_ballots b
+JOIN apartments a ON a.id = b.apartment_id
+JOIN poll_ballot_choices c ON c.ballot_id = b.id
+LEFT JOIN users u ON u.id = b.user_id
+WHERE b.poll_id = $1
+ORDER BY a.block, a.number;
+
+-- name: ListPollsToAnnounceOpening :many
+SELECT
+  *
+FROM polls
+WHERE open_notified_at IS NULL
+  AND closed_at IS NULL
+  AND opens_at <= NOW()
+  AND closes_at > NOW();
+
+-- name: MarkPollOpenNotified :exec
+UPDATE polls
+SET open_notified_at = NOW()
+WHERE id = $1;
+
+-- name: ListPollsClosingSoon :many
+SELECT
+  *
+FROM polls
+WHERE closing_notified_at IS NULL
+  AND closed_at IS NULL
+  AND opens_at <= NOW()
+  AND closes_at > NOW()
+  AND closes_at <= sqlc.arg('closes_before');
+
+-- name: MarkPollClosingNotified :exec
+UPDATE polls
+SET closing_notified_at = NOW()
+WHERE id = $1;
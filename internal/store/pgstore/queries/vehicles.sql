@@ -0,0 +1,46 @@
+-- name: CreateVehicle :one
+INSERT INTO vehicles (
+  condominium_id,
+  apartment_id,
+  plate,
+  model,
+  color,
+  created_by
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6
+) RETURNING *;
+
+-- name: GetVehicleById :one
+SELECT
+  *
+FROM vehicles
+WHERE id = $1 AND removed_at IS NULL;
+
+-- name: ListVehiclesByApartment :many
+SELECT
+  *
+FROM vehicles
+WHERE apartment_id = $1 AND removed_at IS NULL
+ORDER BY created_at;
+
+-- name: FindVehicleByPlate :one
+SELECT
+  v.*,
+  a.block,
+  a.number AS apartment_number
+FROM vehicles v
+JOIN apartments a ON a.id = v.apartment_id
+WHERE v.condominium_id = $1
+  AND v.plate = ANY(sqlc.arg('plates')::text[])
+  AND v.removed_at IS NULL
+LIMIT 1;
+
+-- name: RemoveVehicle :exec
+UPDATE vehicles
+SET removed_at = NOW()
+WHERE id = $1 AND removed_at IS NULL;
@@ -0,0 +1,51 @@
+-- name: CreateVisitorRequest :one
+INSERT INTO visitor_requests (
+  condominium_id,
+  apartment_id,
+  visitor_name,
+  visitor_document,
+  photo_key,
+  requested_by,
+  expires_at
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7
+) RETURNING *;
+
+-- name: GetVisitorRequestById :one
+SELECT
+  *
+FROM visitor_requests
+WHERE id = $1;
+
+-- name: ListVisitorRequests :many
+SELECT
+  *
+FROM visitor_requests
+WHERE condominium_id = $1
+  AND created_at >= $2
+  AND (sqlc.narg('status')::text IS NULL OR status = sqlc.narg('status'))
+ORDER BY created_at DESC;
+
+-- name: DecideVisitorRequest :one
+UPDATE visitor_requests
+SET status = $2,
+    decided_by = $3,
+    decided_at = NOW()
+WHERE id = $1
+  AND status = 'pending'
+  AND expires_at > NOW()
+RETURNING *;
+
+-- name: ExpireVisitorRequests :many
+UPDATE visitor_requests
+SET status = 'expired',
+    decided_at = NOW()
+WHERE status = 'pending'
+  AND expires_at <= NOW()
+RETURNING *;
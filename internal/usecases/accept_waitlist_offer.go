@@ -0,0 +1,101 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AcceptWaitlistOfferUC interface {
+	Exec(ctx context.Context, req AcceptWaitlistOfferReq) (pgstore.Booking, error)
+}
+
+type AcceptWaitlistOfferReq struct {
+	UserID  uuid.UUID
+	EntryID uuid.UUID
+}
+
+type AcceptWaitlistOfferUseCase struct {
+	pool *pgxpool.Pool
+}
+
+func NewAcceptWaitlistOfferUseCase(pool *pgxpool.Pool) *AcceptWaitlistOfferUseCase {
+	return &AcceptWaitlistOfferUseCase{
+		pool: pool,
+	}
+}
+
+// Exec turns an open waitlist offer into a booking. The held range no longer counts as taken
+// once the offer is accepted, so the booking goes through the same checks as any other.
+func (uc *AcceptWaitlistOfferUseCase) Exec(ctx context.Context, req AcceptWaitlistOfferReq) (pgstore.Booking, error) {
+	tx, err := uc.pool.Begin(ctx)
+	if err != nil {
+		return pgstore.Booking{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pgstore.New(tx)
+
+	entry, err := qtx.GetWaitlistEntryById(ctx, req.EntryID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pgstore.Booking{}, ErrWaitlistEntryNotFound
+		}
+		return pgstore.Booking{}, fmt.Errorf("failed to get waitlist entry: %w", err)
+	}
+
+	isResident, err := qtx.CheckIsResident(ctx, pgstore.CheckIsResidentParams{
+		UserID:      req.UserID,
+		ApartmentID: entry.ApartmentID,
+	})
+	if err != nil {
+		return pgstore.Booking{}, fmt.Errorf("error checking residency: %w", err)
+	}
+	if !isResident {
+		return pgstore.Booking{}, ErrNoPermission
+	}
+
+	area, err := qtx.GetCommonAreaIdForUpdate(ctx, entry.CommonAreaID)
+	if err != nil {
+		return pgstore.Booking{}, fmt.Errorf("failed to lock common area: %w", err)
+	}
+
+	if _, err := qtx.AcceptWaitlistOffer(ctx, entry.ID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pgstore.Booking{}, ErrWaitlistOfferExpired
+		}
+		return pgstore.Booking{}, fmt.Errorf("failed to accept waitlist offer: %w", err)
+	}
+
+	booking, err := createLockedBooking(ctx, qtx, area, CreateBookingReq{
+		CondominiumID: entry.CondominiumID,
+		UserID:        req.UserID,
+		ApartmentID:   entry.ApartmentID,
+		CommonAreaID:  entry.CommonAreaID,
+		StartsAt:      entry.StartsAt,
+		EndsAt:        entry.EndsAt,
+		Headcount:     entry.Headcount,
+	}, time.Now())
+	if err != nil {
+		return pgstore.Booking{}, err
+	}
+
+	if err := qtx.SetWaitlistEntryBooking(ctx, pgstore.SetWaitlistEntryBookingParams{
+		ID:        entry.ID,
+		BookingID: &booking.ID,
+	}); err != nil {
+		return pgstore.Booking{}, fmt.Errorf("failed to link waitlist entry: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return pgstore.Booking{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return booking, nil
+}
@@ -0,0 +1,88 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/services"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ArchiveCommonAreaUC interface {
+	Exec(ctx context.Context, req ArchiveCommonAreaReq) (ArchivedCommonArea, error)
+}
+
+type ArchiveCommonAreaReq struct {
+	UserID       uuid.UUID
+	CommonAreaID uuid.UUID
+}
+
+// ArchivedCommonArea carries the bookings called off by the archival.
+type ArchivedCommonArea struct {
+	CommonArea        pgstore.CommonArea
+	CancelledBookings []pgstore.Booking
+}
+
+type ArchiveCommonAreaUseCase struct {
+	pool     *pgxpool.Pool
+	notifier services.NotificationService
+}
+
+func NewArchiveCommonAreaUseCase(pool *pgxpool.Pool, n services.NotificationService) *ArchiveCommonAreaUseCase {
+	return &ArchiveCommonAreaUseCase{
+		pool:     pool,
+		notifier: n,
+	}
+}
+
+// Exec deactivates an area. It disappears from the listings and takes no new bookings, but its
+// booking history is kept. Bookings not finished yet are cancelled, along with their unpaid bills
+// and the waitlist, and each affected user is notified.
+func (uc *ArchiveCommonAreaUseCase) Exec(ctx context.Context, req ArchiveCommonAreaReq) (ArchivedCommonArea, error) {
+	tx, err := uc.pool.Begin(ctx)
+	if err != nil {
+		return ArchivedCommonArea{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pgstore.New(tx)
+
+	area, err := lockManagedArea(ctx, qtx, req.CommonAreaID, req.UserID)
+	if err != nil {
+		return ArchivedCommonArea{}, err
+	}
+
+	condo, err := qtx.GetCondominiumById(ctx, area.CondominiumID)
+	if err != nil {
+		return ArchivedCommonArea{}, fmt.Errorf("failed to get condominium: %w", err)
+	}
+
+	loc, err := time.LoadLocation(condo.Timezone)
+	if err != nil {
+		return ArchivedCommonArea{}, fmt.Errorf("invalid condominium timezone %q: %w", condo.Timezone, err)
+	}
+
+	archived, err := qtx.ArchiveCommonArea(ctx, area.ID)
+	if err != nil {
+		return ArchivedCommonArea{}, fmt.Errorf("failed to archive common area: %w", err)
+	}
+
+	closure, err := closeAreaRange(ctx, qtx, area, req.UserID, time.Now(), nil)
+	if err != nil {
+		return ArchivedCommonArea{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return ArchivedCommonArea{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	notifyAreaClosure(uc.notifier, area.Name, "a área foi desativada pela administração", loc, closure)
+
+	return ArchivedCommonArea{
+		CommonArea:        archived,
+		CancelledBookings: closure.Bookings,
+	}, nil
+}
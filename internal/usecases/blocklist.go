@@ -0,0 +1,77 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/Bellorico323/vizen/internal/services"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/utils"
+	"github.com/google/uuid"
+)
+
+var ErrVisitorBlocked = errors.New("visitor is on the condominium blocklist")
+
+// visitorIdentity is what the gate knows about a visitor. Document and Plate are optional.
+type visitorIdentity struct {
+	Name     string
+	Document *string
+	Plate    *string
+}
+
+// findBlockedVisitors returns the active blocklist entries matching the visitor by document, plate
+// (either format) or name. Names are compared case-insensitively, so a match may be a homonym.
+func findBlockedVisitors(ctx context.Context, q pgstore.Querier, condoID uuid.UUID, visitor visitorIdentity) ([]pgstore.BlockedVisitor, error) {
+	params := pgstore.FindBlockedVisitorsParams{
+		CondominiumID: condoID,
+		Name:          utils.ToNullString(normalizeVisitorName(visitor.Name)),
+	}
+	if visitor.Document != nil {
+		params.Document = utils.ToNullString(utils.NormalizeDocument(*visitor.Document))
+	}
+	if visitor.Plate != nil {
+		params.Plates = utils.PlateVariants(*visitor.Plate)
+	}
+
+	matches, err := q.FindBlockedVisitors(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check blocklist: %w", err)
+	}
+
+	// Strong matches (document or plate) first, they are the ones worth showing.
+	slices.SortStableFunc(matches, func(a, b pgstore.BlockedVisitor) int {
+		aStrong := a.Document != nil && params.Document != nil && *a.Document == *params.Document ||
+			a.Plate != nil && slices.Contains(params.Plates, *a.Plate)
+		bStrong := b.Document != nil && params.Document != nil && *b.Document == *params.Document ||
+			b.Plate != nil && slices.Contains(params.Plates, *b.Plate)
+		switch {
+		case aStrong == bStrong:
+			return 0
+		case aStrong:
+			return -1
+		default:
+			return 1
+		}
+	})
+
+	return matches, nil
+}
+
+func normalizeVisitorName(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
+
+func alertBlockedVisitor(notifier services.NotificationService, entry pgstore.BlockedVisitor, visitorName string) {
+	bgCtx := context.Background()
+
+	title := "🚫 Visitante bloqueado na portaria"
+	body := fmt.Sprintf("%s tentou entrar e consta na lista de bloqueio. Motivo: %s", visitorName, entry.Reason)
+
+	if err := notifier.SendToCondoAdmins(bgCtx, entry.CondominiumID, title, body); err != nil {
+		slog.Error("Failed to alert admins about blocked visitor", "blocked_visitor_id", entry.ID, "error", err)
+	}
+}
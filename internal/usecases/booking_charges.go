@@ -0,0 +1,105 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+)
+
+// bookingFeeCents is the price of a booking. Areas priced per hour bill every started hour in full.
+func bookingFeeCents(area pgstore.CommonArea, startsAt, endsAt time.Time) int64 {
+	if area.PriceCents == nil {
+		return 0
+	}
+	if area.PricingUnit != PricingPerHour {
+		return *area.PriceCents
+	}
+
+	minutes := int64(endsAt.Sub(startsAt) / time.Minute)
+	hours := (minutes + 59) / 60
+	return *area.PriceCents * hours
+}
+
+// billBookingCharges creates the fee and deposit bills of a booking that was just confirmed and
+// links them to it. Bills are due on the day the booking starts in the condominium timezone.
+func billBookingCharges(ctx context.Context, qtx *pgstore.Queries, area pgstore.CommonArea, booking pgstore.Booking) (pgstore.Booking, error) {
+	fee := bookingFeeCents(area, booking.StartsAt, booking.EndsAt)
+	if fee == 0 && area.DepositCents == nil {
+		return booking, nil
+	}
+
+	condo, err := qtx.GetCondominiumById(ctx, booking.CondominiumID)
+	if err != nil {
+		return pgstore.Booking{}, fmt.Errorf("failed to get condominium: %w", err)
+	}
+
+	loc, err := time.LoadLocation(condo.Timezone)
+	if err != nil {
+		return pgstore.Booking{}, fmt.Errorf("invalid condominium timezone %q: %w", condo.Timezone, err)
+	}
+
+	start := booking.StartsAt.In(loc)
+	dueDate := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+
+	params := pgstore.SetBookingBillsParams{ID: booking.ID}
+
+	if fee > 0 {
+		bill, err := qtx.CreateBill(ctx, pgstore.CreateBillParams{
+			CondominiumID: booking.CondominiumID,
+			ApartmentID:   booking.ApartmentID,
+			BillType:      "booking_fee",
+			ValueInCents:  fee,
+			DueDate:       dueDate,
+		})
+		if err != nil {
+			return pgstore.Booking{}, fmt.Errorf("failed to create booking fee bill: %w", err)
+		}
+		params.FeeBillID = &bill.ID
+	}
+
+	if area.DepositCents != nil {
+		bill, err := qtx.CreateBill(ctx, pgstore.CreateBillParams{
+			CondominiumID: booking.CondominiumID,
+			ApartmentID:   booking.ApartmentID,
+			BillType:      "booking_deposit",
+			ValueInCents:  *area.DepositCents,
+			DueDate:       dueDate,
+		})
+		if err != nil {
+			return pgstore.Booking{}, fmt.Errorf("failed to create booking deposit bill: %w", err)
+		}
+		params.DepositBillID = &bill.ID
+	}
+
+	booking, err = qtx.SetBookingBills(ctx, params)
+	if err != nil {
+		return pgstore.Booking{}, fmt.Errorf("failed to link booking bills: %w", err)
+	}
+
+	return booking, nil
+}
+
+// cancelBookingCharges cancels the unpaid bills of a booking that will not happen. Late
+// cancellations keep the fee. Paid bills are left alone: refunds and deposit releases are
+// handled by staff.
+func cancelBookingCharges(ctx context.Context, qtx *pgstore.Queries, booking pgstore.Booking, keepFee bool) error {
+	var ids []uuid.UUID
+	if booking.FeeBillID != nil && !keepFee {
+		ids = append(ids, *booking.FeeBillID)
+	}
+	if booking.DepositBillID != nil {
+		ids = append(ids, *booking.DepositBillID)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	if err := qtx.CancelOpenBills(ctx, ids); err != nil {
+		return fmt.Errorf("failed to cancel booking bills: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,315 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/utils"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidBookingRules = errors.New("booking rules must be positive and the minimum duration cannot exceed the maximum")
+	ErrInvalidOpeningHours = errors.New("opening hours need one entry per weekday (0-6) with opensAt before closesAt (HH:MM)")
+
+	ErrBookingOutsideOpeningHours = errors.New("the area is closed at the selected time")
+	ErrBookingTooShort            = errors.New("booking is shorter than the minimum duration for this area")
+	ErrBookingTooLong             = errors.New("booking is longer than the maximum duration for this area")
+	ErrBookingTooFarAhead         = errors.New("booking starts too far in the future for this area")
+	ErrBookingCooldown            = errors.New("the area needs a break between bookings")
+	ErrBookingMonthlyLimit        = errors.New("the apartment reached the monthly booking limit for this area")
+	ErrCommonAreaArchived         = errors.New("the common area was archived and takes no new bookings")
+	ErrAreaBlackedOut             = errors.New("the area is closed for a blackout period at the selected time")
+
+	ErrOverlappingNeedsCapacity = errors.New("areas taking overlapping bookings need a capacity")
+	ErrInvalidHeadcount         = errors.New("headcount must be at least 1 and fit the area capacity")
+)
+
+// BookingRules is the rule set of a common area. Nil limits are disabled. An empty OpeningHours
+// keeps the area always open; otherwise weekdays without an entry are closed.
+type BookingRules struct {
+	OpeningHours        []OpeningHours
+	MinDurationMinutes  *int32
+	MaxDurationMinutes  *int32
+	MaxAdvanceDays      *int32
+	CooldownMinutes     int32
+	MaxBookingsPerMonth *int32
+	// CancellationDeadlineHours is how long before the start residents can still cancel without
+	// it counting as a late cancellation. LateCancellationFeeCents, when set, is billed as a fine.
+	CancellationDeadlineHours *int32
+	LateCancellationFeeCents  *int64
+	// PriceCents is charged per booking or per hour of use, following PricingUnit (defaults to
+	// per booking). DepositCents is billed apart and released by staff after the booking.
+	PriceCents   *int64
+	PricingUnit  string
+	DepositCents *int64
+	// AllowOverlappingBookings lets bookings overlap while their headcount fits the area capacity
+	// (gym, pool). Otherwise the area takes a single booking at a time.
+	AllowOverlappingBookings bool
+	// PendingExpiryHours is how long before the start a booking still waiting for approval
+	// expires, following PendingExpiryAction (defaults to deny). Nil keeps it pending.
+	PendingExpiryHours  *int32
+	PendingExpiryAction string
+}
+
+const (
+	PricingPerBooking = "booking"
+	PricingPerHour    = "hour"
+)
+
+const (
+	PendingExpiryDeny     = "deny"
+	PendingExpiryEscalate = "escalate"
+)
+
+func (r BookingRules) pricingUnit() string {
+	if r.PricingUnit == "" {
+		return PricingPerBooking
+	}
+	return r.PricingUnit
+}
+
+func (r BookingRules) pendingExpiryAction() string {
+	if r.PendingExpiryAction == "" {
+		return PendingExpiryDeny
+	}
+	return r.PendingExpiryAction
+}
+
+// OpeningHours is the daily window of a weekday (0 = Sunday), "HH:MM" in the condominium
+// timezone. ClosesAt "24:00" is midnight at the end of the day.
+type OpeningHours struct {
+	Weekday  int16
+	OpensAt  string
+	ClosesAt string
+}
+
+// openingHoursRows is the column form InsertCommonAreaOpeningHours expects.
+type openingHoursRows struct {
+	weekdays []int16
+	opens    []int16
+	closes   []int16
+}
+
+func parseBookingRules(rules BookingRules) (openingHoursRows, error) {
+	for _, limit := range []*int32{rules.MinDurationMinutes, rules.MaxDurationMinutes, rules.MaxAdvanceDays, rules.MaxBookingsPerMonth, rules.CancellationDeadlineHours, rules.PendingExpiryHours} {
+		if limit != nil && *limit < 1 {
+			return openingHoursRows{}, ErrInvalidBookingRules
+		}
+	}
+	for _, cents := range []*int64{rules.LateCancellationFeeCents, rules.PriceCents, rules.DepositCents} {
+		if cents != nil && *cents < 1 {
+			return openingHoursRows{}, ErrInvalidBookingRules
+		}
+	}
+	if rules.CooldownMinutes < 0 {
+		return openingHoursRows{}, ErrInvalidBookingRules
+	}
+	if unit := rules.pricingUnit(); unit != PricingPerBooking && unit != PricingPerHour {
+		return openingHoursRows{}, ErrInvalidBookingRules
+	}
+	if action := rules.pendingExpiryAction(); action != PendingExpiryDeny && action != PendingExpiryEscalate {
+		return openingHoursRows{}, ErrInvalidBookingRules
+	}
+	if rules.MinDurationMinutes != nil && rules.MaxDurationMinutes != nil && *rules.MinDurationMinutes > *rules.MaxDurationMinutes {
+		return openingHoursRows{}, ErrInvalidBookingRules
+	}
+
+	var rows openingHoursRows
+	seen := make(map[int16]bool)
+	for _, h := range rules.OpeningHours {
+		if h.Weekday < 0 || h.Weekday > 6 || seen[h.Weekday] {
+			return openingHoursRows{}, ErrInvalidOpeningHours
+		}
+		seen[h.Weekday] = true
+
+		opens, err := utils.ParseClock(h.OpensAt)
+		if err != nil {
+			return openingHoursRows{}, ErrInvalidOpeningHours
+		}
+		closes, err := utils.ParseClock(h.ClosesAt)
+		if err != nil || closes <= opens {
+			return openingHoursRows{}, ErrInvalidOpeningHours
+		}
+
+		rows.weekdays = append(rows.weekdays, h.Weekday)
+		rows.opens = append(rows.opens, opens)
+		rows.closes = append(rows.closes, closes)
+	}
+
+	return rows, nil
+}
+
+// replaceOpeningHours swaps the opening hours of an area. It must run inside a transaction.
+func replaceOpeningHours(ctx context.Context, qtx *pgstore.Queries, areaID uuid.UUID, rows openingHoursRows) error {
+	if err := qtx.DeleteCommonAreaOpeningHours(ctx, areaID); err != nil {
+		return fmt.Errorf("failed to clear opening hours: %w", err)
+	}
+
+	if len(rows.weekdays) == 0 {
+		return nil
+	}
+
+	err := qtx.InsertCommonAreaOpeningHours(ctx, pgstore.InsertCommonAreaOpeningHoursParams{
+		CommonAreaID:  areaID,
+		Weekdays:      rows.weekdays,
+		OpensMinutes:  rows.opens,
+		ClosesMinutes: rows.closes,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save opening hours: %w", err)
+	}
+
+	return nil
+}
+
+// areaHasRoom reports whether a booking for headcount people fits the area between startsAt and
+// endsAt. Exclusive areas fit no overlapping booking. For the others, occupancy only grows when a
+// booking starts, so GetPeakBookedHeadcount checks the start of the window and of each
+// overlapping booking. It must run after the area row was locked; for exclusive areas locked in
+// share mode it is only a first check, the bookings_no_overlap constraint has the last word.
+func areaHasRoom(ctx context.Context, qtx pgstore.Querier, area pgstore.CommonArea, startsAt, endsAt time.Time, headcount int32) (bool, error) {
+	if !area.AllowOverlappingBookings {
+		taken, err := qtx.CheckBookingConflict(ctx, pgstore.CheckBookingConflictParams{
+			CommonAreaID: area.ID,
+			StartsAt:     startsAt,
+			EndsAt:       endsAt,
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed to check conflicts: %w", err)
+		}
+		return !taken, nil
+	}
+
+	peak, err := qtx.GetPeakBookedHeadcount(ctx, pgstore.GetPeakBookedHeadcountParams{
+		CommonAreaID: area.ID,
+		StartsAt:     startsAt,
+		EndsAt:       endsAt,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to sum booked headcount: %w", err)
+	}
+
+	return peak+headcount <= *area.Capacity, nil
+}
+
+// checkAreaOpen refuses bookings on archived areas and during their blackout periods.
+func checkAreaOpen(ctx context.Context, qtx pgstore.Querier, area pgstore.CommonArea, startsAt, endsAt time.Time) error {
+	if area.ArchivedAt != nil {
+		return ErrCommonAreaArchived
+	}
+
+	blackedOut, err := qtx.CheckBlackoutConflict(ctx, pgstore.CheckBlackoutConflictParams{
+		CommonAreaID: area.ID,
+		StartsAt:     startsAt,
+		EndsAt:       endsAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to check blackouts: %w", err)
+	}
+	if blackedOut {
+		return ErrAreaBlackedOut
+	}
+
+	return nil
+}
+
+// checkBookingRules evaluates the area rule set for a new booking. It must run after the area row
+// was locked, so the cool-down and monthly counts cannot change until the booking is inserted.
+func checkBookingRules(ctx context.Context, qtx *pgstore.Queries, area pgstore.CommonArea, apartmentID uuid.UUID, startsAt, endsAt time.Time, headcount int32, now time.Time) error {
+	duration := int32(endsAt.Sub(startsAt) / time.Minute)
+	if area.MinDurationMinutes != nil && duration < *area.MinDurationMinutes {
+		return ErrBookingTooShort
+	}
+	if area.MaxDurationMinutes != nil && duration > *area.MaxDurationMinutes {
+		return ErrBookingTooLong
+	}
+
+	if area.MaxAdvanceDays != nil && startsAt.After(now.AddDate(0, 0, int(*area.MaxAdvanceDays))) {
+		return ErrBookingTooFarAhead
+	}
+
+	condo, err := qtx.GetCondominiumById(ctx, area.CondominiumID)
+	if err != nil {
+		return fmt.Errorf("failed to get condominium: %w", err)
+	}
+
+	loc, err := time.LoadLocation(condo.Timezone)
+	if err != nil {
+		return fmt.Errorf("invalid condominium timezone %q: %w", condo.Timezone, err)
+	}
+
+	hours, err := qtx.ListCommonAreaOpeningHours(ctx, area.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list opening hours: %w", err)
+	}
+
+	if len(hours) > 0 && !openingHoursAllow(hours, startsAt.In(loc), endsAt.In(loc)) {
+		return ErrBookingOutsideOpeningHours
+	}
+
+	if area.CooldownMinutes > 0 {
+		cooldown := time.Duration(area.CooldownMinutes) * time.Minute
+		hasRoom, err := areaHasRoom(ctx, qtx, area, startsAt.Add(-cooldown), endsAt.Add(cooldown), headcount)
+		if err != nil {
+			return err
+		}
+		if !hasRoom {
+			return ErrBookingCooldown
+		}
+	}
+
+	if area.MaxBookingsPerMonth != nil {
+		local := startsAt.In(loc)
+		monthStart := time.Date(local.Year(), local.Month(), 1, 0, 0, 0, 0, loc)
+
+		count, err := qtx.CountApartmentBookingsInRange(ctx, pgstore.CountApartmentBookingsInRangeParams{
+			ApartmentID:  apartmentID,
+			CommonAreaID: area.ID,
+			FromDate:     monthStart,
+			ToDate:       monthStart.AddDate(0, 1, 0),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to count monthly bookings: %w", err)
+		}
+		if count >= *area.MaxBookingsPerMonth {
+			return ErrBookingMonthlyLimit
+		}
+	}
+
+	return nil
+}
+
+// openingHoursAllow reports whether the booking fits in the opening hours of the day it starts.
+// Bookings cannot cross midnight, except when ending exactly at the end of a day closing at 24:00.
+func openingHoursAllow(hours []pgstore.CommonAreaOpeningHour, start, end time.Time) bool {
+	var day *pgstore.CommonAreaOpeningHour
+	for i := range hours {
+		if hours[i].Weekday == int16(start.Weekday()) {
+			day = &hours[i]
+			break
+		}
+	}
+	if day == nil {
+		return false
+	}
+
+	startMinute := int16(start.Hour()*60 + start.Minute())
+
+	var endMinute int16
+	sy, sm, sd := start.Date()
+	ey, em, ed := end.Date()
+	switch {
+	case sy == ey && sm == em && sd == ed:
+		endMinute = int16(end.Hour()*60 + end.Minute())
+	case end.Equal(time.Date(sy, sm, sd+1, 0, 0, 0, 0, start.Location())):
+		endMinute = 24 * 60
+	default:
+		return false
+	}
+
+	return startMinute >= day.OpensMinute && endMinute <= day.ClosesMinute
+}
@@ -0,0 +1,88 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/services"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+)
+
+// waitlistHoldPeriod is how long a freed time range stays held for the resident it was offered to.
+const waitlistHoldPeriod = 2 * time.Hour
+
+var (
+	ErrWaitlistEntryNotFound = errors.New("waitlist entry not found")
+	ErrWaitlistEntryInactive = errors.New("the waitlist entry is no longer active")
+	ErrWaitlistOfferExpired  = errors.New("the waitlist offer has expired or was never made")
+	ErrAlreadyWaitlisted     = errors.New("the apartment is already waiting for this time range")
+	ErrSlotAvailable         = errors.New("the time range is available, book it directly")
+)
+
+// offerFreedRange offers the time range freed between startsAt and endsAt to the waiting entries
+// that now fit it, first come first served. Each offer holds the range until it expires or the
+// booking starts, whichever comes first. It must run after the area row was locked.
+func offerFreedRange(ctx context.Context, qtx *pgstore.Queries, area pgstore.CommonArea, startsAt, endsAt, now time.Time) ([]pgstore.BookingWaitlistEntry, error) {
+	// A freed range also frees the cool-down around it.
+	cooldown := time.Duration(area.CooldownMinutes) * time.Minute
+
+	waiting, err := qtx.ListWaitingEntriesInRange(ctx, pgstore.ListWaitingEntriesInRangeParams{
+		CommonAreaID: area.ID,
+		StartsAt:     startsAt.Add(-cooldown),
+		EndsAt:       endsAt.Add(cooldown),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list waitlist entries: %w", err)
+	}
+
+	var offers []pgstore.BookingWaitlistEntry
+	for _, entry := range waiting {
+		hasRoom, err := areaHasRoom(ctx, qtx, area, entry.StartsAt.Add(-cooldown), entry.EndsAt.Add(cooldown), entry.Headcount)
+		if err != nil {
+			return nil, err
+		}
+		if !hasRoom {
+			continue
+		}
+
+		expiresAt := now.Add(waitlistHoldPeriod)
+		if entry.StartsAt.Before(expiresAt) {
+			expiresAt = entry.StartsAt
+		}
+
+		offer, err := qtx.OfferWaitlistEntry(ctx, pgstore.OfferWaitlistEntryParams{
+			ID:             entry.ID,
+			OfferExpiresAt: &expiresAt,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to offer waitlist entry: %w", err)
+		}
+		offers = append(offers, offer)
+	}
+
+	return offers, nil
+}
+
+// notifyWaitlistOffers tells each resident that the range they waited for is held for them. It
+// must be called after the transaction that made the offers commits.
+func notifyWaitlistOffers(notifier services.NotificationService, areaName string, offers []pgstore.BookingWaitlistEntry) {
+	if len(offers) == 0 {
+		return
+	}
+
+	go func() {
+		bgCtx := context.Background()
+
+		for _, offer := range offers {
+			title := "📅 Horário disponível"
+			body := fmt.Sprintf("O horário que você aguardava em '%s' foi liberado e está reservado para você por tempo limitado. Confirme no app para garantir o agendamento.", areaName)
+
+			if err := notifier.SendToUser(bgCtx, offer.UserID, title, body); err != nil {
+				slog.Error("Failed to send async notification", "waitlist_entry_id", offer.ID, "error", err)
+			}
+		}
+	}()
+}
@@ -0,0 +1,187 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/services"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// lateCancellationFineDueDays is how long the apartment has to pay a late cancellation fine.
+const lateCancellationFineDueDays = 10
+
+type CancelBookingUC interface {
+	Exec(ctx context.Context, req CancelBookingReq) (CancelledBooking, error)
+}
+
+type CancelBookingReq struct {
+	UserID    uuid.UUID
+	BookingID uuid.UUID
+}
+
+// CancelledBooking carries the fine billed to the apartment when the cancellation was late and
+// the area charges for it.
+type CancelledBooking struct {
+	Booking  pgstore.Booking
+	FineBill *pgstore.Bill
+}
+
+type CancelBookingUseCase struct {
+	pool     *pgxpool.Pool
+	notifier services.NotificationService
+}
+
+func NewCancelBookingUseCase(pool *pgxpool.Pool, n services.NotificationService) *CancelBookingUseCase {
+	return &CancelBookingUseCase{
+		pool:     pool,
+		notifier: n,
+	}
+}
+
+var (
+	ErrBookingNotCancellable = errors.New("only pending or confirmed bookings can be cancelled")
+	ErrBookingAlreadyStarted = errors.New("bookings cannot be cancelled after they start")
+)
+
+// Exec lets a resident of the apartment cancel one of its bookings before it starts. Cancelling
+// a confirmed booking after the area cancellation deadline is recorded as a late cancellation
+// and, when the area charges a fee for it, billed to the apartment as a fine. Unpaid booking
+// bills are cancelled, except the fee of a late cancellation. The freed range is offered to the
+// waitlist.
+func (uc *CancelBookingUseCase) Exec(ctx context.Context, req CancelBookingReq) (CancelledBooking, error) {
+	tx, err := uc.pool.Begin(ctx)
+	if err != nil {
+		return CancelledBooking{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pgstore.New(tx)
+
+	booking, err := qtx.GetBookingById(ctx, req.BookingID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return CancelledBooking{}, ErrBookingNotFound
+		}
+		return CancelledBooking{}, fmt.Errorf("failed to get booking: %w", err)
+	}
+	if booking.DeletedAt != nil {
+		return CancelledBooking{}, ErrBookingNotFound
+	}
+
+	isResident, err := qtx.CheckIsResident(ctx, pgstore.CheckIsResidentParams{
+		UserID:      req.UserID,
+		ApartmentID: booking.ApartmentID,
+	})
+	if err != nil {
+		return CancelledBooking{}, fmt.Errorf("error checking residency: %w", err)
+	}
+	if !isResident {
+		return CancelledBooking{}, ErrNoPermission
+	}
+
+	if booking.Status != "pending" && booking.Status != "confirmed" {
+		return CancelledBooking{}, ErrBookingNotCancellable
+	}
+
+	now := time.Now()
+	if !now.Before(booking.StartsAt) {
+		return CancelledBooking{}, ErrBookingAlreadyStarted
+	}
+
+	area, err := qtx.GetCommonAreaIdForUpdate(ctx, booking.CommonAreaID)
+	if err != nil {
+		return CancelledBooking{}, fmt.Errorf("failed to lock common area: %w", err)
+	}
+
+	// Pending bookings were never granted, so withdrawing them is never late.
+	late := booking.Status == "confirmed" && area.CancellationDeadlineHours != nil &&
+		now.After(booking.StartsAt.Add(-time.Duration(*area.CancellationDeadlineHours)*time.Hour))
+
+	var result CancelledBooking
+	if late && area.LateCancellationFeeCents != nil {
+		condo, err := qtx.GetCondominiumById(ctx, booking.CondominiumID)
+		if err != nil {
+			return CancelledBooking{}, fmt.Errorf("failed to get condominium: %w", err)
+		}
+
+		loc, err := time.LoadLocation(condo.Timezone)
+		if err != nil {
+			return CancelledBooking{}, fmt.Errorf("invalid condominium timezone %q: %w", condo.Timezone, err)
+		}
+
+		today := now.In(loc)
+		fine, err := qtx.CreateBill(ctx, pgstore.CreateBillParams{
+			CondominiumID: booking.CondominiumID,
+			ApartmentID:   booking.ApartmentID,
+			BillType:      "fine",
+			ValueInCents:  *area.LateCancellationFeeCents,
+			DueDate:       time.Date(today.Year(), today.Month(), today.Day()+lateCancellationFineDueDays, 0, 0, 0, 0, time.UTC),
+		})
+		if err != nil {
+			return CancelledBooking{}, fmt.Errorf("failed to create late cancellation fine: %w", err)
+		}
+		result.FineBill = &fine
+	}
+
+	var fineBillID *uuid.UUID
+	if result.FineBill != nil {
+		fineBillID = &result.FineBill.ID
+	}
+
+	result.Booking, err = qtx.CancelBooking(ctx, pgstore.CancelBookingParams{
+		ID:                     booking.ID,
+		CancelledBy:            utils.ToPtr(req.UserID),
+		LateCancellation:       late,
+		CancellationFineBillID: fineBillID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return CancelledBooking{}, ErrBookingNotCancellable
+		}
+		return CancelledBooking{}, fmt.Errorf("failed to cancel booking: %w", err)
+	}
+
+	if err := cancelBookingCharges(ctx, qtx, result.Booking, late); err != nil {
+		return CancelledBooking{}, err
+	}
+
+	offers, err := offerFreedRange(ctx, qtx, area, booking.StartsAt, booking.EndsAt, now)
+	if err != nil {
+		return CancelledBooking{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return CancelledBooking{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	notifyWaitlistOffers(uc.notifier, area.Name, offers)
+
+	if result.FineBill != nil {
+		go func() {
+			bgCtx := context.Background()
+
+			title := "💸 Multa por cancelamento tardio"
+			body := fmt.Sprintf("O agendamento de '%s' foi cancelado fora do prazo e gerou uma multa de R$ %.2f.",
+				booking.CommonAreaName, float64(result.FineBill.ValueInCents)/100)
+
+			err := uc.notifier.SendToApartmentResidents(bgCtx, booking.ApartmentID, title, body, map[string]string{
+				"type":      "LATE_CANCELLATION_FINE",
+				"bookingId": booking.ID.String(),
+				"billId":    result.FineBill.ID.String(),
+			})
+			if err != nil {
+				slog.Error("Failed to send async notification", "booking_id", booking.ID, "error", err)
+			}
+		}()
+	}
+
+	return result, nil
+}
@@ -0,0 +1,87 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/services"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type ClosePollUC interface {
+	Exec(ctx context.Context, req ClosePollReq) error
+}
+
+type ClosePollReq struct {
+	PollID uuid.UUID
+	UserID uuid.UUID
+}
+
+type ClosePollUseCase struct {
+	querier  pgstore.Querier
+	notifier services.NotificationService
+}
+
+func NewClosePollUseCase(q pgstore.Querier, n services.NotificationService) *ClosePollUseCase {
+	return &ClosePollUseCase{
+		querier:  q,
+		notifier: n,
+	}
+}
+
+var ErrPollAlreadyClosed = errors.New("poll is already closed")
+
+func (uc *ClosePollUseCase) Exec(ctx context.Context, req ClosePollReq) error {
+	poll, err := uc.querier.GetPollById(ctx, req.PollID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrPollNotFound
+		}
+		return fmt.Errorf("failed to fetch poll: %w", err)
+	}
+
+	role, err := uc.querier.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
+		CondominiumID: poll.CondominiumID,
+		UserID:        req.UserID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNoPermission
+		}
+		return err
+	}
+
+	if role != "admin" && role != "syndic" {
+		return ErrNoPermission
+	}
+
+	if pollStatus(poll, time.Now()) == "closed" {
+		return ErrPollAlreadyClosed
+	}
+
+	poll, err = uc.querier.ClosePoll(ctx, poll.ID)
+	if err != nil {
+		return fmt.Errorf("failed to close poll: %w", err)
+	}
+
+	go func() {
+		bgCtx := context.Background()
+
+		err := uc.notifier.SendToCondoResidents(
+			bgCtx,
+			poll.CondominiumID,
+			"🗳️ Votação encerrada",
+			fmt.Sprintf("A votação '%s' foi encerrada. Confira o resultado no app.", poll.Title),
+		)
+		if err != nil {
+			slog.Error("Failed to notify residents about closed poll", "poll_id", poll.ID, "error", err)
+		}
+	}()
+
+	return nil
+}
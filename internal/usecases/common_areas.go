@@ -0,0 +1,127 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/services"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// lockManagedArea locks an area that is not archived for a change by one of its condominium
+// admins or syndics.
+func lockManagedArea(ctx context.Context, qtx *pgstore.Queries, areaID, userID uuid.UUID) (pgstore.CommonArea, error) {
+	area, err := qtx.GetCommonAreaIdForUpdate(ctx, areaID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pgstore.CommonArea{}, ErrCommonAreaNotFound
+		}
+		return pgstore.CommonArea{}, fmt.Errorf("failed to lock common area: %w", err)
+	}
+	if area.ArchivedAt != nil {
+		return pgstore.CommonArea{}, ErrCommonAreaNotFound
+	}
+
+	role, err := qtx.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
+		CondominiumID: area.CondominiumID,
+		UserID:        userID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pgstore.CommonArea{}, ErrNoPermission
+		}
+		return pgstore.CommonArea{}, err
+	}
+
+	if role != "admin" && role != "syndic" {
+		return pgstore.CommonArea{}, ErrNoPermission
+	}
+
+	return area, nil
+}
+
+// areaClosure is what closing an area for a range called off.
+type areaClosure struct {
+	Bookings        []pgstore.Booking
+	WaitlistEntries []pgstore.BookingWaitlistEntry
+}
+
+// closeAreaRange cancels the pending and confirmed bookings overlapping startsAt..endsAt along
+// with their unpaid bills, and drops the waitlist entries for the range. A nil endsAt closes the
+// area for good. It must run after the area row was locked.
+func closeAreaRange(ctx context.Context, qtx *pgstore.Queries, area pgstore.CommonArea, userID uuid.UUID, startsAt time.Time, endsAt *time.Time) (areaClosure, error) {
+	bookings, err := qtx.ListActiveAreaBookingsInRange(ctx, pgstore.ListActiveAreaBookingsInRangeParams{
+		CommonAreaID: area.ID,
+		StartsAt:     startsAt,
+		EndsAt:       endsAt,
+	})
+	if err != nil {
+		return areaClosure{}, fmt.Errorf("failed to list affected bookings: %w", err)
+	}
+
+	var closure areaClosure
+	for _, booking := range bookings {
+		cancelled, err := qtx.CancelBooking(ctx, pgstore.CancelBookingParams{
+			ID:          booking.ID,
+			CancelledBy: utils.ToPtr(userID),
+		})
+		if err != nil {
+			return areaClosure{}, fmt.Errorf("failed to cancel booking %s: %w", booking.ID, err)
+		}
+
+		// The apartment did not call it off, so nothing is kept.
+		if err := cancelBookingCharges(ctx, qtx, cancelled, false); err != nil {
+			return areaClosure{}, err
+		}
+		closure.Bookings = append(closure.Bookings, cancelled)
+	}
+
+	closure.WaitlistEntries, err = qtx.CancelWaitlistEntriesInRange(ctx, pgstore.CancelWaitlistEntriesInRangeParams{
+		CommonAreaID: area.ID,
+		StartsAt:     startsAt,
+		EndsAt:       endsAt,
+	})
+	if err != nil {
+		return areaClosure{}, fmt.Errorf("failed to cancel waitlist entries: %w", err)
+	}
+
+	return closure, nil
+}
+
+// notifyAreaClosure tells each affected user why their booking or waitlist entry was called off.
+// It must be called after the transaction that closed the area commits.
+func notifyAreaClosure(notifier services.NotificationService, areaName, reason string, loc *time.Location, closure areaClosure) {
+	if len(closure.Bookings) == 0 && len(closure.WaitlistEntries) == 0 {
+		return
+	}
+
+	go func() {
+		bgCtx := context.Background()
+
+		for _, booking := range closure.Bookings {
+			title := "🚧 Agendamento cancelado"
+			body := fmt.Sprintf("Seu agendamento de '%s' para %s foi cancelado: %s. As cobranças em aberto da reserva foram canceladas.",
+				areaName, booking.StartsAt.In(loc).Format("02/01 15:04"), reason)
+
+			if err := notifier.SendToUser(bgCtx, booking.UserID, title, body); err != nil {
+				slog.Error("Failed to send async notification", "booking_id", booking.ID, "error", err)
+			}
+		}
+
+		for _, entry := range closure.WaitlistEntries {
+			title := "🚧 Lista de espera encerrada"
+			body := fmt.Sprintf("Sua espera por '%s' para %s foi encerrada: %s.",
+				areaName, entry.StartsAt.In(loc).Format("02/01 15:04"), reason)
+
+			if err := notifier.SendToUser(bgCtx, entry.UserID, title, body); err != nil {
+				slog.Error("Failed to send async notification", "waitlist_entry_id", entry.ID, "error", err)
+			}
+		}
+	}()
+}
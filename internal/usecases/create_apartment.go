@@ -18,6 +18,7 @@ type CreateApartmentReq struct {
 	CondominiumID uuid.UUID
 	Block         string
 	Number        string
+	VotingWeight  *float64
 	UserID        uuid.UUID
 }
 
@@ -34,6 +35,7 @@ func NewCreateApartmentUseCase(q pgstore.Querier) *CreateApartmentUseCase {
 var (
 	ErrCondominiumNotFound       = errors.New("condominium not found")
 	ErrApartmentNumberIsRequired = errors.New("apartment number is required")
+	ErrInvalidVotingWeight       = errors.New("voting weight must be greater than zero")
 )
 
 func (uc *CreateApartmentUseCase) Exec(ctx context.Context, req CreateApartmentReq) (uuid.UUID, error) {
@@ -65,10 +67,15 @@ func (uc *CreateApartmentUseCase) Exec(ctx context.Context, req CreateApartmentR
 		return uuid.Nil, ErrApartmentNumberIsRequired
 	}
 
+	if req.VotingWeight != nil && *req.VotingWeight <= 0 {
+		return uuid.Nil, ErrInvalidVotingWeight
+	}
+
 	args := pgstore.CreateApartmentParams{
 		CondominiumID: condominium.ID,
 		Block:         utils.ToNullString(req.Block),
 		Number:        req.Number,
+		VotingWeight:  req.VotingWeight,
 	}
 
 	id, err := uc.querier.CreateApartment(ctx, args)
@@ -0,0 +1,96 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type CreateBlockedVisitorUC interface {
+	Exec(ctx context.Context, req CreateBlockedVisitorReq) (pgstore.BlockedVisitor, error)
+}
+
+type CreateBlockedVisitorReq struct {
+	CondominiumID uuid.UUID
+	UserID        uuid.UUID
+	Name          string
+	Document      *string
+	Plate         *string
+	Reason        string
+	// ExpiresAt nil keeps the visitor blocked until the entry is removed.
+	ExpiresAt *time.Time
+}
+
+type CreateBlockedVisitorUseCase struct {
+	querier pgstore.Querier
+}
+
+func NewCreateBlockedVisitorUseCase(q pgstore.Querier) *CreateBlockedVisitorUseCase {
+	return &CreateBlockedVisitorUseCase{
+		querier: q,
+	}
+}
+
+var (
+	ErrBlockReasonRequired = errors.New("visitor name and block reason are required")
+	ErrBlockExpiryInPast   = errors.New("block expiration must be in the future")
+)
+
+func (uc *CreateBlockedVisitorUseCase) Exec(ctx context.Context, req CreateBlockedVisitorReq) (pgstore.BlockedVisitor, error) {
+	name := normalizeVisitorName(req.Name)
+	reason := strings.TrimSpace(req.Reason)
+	if name == "" || reason == "" {
+		return pgstore.BlockedVisitor{}, ErrBlockReasonRequired
+	}
+
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return pgstore.BlockedVisitor{}, ErrBlockExpiryInPast
+	}
+
+	var document *string
+	if req.Document != nil {
+		document = utils.ToNullString(utils.NormalizeDocument(*req.Document))
+	}
+
+	var plate *string
+	if req.Plate != nil && strings.TrimSpace(*req.Plate) != "" {
+		p, err := utils.NormalizePlate(*req.Plate)
+		if err != nil {
+			return pgstore.BlockedVisitor{}, ErrInvalidPlate
+		}
+		plate = &p
+	}
+
+	_, err := uc.querier.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
+		CondominiumID: req.CondominiumID,
+		UserID:        req.UserID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pgstore.BlockedVisitor{}, ErrNoPermission
+		}
+		return pgstore.BlockedVisitor{}, err
+	}
+
+	entry, err := uc.querier.CreateBlockedVisitor(ctx, pgstore.CreateBlockedVisitorParams{
+		CondominiumID: req.CondominiumID,
+		Name:          name,
+		Document:      document,
+		Plate:         plate,
+		Reason:        reason,
+		CreatedBy:     req.UserID,
+		ExpiresAt:     req.ExpiresAt,
+	})
+	if err != nil {
+		return pgstore.BlockedVisitor{}, fmt.Errorf("failed to create blocked visitor: %w", err)
+	}
+
+	return entry, nil
+}
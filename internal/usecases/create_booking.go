@@ -8,6 +8,7 @@ import (
 
 	"github.com/Bellorico323/vizen/internal/store/pgstore"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -22,6 +23,8 @@ type CreateBookingReq struct {
 	CommonAreaID  uuid.UUID
 	StartsAt      time.Time
 	EndsAt        time.Time
+	// Headcount is how many people the booking covers. Zero means 1.
+	Headcount int32
 }
 
 type CreateBookingUseCase struct {
@@ -38,6 +41,7 @@ func NewCreateBookingUseCase(pool *pgxpool.Pool, q pgstore.Querier) *CreateBooki
 
 var (
 	ErrTimeSlotTaken      = errors.New("the selected time slot is already booked")
+	ErrAreaAtCapacity     = errors.New("the area has not enough room left at the selected time")
 	ErrInvalidBookingDate = errors.New("start date must be before end date and in the future")
 )
 
@@ -60,6 +64,16 @@ func (uc *CreateBookingUseCase) Exec(ctx context.Context, req CreateBookingReq)
 		return pgstore.Booking{}, ErrNoPermission
 	}
 
+	for attempt := 1; ; attempt++ {
+		booking, err := uc.create(ctx, req)
+		if errors.Is(err, errAreaLockOutdated) && attempt < maxAreaLockAttempts {
+			continue
+		}
+		return booking, err
+	}
+}
+
+func (uc *CreateBookingUseCase) create(ctx context.Context, req CreateBookingReq) (pgstore.Booking, error) {
 	tx, err := uc.pool.Begin(ctx)
 	if err != nil {
 		return pgstore.Booking{}, fmt.Errorf("failed to begin transaction: %w", err)
@@ -68,23 +82,94 @@ func (uc *CreateBookingUseCase) Exec(ctx context.Context, req CreateBookingReq)
 
 	qtx := pgstore.New(tx)
 
-	area, err := qtx.GetCommonAreaIdForUpdate(ctx, req.CommonAreaID)
+	area, err := lockAreaForBooking(ctx, qtx, req.CommonAreaID)
 	if err != nil {
 		return pgstore.Booking{}, fmt.Errorf("failed to lock common area: %w", err)
 	}
 
-	hasConflict, err := qtx.CheckBookingConflict(ctx, pgstore.CheckBookingConflictParams{
-		CommonAreaID: req.CommonAreaID,
-		StartsAt:     req.StartsAt,
-		EndsAt:       req.EndsAt,
-	})
+	booking, err := createLockedBooking(ctx, qtx, area, req, time.Now())
+	if err != nil {
+		return pgstore.Booking{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return pgstore.Booking{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return booking, nil
+}
+
+// maxAreaLockAttempts bounds how many times a booking is retried when the area rules change
+// while its transaction picks the lock mode.
+const maxAreaLockAttempts = 3
+
+// errAreaLockOutdated reports that the area was share locked but its rules changed in the
+// meantime and now need the exclusive lock. The lock is never upgraded in place, since two
+// transactions upgrading their share locks at once deadlock; the transaction is retried instead.
+var errAreaLockOutdated = errors.New("area rules changed before the lock was granted")
+
+// lockAreaForBooking locks the area row before a new booking. Overlapping exclusive bookings are
+// refused by the bookings_no_overlap constraint, so a share lock is enough for most areas:
+// residents booking the same area do not wait for each other, while rule changes, blackouts and
+// waitlist offers still wait for them. Capacity, cool-down and monthly limits are counted inside
+// the transaction, so areas using them are locked exclusively. The lock mode is chosen from an
+// unlocked read of the rules and checked again once the lock is granted.
+func lockAreaForBooking(ctx context.Context, qtx *pgstore.Queries, areaID uuid.UUID) (pgstore.CommonArea, error) {
+	area, err := qtx.GetCommonAreaById(ctx, areaID)
+	if err != nil {
+		return pgstore.CommonArea{}, err
+	}
+	if needsExclusiveAreaLock(area) {
+		return qtx.GetCommonAreaIdForUpdate(ctx, areaID)
+	}
+
+	area, err = qtx.GetCommonAreaForShare(ctx, areaID)
 	if err != nil {
-		return pgstore.Booking{}, fmt.Errorf("failed to check conflicts: %w", err)
+		return pgstore.CommonArea{}, err
 	}
-	if hasConflict {
+	if needsExclusiveAreaLock(area) {
+		return pgstore.CommonArea{}, errAreaLockOutdated
+	}
+
+	return area, nil
+}
+
+func needsExclusiveAreaLock(area pgstore.CommonArea) bool {
+	return area.AllowOverlappingBookings || area.CooldownMinutes > 0 || area.MaxBookingsPerMonth != nil
+}
+
+// createLockedBooking checks the area is open, the headcount, the room left and the area rules,
+// then inserts the booking, billing it right away when the area needs no approval. It must run
+// after the area row was locked. An overlap slipping past the checks is reported as
+// ErrTimeSlotTaken by the bookings_no_overlap constraint.
+func createLockedBooking(ctx context.Context, qtx *pgstore.Queries, area pgstore.CommonArea, req CreateBookingReq, now time.Time) (pgstore.Booking, error) {
+	if err := checkAreaOpen(ctx, qtx, area, req.StartsAt, req.EndsAt); err != nil {
+		return pgstore.Booking{}, err
+	}
+
+	headcount := req.Headcount
+	if headcount == 0 {
+		headcount = 1
+	}
+	if headcount < 0 || (area.Capacity != nil && headcount > *area.Capacity) {
+		return pgstore.Booking{}, ErrInvalidHeadcount
+	}
+
+	hasRoom, err := areaHasRoom(ctx, qtx, area, req.StartsAt, req.EndsAt, headcount)
+	if err != nil {
+		return pgstore.Booking{}, err
+	}
+	if !hasRoom {
+		if area.AllowOverlappingBookings {
+			return pgstore.Booking{}, ErrAreaAtCapacity
+		}
 		return pgstore.Booking{}, ErrTimeSlotTaken
 	}
 
+	if err := checkBookingRules(ctx, qtx, area, req.ApartmentID, req.StartsAt, req.EndsAt, headcount, now); err != nil {
+		return pgstore.Booking{}, err
+	}
+
 	initialStatus := "confirmed"
 	if area.RequiresApproval {
 		initialStatus = "pending"
@@ -94,17 +179,23 @@ func (uc *CreateBookingUseCase) Exec(ctx context.Context, req CreateBookingReq)
 		CondominiumID: req.CondominiumID,
 		ApartmentID:   req.ApartmentID,
 		UserID:        req.UserID,
-		CommonAreaID:  req.CommonAreaID,
+		CommonAreaID:  area.ID,
 		StartsAt:      req.StartsAt,
 		EndsAt:        req.EndsAt,
 		Status:        initialStatus,
+		Headcount:     headcount,
+		Exclusive:     !area.AllowOverlappingBookings,
 	})
 	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23P01" {
+			return pgstore.Booking{}, ErrTimeSlotTaken
+		}
 		return pgstore.Booking{}, fmt.Errorf("failed to create booking: %w", err)
 	}
 
-	if err := tx.Commit(ctx); err != nil {
-		return pgstore.Booking{}, fmt.Errorf("failed to commit transaction: %w", err)
+	if booking.Status == "confirmed" {
+		return billBookingCharges(ctx, qtx, area, booking)
 	}
 
 	return booking, nil
@@ -0,0 +1,118 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	CalendarFeedUser        = "user"
+	CalendarFeedCondominium = "condominium"
+)
+
+type CreateCalendarFeedUC interface {
+	Exec(ctx context.Context, req CreateCalendarFeedReq) (CalendarFeedLink, error)
+}
+
+type CreateCalendarFeedReq struct {
+	UserID        uuid.UUID
+	CondominiumID uuid.UUID
+	// Scope is CalendarFeedUser for the user's own bookings or CalendarFeedCondominium for every
+	// booking of the condominium.
+	Scope string
+}
+
+// CalendarFeedLink is a feed with the secret URL calendar apps subscribe to.
+type CalendarFeedLink struct {
+	Feed pgstore.CalendarFeed
+	URL  string
+}
+
+type CreateCalendarFeedUseCase struct {
+	querier pgstore.Querier
+	signer  *auth.URLSigner
+	baseURL string
+}
+
+func NewCreateCalendarFeedUseCase(q pgstore.Querier, signer *auth.URLSigner, baseURL string) *CreateCalendarFeedUseCase {
+	return &CreateCalendarFeedUseCase{
+		querier: q,
+		signer:  signer,
+		baseURL: strings.TrimRight(baseURL, "/"),
+	}
+}
+
+var ErrInvalidCalendarFeedScope = errors.New("scope must be user or condominium")
+
+// Exec creates a feed URL for the user. Residents and staff can follow their own bookings; the
+// whole condominium calendar is for admins and syndics.
+func (uc *CreateCalendarFeedUseCase) Exec(ctx context.Context, req CreateCalendarFeedReq) (CalendarFeedLink, error) {
+	if req.Scope != CalendarFeedUser && req.Scope != CalendarFeedCondominium {
+		return CalendarFeedLink{}, ErrInvalidCalendarFeedScope
+	}
+
+	if err := checkCalendarFeedAccess(ctx, uc.querier, req.CondominiumID, req.UserID, req.Scope); err != nil {
+		return CalendarFeedLink{}, err
+	}
+
+	feed, err := uc.querier.CreateCalendarFeed(ctx, pgstore.CreateCalendarFeedParams{
+		CondominiumID: req.CondominiumID,
+		UserID:        req.UserID,
+		Scope:         req.Scope,
+	})
+	if err != nil {
+		return CalendarFeedLink{}, fmt.Errorf("failed to create calendar feed: %w", err)
+	}
+
+	return CalendarFeedLink{
+		Feed: feed,
+		URL:  calendarFeedURL(uc.signer, uc.baseURL, feed.Token),
+	}, nil
+}
+
+// checkCalendarFeedAccess returns ErrNoPermission unless the user can read a feed of the given
+// scope: residents and members for their own bookings, admins and syndics for the condominium.
+func checkCalendarFeedAccess(ctx context.Context, q pgstore.Querier, condoID, userID uuid.UUID, scope string) error {
+	if scope == CalendarFeedUser {
+		hasAccess, err := q.CheckUserAccessToCondo(ctx, pgstore.CheckUserAccessToCondoParams{
+			UserID:        userID,
+			CondominiumID: condoID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to check condominium access: %w", err)
+		}
+		if !hasAccess {
+			return ErrNoPermission
+		}
+		return nil
+	}
+
+	role, err := q.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
+		CondominiumID: condoID,
+		UserID:        userID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNoPermission
+		}
+		return err
+	}
+	if role != "admin" && role != "syndic" {
+		return ErrNoPermission
+	}
+
+	return nil
+}
+
+// calendarFeedURL is the secret URL of a feed. Like invite share links it has no expiry of its
+// own: it stops working once the feed is revoked.
+func calendarFeedURL(signer *auth.URLSigner, baseURL string, token uuid.UUID) string {
+	return fmt.Sprintf("%s/api/v1/calendar/%s.ics", baseURL, signedTokenCode(signer, "calendar", token))
+}
@@ -9,10 +9,11 @@ import (
 	"github.com/Bellorico323/vizen/internal/store/pgstore"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type CreateCommonAreaUC interface {
-	Exec(ctx context.Context, req CreateCommonAreaReq) (pgstore.CommonArea, error)
+	Exec(ctx context.Context, req CreateCommonAreaReq) (CommonAreaDetails, error)
 }
 
 type CreateCommonAreaReq struct {
@@ -21,50 +22,103 @@ type CreateCommonAreaReq struct {
 	Name             string
 	Capacity         *int32
 	RequiresApproval bool
+	Rules            BookingRules
+}
+
+// CommonAreaDetails is a common area with its opening hours.
+type CommonAreaDetails struct {
+	pgstore.CommonArea
+	OpeningHours []pgstore.CommonAreaOpeningHour
 }
 
 type CreateCommonAreaUseCase struct {
-	querier pgstore.Querier
+	pool *pgxpool.Pool
 }
 
-func NewCreateCommonAreaUseCase(q pgstore.Querier) *CreateCommonAreaUseCase {
+func NewCreateCommonAreaUseCase(pool *pgxpool.Pool) *CreateCommonAreaUseCase {
 	return &CreateCommonAreaUseCase{
-		querier: q,
+		pool: pool,
 	}
 }
 
 var ErrInvalidAreaName = errors.New("Invalid common area name")
 
-func (uc *CreateCommonAreaUseCase) Exec(ctx context.Context, req CreateCommonAreaReq) (pgstore.CommonArea, error) {
-	userRole, err := uc.querier.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
+func (uc *CreateCommonAreaUseCase) Exec(ctx context.Context, req CreateCommonAreaReq) (CommonAreaDetails, error) {
+	tx, err := uc.pool.Begin(ctx)
+	if err != nil {
+		return CommonAreaDetails{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pgstore.New(tx)
+
+	userRole, err := qtx.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
 		CondominiumID: req.CondominiumID,
 		UserID:        req.UserID,
 	})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return pgstore.CommonArea{}, ErrNoPermission
+			return CommonAreaDetails{}, ErrNoPermission
 		}
 
-		return pgstore.CommonArea{}, err
+		return CommonAreaDetails{}, err
 	}
 
 	if userRole != "admin" && userRole != "syndic" {
-		return pgstore.CommonArea{}, ErrNoPermission
+		return CommonAreaDetails{}, ErrNoPermission
 	}
 
 	if strings.TrimSpace(req.Name) == "" {
-		return pgstore.CommonArea{}, ErrInvalidAreaName
+		return CommonAreaDetails{}, ErrInvalidAreaName
+	}
+
+	hours, err := parseBookingRules(req.Rules)
+	if err != nil {
+		return CommonAreaDetails{}, err
 	}
 
-	area, err := uc.querier.CreateCommonArea(ctx, pgstore.CreateCommonAreaParams{
-		CondominiumID:    req.CondominiumID,
-		Name:             req.Name,
-		Capacity:         req.Capacity,
-		RequiresApproval: req.RequiresApproval,
+	if req.Rules.AllowOverlappingBookings && req.Capacity == nil {
+		return CommonAreaDetails{}, ErrOverlappingNeedsCapacity
+	}
+
+	area, err := qtx.CreateCommonArea(ctx, pgstore.CreateCommonAreaParams{
+		CondominiumID:             req.CondominiumID,
+		Name:                      req.Name,
+		Capacity:                  req.Capacity,
+		RequiresApproval:          req.RequiresApproval,
+		MinDurationMinutes:        req.Rules.MinDurationMinutes,
+		MaxDurationMinutes:        req.Rules.MaxDurationMinutes,
+		MaxAdvanceDays:            req.Rules.MaxAdvanceDays,
+		CooldownMinutes:           req.Rules.CooldownMinutes,
+		MaxBookingsPerMonth:       req.Rules.MaxBookingsPerMonth,
+		CancellationDeadlineHours: req.Rules.CancellationDeadlineHours,
+		LateCancellationFeeCents:  req.Rules.LateCancellationFeeCents,
+		PriceCents:                req.Rules.PriceCents,
+		PricingUnit:               req.Rules.pricingUnit(),
+		DepositCents:              req.Rules.DepositCents,
+		AllowOverlappingBookings:  req.Rules.AllowOverlappingBookings,
+		PendingExpiryHours:        req.Rules.PendingExpiryHours,
+		PendingExpiryAction:       req.Rules.pendingExpiryAction(),
 	})
 	if err != nil {
-		return pgstore.CommonArea{}, fmt.Errorf("failed to create common area: %w", err)
+		return CommonAreaDetails{}, fmt.Errorf("failed to create common area: %w", err)
+	}
+
+	if err := replaceOpeningHours(ctx, qtx, area.ID, hours); err != nil {
+		return CommonAreaDetails{}, err
+	}
+
+	openingHours, err := qtx.ListCommonAreaOpeningHours(ctx, area.ID)
+	if err != nil {
+		return CommonAreaDetails{}, fmt.Errorf("failed to list opening hours: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return CommonAreaDetails{}, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
-	return area, nil
+	return CommonAreaDetails{
+		CommonArea:   area,
+		OpeningHours: openingHours,
+	}, nil
 }
@@ -0,0 +1,111 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/services"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type CreateCommonAreaBlackoutUC interface {
+	Exec(ctx context.Context, req CreateCommonAreaBlackoutReq) (CreatedBlackout, error)
+}
+
+type CreateCommonAreaBlackoutReq struct {
+	UserID       uuid.UUID
+	CommonAreaID uuid.UUID
+	StartsAt     time.Time
+	EndsAt       time.Time
+	Reason       *string
+}
+
+// CreatedBlackout carries the bookings called off by the blackout.
+type CreatedBlackout struct {
+	Blackout          pgstore.CommonAreaBlackout
+	CancelledBookings []pgstore.Booking
+}
+
+type CreateCommonAreaBlackoutUseCase struct {
+	pool     *pgxpool.Pool
+	notifier services.NotificationService
+}
+
+func NewCreateCommonAreaBlackoutUseCase(pool *pgxpool.Pool, n services.NotificationService) *CreateCommonAreaBlackoutUseCase {
+	return &CreateCommonAreaBlackoutUseCase{
+		pool:     pool,
+		notifier: n,
+	}
+}
+
+var ErrInvalidBlackoutRange = errors.New("blackout must end after it starts and in the future")
+
+// Exec closes an area for a period. Pending and confirmed bookings overlapping it are cancelled,
+// along with their unpaid bills and the waitlist for the period, and each affected user is
+// notified. New bookings overlapping it are refused.
+func (uc *CreateCommonAreaBlackoutUseCase) Exec(ctx context.Context, req CreateCommonAreaBlackoutReq) (CreatedBlackout, error) {
+	if !req.EndsAt.After(req.StartsAt) || !req.EndsAt.After(time.Now()) {
+		return CreatedBlackout{}, ErrInvalidBlackoutRange
+	}
+
+	tx, err := uc.pool.Begin(ctx)
+	if err != nil {
+		return CreatedBlackout{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pgstore.New(tx)
+
+	// Same lock as CreateBookingUseCase, so no booking slips into the period meanwhile.
+	area, err := lockManagedArea(ctx, qtx, req.CommonAreaID, req.UserID)
+	if err != nil {
+		return CreatedBlackout{}, err
+	}
+
+	condo, err := qtx.GetCondominiumById(ctx, area.CondominiumID)
+	if err != nil {
+		return CreatedBlackout{}, fmt.Errorf("failed to get condominium: %w", err)
+	}
+
+	loc, err := time.LoadLocation(condo.Timezone)
+	if err != nil {
+		return CreatedBlackout{}, fmt.Errorf("invalid condominium timezone %q: %w", condo.Timezone, err)
+	}
+
+	blackout, err := qtx.CreateCommonAreaBlackout(ctx, pgstore.CreateCommonAreaBlackoutParams{
+		CondominiumID: area.CondominiumID,
+		CommonAreaID:  area.ID,
+		StartsAt:      req.StartsAt,
+		EndsAt:        req.EndsAt,
+		Reason:        req.Reason,
+		CreatedBy:     utils.ToPtr(req.UserID),
+	})
+	if err != nil {
+		return CreatedBlackout{}, fmt.Errorf("failed to create blackout: %w", err)
+	}
+
+	closure, err := closeAreaRange(ctx, qtx, area, req.UserID, req.StartsAt, &req.EndsAt)
+	if err != nil {
+		return CreatedBlackout{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return CreatedBlackout{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	reason := "a área estará fechada nesse período"
+	if req.Reason != nil && *req.Reason != "" {
+		reason = fmt.Sprintf("a área estará fechada nesse período (%s)", *req.Reason)
+	}
+	notifyAreaClosure(uc.notifier, area.Name, reason, loc, closure)
+
+	return CreatedBlackout{
+		Blackout:          blackout,
+		CancelledBookings: closure.Bookings,
+	}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Bellorico323/vizen/internal/store/pgstore"
 	"github.com/google/uuid"
@@ -19,6 +20,8 @@ type CreateCondominiumReq struct {
 	Cnpj     string
 	Address  string
 	PlanType string
+	// Timezone is an IANA name used to evaluate local schedules; defaults to DefaultCondominiumTimezone.
+	Timezone *string
 	UserID   uuid.UUID
 }
 
@@ -33,15 +36,26 @@ func NewCreateCondominiumUseCase(p *pgxpool.Pool) *CreateCondominiumUseCase {
 }
 
 var (
-	ErrUserNotFound = errors.New("user not found")
-	ErrNoPermission = errors.New("user does not have permission")
+	ErrUserNotFound    = errors.New("user not found")
+	ErrNoPermission    = errors.New("user does not have permission")
+	ErrInvalidTimezone = errors.New("invalid timezone")
 )
 
+const DefaultCondominiumTimezone = "America/Sao_Paulo"
+
 func (uc *CreateCondominiumUseCase) Exec(ctx context.Context, req CreateCondominiumReq) (uuid.UUID, error) {
 	if !isValidPlanType(req.PlanType) {
 		return uuid.Nil, fmt.Errorf("invalid plan type")
 	}
 
+	timezone := DefaultCondominiumTimezone
+	if req.Timezone != nil {
+		if _, err := time.LoadLocation(*req.Timezone); err != nil || *req.Timezone == "" {
+			return uuid.Nil, ErrInvalidTimezone
+		}
+		timezone = *req.Timezone
+	}
+
 	tx, err := uc.pool.Begin(ctx)
 	if err != nil {
 		return uuid.Nil, fmt.Errorf("failed to begin transaction: %w", err)
@@ -61,6 +75,7 @@ func (uc *CreateCondominiumUseCase) Exec(ctx context.Context, req CreateCondomin
 		Cnpj:     req.Cnpj,
 		Address:  req.Address,
 		PlanType: req.PlanType,
+		Timezone: timezone,
 	})
 	if err != nil {
 		return uuid.Nil, err
@@ -4,15 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/utils"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 )
 
 type CreateInviteUC interface {
-	Exec(ctx context.Context, req CreateInviteReq) (pgstore.Invite, error)
+	Exec(ctx context.Context, req CreateInviteReq) (CreateInviteResult, error)
 }
 
 type CreateInviteReq struct {
@@ -23,6 +25,27 @@ type CreateInviteReq struct {
 	GuestType     *string
 	StartsAt      time.Time
 	EndsAt        time.Time
+
+	// Recurring invites are only valid on Weekdays (0 = Sunday) between DailyStartTime and
+	// DailyEndTime ("HH:MM", condominium local time), from StartsAt until EndsAt.
+	Weekdays       []int16
+	DailyStartTime *string
+	DailyEndTime   *string
+
+	// MaxEntries limits how many times the token can be validated. Nil means unlimited, except for
+	// deliveries, which are single-use by default.
+	MaxEntries *int32
+
+	// GuestPlate lets the guest in by plate at the vehicle gate.
+	GuestPlate *string
+}
+
+type CreateInviteResult struct {
+	Invite pgstore.Invite
+	// GuestNameBlocked warns that the guest name matches an entry of the condominium blocklist. Name
+	// matches may be homonyms, so the invite is still created, but the gate will refuse the guest
+	// while the entry is active.
+	GuestNameBlocked bool
 }
 
 type CreateInviteUseCase struct {
@@ -39,19 +62,20 @@ var (
 	ErrGuestNameIsRequired    = errors.New("guest name is required")
 	ErrInviteInvalidTimeRange = errors.New("start date is lower than end date")
 	ErrInviteInThePast        = errors.New("invite expiration must be in the future")
+	ErrInvalidMaxEntries      = errors.New("max entries must be greater than zero")
 )
 
-func (uc *CreateInviteUseCase) Exec(ctx context.Context, req CreateInviteReq) (pgstore.Invite, error) {
+func (uc *CreateInviteUseCase) Exec(ctx context.Context, req CreateInviteReq) (CreateInviteResult, error) {
 	apt, err := uc.querier.GetApartmentById(ctx, req.ApartmentID)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return pgstore.Invite{}, ErrApartmentNotFound
+			return CreateInviteResult{}, ErrApartmentNotFound
 		}
-		return pgstore.Invite{}, fmt.Errorf("failed to fetch apartment: %w", err)
+		return CreateInviteResult{}, fmt.Errorf("failed to fetch apartment: %w", err)
 	}
 
 	if apt.CondominiumID != req.CondominiumID {
-		return pgstore.Invite{}, ErrApartmentIsNotFromCondominium
+		return CreateInviteResult{}, ErrApartmentIsNotFromCondominium
 	}
 
 	isResident, err := uc.querier.CheckIsResident(ctx, pgstore.CheckIsResidentParams{
@@ -59,23 +83,28 @@ func (uc *CreateInviteUseCase) Exec(ctx context.Context, req CreateInviteReq) (p
 		ApartmentID: req.ApartmentID,
 	})
 	if err != nil {
-		return pgstore.Invite{}, fmt.Errorf("Error while checking if user is resident: %w", err)
+		return CreateInviteResult{}, fmt.Errorf("Error while checking if user is resident: %w", err)
 	}
 
 	if !isResident {
-		return pgstore.Invite{}, ErrNoPermission
+		return CreateInviteResult{}, ErrNoPermission
 	}
 
 	if req.GuestName == "" {
-		return pgstore.Invite{}, ErrGuestNameIsRequired
+		return CreateInviteResult{}, ErrGuestNameIsRequired
 	}
 
 	if req.EndsAt.Before(req.StartsAt) {
-		return pgstore.Invite{}, ErrInviteInvalidTimeRange
+		return CreateInviteResult{}, ErrInviteInvalidTimeRange
 	}
 
 	if req.EndsAt.Before(time.Now()) {
-		return pgstore.Invite{}, ErrInviteInThePast
+		return CreateInviteResult{}, ErrInviteInThePast
+	}
+
+	recurrence, err := parseInviteRecurrence(req.Weekdays, req.DailyStartTime, req.DailyEndTime)
+	if err != nil {
+		return CreateInviteResult{}, err
 	}
 
 	guestType := "guest"
@@ -83,18 +112,63 @@ func (uc *CreateInviteUseCase) Exec(ctx context.Context, req CreateInviteReq) (p
 		guestType = *req.GuestType
 	}
 
+	maxEntries := req.MaxEntries
+	if maxEntries == nil && guestType == "delivery" {
+		maxEntries = utils.ToPtr(int32(1))
+	}
+	if maxEntries != nil && *maxEntries < 1 {
+		return CreateInviteResult{}, ErrInvalidMaxEntries
+	}
+
+	var guestPlate *string
+	if req.GuestPlate != nil {
+		plate, err := utils.NormalizePlate(*req.GuestPlate)
+		if err != nil {
+			return CreateInviteResult{}, ErrInvalidPlate
+		}
+		guestPlate = &plate
+	}
+
+	blocked, err := findBlockedVisitors(ctx, uc.querier, req.CondominiumID, visitorIdentity{
+		Name:  req.GuestName,
+		Plate: guestPlate,
+	})
+	if err != nil {
+		return CreateInviteResult{}, err
+	}
+
+	var nameBlocked bool
+	for _, entry := range blocked {
+		// Entries lifted before the invite starts do not affect it.
+		if entry.ExpiresAt != nil && !entry.ExpiresAt.After(req.StartsAt) {
+			continue
+		}
+		if entry.Plate != nil && guestPlate != nil && slices.Contains(utils.PlateVariants(*guestPlate), *entry.Plate) {
+			return CreateInviteResult{}, ErrVisitorBlocked
+		}
+		nameBlocked = true
+	}
+
 	invite, err := uc.querier.CreateInvite(ctx, pgstore.CreateInviteParams{
-		CondominiumID: req.CondominiumID,
-		ApartmentID:   req.ApartmentID,
-		IssuedBy:      req.IssuedBy,
-		GuestName:     req.GuestName,
-		GuestType:     guestType,
-		StartsAt:      req.StartsAt,
-		EndsAt:        req.EndsAt,
+		CondominiumID:      req.CondominiumID,
+		ApartmentID:        req.ApartmentID,
+		IssuedBy:           req.IssuedBy,
+		GuestName:          req.GuestName,
+		GuestType:          guestType,
+		StartsAt:           req.StartsAt,
+		EndsAt:             req.EndsAt,
+		RecurrenceWeekdays: recurrence.weekdays,
+		DailyStartMinute:   recurrence.startMinute,
+		DailyEndMinute:     recurrence.endMinute,
+		MaxEntries:         maxEntries,
+		GuestPlate:         guestPlate,
 	})
 	if err != nil {
-		return pgstore.Invite{}, err
+		return CreateInviteResult{}, err
 	}
 
-	return invite, nil
+	return CreateInviteResult{
+		Invite:           invite,
+		GuestNameBlocked: nameBlocked,
+	}, nil
 }
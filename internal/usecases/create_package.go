@@ -2,26 +2,42 @@ package usecases
 
 import (
 	"context"
+	"crypto/rand"
 	"errors"
 	"fmt"
 	"log/slog"
+	"math/big"
+	"strings"
 
 	"github.com/Bellorico323/vizen/internal/services"
 	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/utils"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 )
 
 type CreatePackageUC interface {
-	Exec(ctx context.Context, req CreatePackageReq) (uuid.UUID, error)
+	Exec(ctx context.Context, req CreatePackageReq) (CreatePackageResult, error)
 }
 
 type CreatePackageReq struct {
-	CondominiumID uuid.UUID
-	ApartmentID   uuid.UUID
-	ReceivedBy    uuid.UUID
-	RecipientName *string
-	PhotoUrl      *string
+	CondominiumID   uuid.UUID
+	ApartmentID     uuid.UUID
+	ReceivedBy      uuid.UUID
+	RecipientName   *string
+	PhotoUrl        *string
+	Carrier         *string
+	TrackingCode    *string
+	SizeCategory    *string
+	IsPerishable    bool
+	IsFragile       bool
+	StorageLocation *string
+}
+
+type CreatePackageResult struct {
+	PackageID uuid.UUID
+	// DuplicateOf lists packages already registered with the same tracking code, usually a double scan.
+	DuplicateOf []uuid.UUID
 }
 
 type CreatePackageUseCase struct {
@@ -36,41 +52,72 @@ func NewCreatePackageUseCase(q pgstore.Querier, n services.NotificationService)
 	}
 }
 
-var ErrApartmentIsNotFromCondominium = errors.New("Apartment is not from condominium")
+var (
+	ErrApartmentIsNotFromCondominium = errors.New("Apartment is not from condominium")
+	ErrInvalidPackageSize            = errors.New("Invalid package size category")
+)
+
+var packageSizeCategories = map[string]bool{
+	"envelope":    true,
+	"small":       true,
+	"medium":      true,
+	"large":       true,
+	"extra_large": true,
+}
+
+// NormalizeTrackingCode strips spaces and upper-cases codes so scans and typed codes match.
+func NormalizeTrackingCode(code *string) *string {
+	if code == nil {
+		return nil
+	}
+
+	normalized := strings.ToUpper(strings.Join(strings.Fields(*code), ""))
+	return utils.ToNullString(normalized)
+}
+
+const pickupCodeDigits = 6
+
+// generatePickupCode returns a random numeric code the resident shows at the desk.
+func generatePickupCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%0*d", pickupCodeDigits, n.Int64()), nil
+}
+
+func (uc *CreatePackageUseCase) Exec(ctx context.Context, req CreatePackageReq) (CreatePackageResult, error) {
+	if req.SizeCategory != nil && !packageSizeCategories[*req.SizeCategory] {
+		return CreatePackageResult{}, ErrInvalidPackageSize
+	}
 
-func (uc *CreatePackageUseCase) Exec(ctx context.Context, req CreatePackageReq) (uuid.UUID, error) {
 	_, err := uc.querier.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
 		CondominiumID: req.CondominiumID,
 		UserID:        req.ReceivedBy,
 	})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return uuid.Nil, ErrNoPermission
+			return CreatePackageResult{}, ErrNoPermission
 		}
-		return uuid.Nil, err
+		return CreatePackageResult{}, err
 	}
 
 	apartment, err := uc.querier.GetApartmentById(ctx, req.ApartmentID)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return uuid.Nil, ErrApartmentNotFound
+			return CreatePackageResult{}, ErrApartmentNotFound
 		}
-		return uuid.Nil, fmt.Errorf("failed to find apartment: %w", err)
+		return CreatePackageResult{}, fmt.Errorf("failed to find apartment: %w", err)
 	}
 
 	if apartment.CondominiumID != req.CondominiumID {
-		return uuid.Nil, ErrApartmentIsNotFromCondominium
+		return CreatePackageResult{}, ErrApartmentIsNotFromCondominium
 	}
 
-	packg, err := uc.querier.CreatePackage(ctx, pgstore.CreatePackageParams{
-		CondominiumID: req.CondominiumID,
-		ApartmentID:   apartment.ID,
-		ReceivedBy:    req.ReceivedBy,
-		RecipientName: req.RecipientName,
-		PhotoUrl:      req.PhotoUrl,
-	})
+	packg, duplicateOf, err := insertPackage(ctx, uc.querier, apartment, req)
 	if err != nil {
-		return uuid.Nil, fmt.Errorf("failed to create package: %w", err)
+		return CreatePackageResult{}, err
 	}
 
 	title := "📦 Chegou Encomenda!"
@@ -79,14 +126,77 @@ func (uc *CreatePackageUseCase) Exec(ctx context.Context, req CreatePackageReq)
 		body = fmt.Sprintf("Encomenda para %s chegou na portaria.", *req.RecipientName)
 	}
 
+	pickupCode := derefString(packg.PickupCode)
+	body += fmt.Sprintf(" Código de retirada: %s", pickupCode)
+
 	go func() {
 		bgCtx := context.Background()
 
-		err = uc.notifier.SendToApartmentResidents(bgCtx, apartment.ID, packg.ID, title, body)
+		err := uc.notifier.SendToApartmentResidents(bgCtx, apartment.ID, title, body, map[string]string{
+			"type":       "PACKAGE_ARRIVED",
+			"packageId":  packg.ID.String(),
+			"pickupCode": pickupCode,
+		})
 		if err != nil {
 			slog.Error("Failed to send async notification", "package_id", packg.ID, "error", err)
 		}
 	}()
 
-	return packg.ID, nil
+	return CreatePackageResult{
+		PackageID:   packg.ID,
+		DuplicateOf: duplicateOf,
+	}, nil
+}
+
+// insertPackage registers a package for an already validated apartment, generating its pickup code
+// and looking up previous packages with the same tracking code.
+func insertPackage(ctx context.Context, q pgstore.Querier, apartment pgstore.Apartment, req CreatePackageReq) (pgstore.Package, []uuid.UUID, error) {
+	req.TrackingCode = NormalizeTrackingCode(req.TrackingCode)
+
+	var duplicateOf []uuid.UUID
+	if req.TrackingCode != nil {
+		existing, err := q.ListPackagesByTrackingCode(ctx, pgstore.ListPackagesByTrackingCodeParams{
+			CondominiumID: req.CondominiumID,
+			TrackingCode:  req.TrackingCode,
+		})
+		if err != nil {
+			return pgstore.Package{}, nil, fmt.Errorf("failed to check tracking code: %w", err)
+		}
+
+		for _, pkg := range existing {
+			duplicateOf = append(duplicateOf, pkg.ID)
+		}
+	}
+
+	pickupCode, err := generatePickupCode()
+	if err != nil {
+		return pgstore.Package{}, nil, fmt.Errorf("failed to generate pickup code: %w", err)
+	}
+
+	packg, err := q.CreatePackage(ctx, pgstore.CreatePackageParams{
+		CondominiumID:   req.CondominiumID,
+		ApartmentID:     apartment.ID,
+		ReceivedBy:      req.ReceivedBy,
+		RecipientName:   req.RecipientName,
+		PhotoUrl:        req.PhotoUrl,
+		PickupCode:      &pickupCode,
+		Carrier:         utils.ToNullString(derefString(req.Carrier)),
+		TrackingCode:    req.TrackingCode,
+		SizeCategory:    req.SizeCategory,
+		IsPerishable:    req.IsPerishable,
+		IsFragile:       req.IsFragile,
+		StorageLocation: utils.ToNullString(derefString(req.StorageLocation)),
+	})
+	if err != nil {
+		return pgstore.Package{}, nil, fmt.Errorf("failed to create package: %w", err)
+	}
+
+	return packg, duplicateOf, nil
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
 }
@@ -0,0 +1,184 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/Bellorico323/vizen/internal/services"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type CreatePackagesBatchUC interface {
+	Exec(ctx context.Context, req CreatePackagesBatchReq) ([]CreatePackagesBatchItemResult, error)
+}
+
+type CreatePackagesBatchReq struct {
+	CondominiumID uuid.UUID
+	ReceivedBy    uuid.UUID
+	// Items only use the package fields; condominium and receiver come from the batch.
+	Items []CreatePackageReq
+}
+
+// CreatePackagesBatchItemResult holds the outcome of one item, in the same order as the request.
+// Err is set when the item was rejected, in which case nothing was created for it.
+type CreatePackagesBatchItemResult struct {
+	PackageID   *uuid.UUID
+	DuplicateOf []uuid.UUID
+	Err         error
+}
+
+type CreatePackagesBatchUseCase struct {
+	pool     *pgxpool.Pool
+	querier  pgstore.Querier
+	notifier services.NotificationService
+}
+
+func NewCreatePackagesBatchUseCase(pool *pgxpool.Pool, q pgstore.Querier, n services.NotificationService) *CreatePackagesBatchUseCase {
+	return &CreatePackagesBatchUseCase{
+		pool:     pool,
+		querier:  q,
+		notifier: n,
+	}
+}
+
+const MaxPackagesPerBatch = 100
+
+var (
+	ErrEmptyPackageBatch    = errors.New("batch must contain at least one package")
+	ErrPackageBatchTooLarge = fmt.Errorf("batch cannot contain more than %d packages", MaxPackagesPerBatch)
+)
+
+// arrivedGroup collects the packages of one apartment so residents get a single push.
+type arrivedGroup struct {
+	apartment   pgstore.Apartment
+	packageIDs  []string
+	pickupCodes []string
+}
+
+func (uc *CreatePackagesBatchUseCase) Exec(ctx context.Context, req CreatePackagesBatchReq) ([]CreatePackagesBatchItemResult, error) {
+	if len(req.Items) == 0 {
+		return nil, ErrEmptyPackageBatch
+	}
+	if len(req.Items) > MaxPackagesPerBatch {
+		return nil, ErrPackageBatchTooLarge
+	}
+
+	_, err := uc.querier.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
+		CondominiumID: req.CondominiumID,
+		UserID:        req.ReceivedBy,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNoPermission
+		}
+		return nil, err
+	}
+
+	tx, err := uc.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pgstore.New(tx)
+
+	results := make([]CreatePackagesBatchItemResult, len(req.Items))
+	apartments := make(map[uuid.UUID]*pgstore.Apartment)
+	groups := make(map[uuid.UUID]*arrivedGroup)
+	var groupOrder []uuid.UUID
+
+	for i, item := range req.Items {
+		item.CondominiumID = req.CondominiumID
+		item.ReceivedBy = req.ReceivedBy
+
+		if item.SizeCategory != nil && !packageSizeCategories[*item.SizeCategory] {
+			results[i].Err = ErrInvalidPackageSize
+			continue
+		}
+
+		apartment, ok := apartments[item.ApartmentID]
+		if !ok {
+			apt, err := qtx.GetApartmentById(ctx, item.ApartmentID)
+			if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+				return nil, fmt.Errorf("failed to find apartment: %w", err)
+			}
+			if err == nil {
+				apartment = &apt
+			}
+			apartments[item.ApartmentID] = apartment
+		}
+
+		if apartment == nil {
+			results[i].Err = ErrApartmentNotFound
+			continue
+		}
+		if apartment.CondominiumID != req.CondominiumID {
+			results[i].Err = ErrApartmentIsNotFromCondominium
+			continue
+		}
+
+		packg, duplicateOf, err := insertPackage(ctx, qtx, *apartment, item)
+		if err != nil {
+			return nil, err
+		}
+
+		results[i].PackageID = &packg.ID
+		results[i].DuplicateOf = duplicateOf
+
+		group, ok := groups[apartment.ID]
+		if !ok {
+			group = &arrivedGroup{apartment: *apartment}
+			groups[apartment.ID] = group
+			groupOrder = append(groupOrder, apartment.ID)
+		}
+		group.packageIDs = append(group.packageIDs, packg.ID.String())
+		group.pickupCodes = append(group.pickupCodes, derefString(packg.PickupCode))
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	go func() {
+		bgCtx := context.Background()
+
+		for _, apartmentID := range groupOrder {
+			group := groups[apartmentID]
+
+			title := "📦 Chegou Encomenda!"
+			body := fmt.Sprintf("Uma nova encomenda para %s chegou na portaria. Código de retirada: %s",
+				group.apartment.Number, group.pickupCodes[0])
+			data := map[string]string{
+				"type":       "PACKAGE_ARRIVED",
+				"packageId":  group.packageIDs[0],
+				"pickupCode": group.pickupCodes[0],
+			}
+
+			if len(group.packageIDs) > 1 {
+				title = "📦 Chegaram Encomendas!"
+				body = fmt.Sprintf("%d encomendas para %s chegaram na portaria. Códigos de retirada: %s",
+					len(group.packageIDs), group.apartment.Number, strings.Join(group.pickupCodes, ", "))
+				data = map[string]string{
+					"type":        "PACKAGES_ARRIVED",
+					"packageIds":  strings.Join(group.packageIDs, ","),
+					"pickupCodes": strings.Join(group.pickupCodes, ","),
+					"count":       strconv.Itoa(len(group.packageIDs)),
+				}
+			}
+
+			err := uc.notifier.SendToApartmentResidents(bgCtx, apartmentID, title, body, data)
+			if err != nil {
+				slog.Error("Failed to send async notification", "apartment_id", apartmentID, "error", err)
+			}
+		}
+	}()
+
+	return results, nil
+}
@@ -0,0 +1,115 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type CreatePickupAuthorizationUC interface {
+	Exec(ctx context.Context, req CreatePickupAuthorizationReq) (pgstore.PackagePickupAuthorization, error)
+}
+
+type CreatePickupAuthorizationReq struct {
+	UserID      uuid.UUID
+	ApartmentID uuid.UUID
+	PackageID   *uuid.UUID
+	Name        string
+	Document    string
+	ValidFrom   *time.Time
+	ValidUntil  time.Time
+}
+
+type CreatePickupAuthorizationUseCase struct {
+	querier pgstore.Querier
+}
+
+func NewCreatePickupAuthorizationUseCase(q pgstore.Querier) *CreatePickupAuthorizationUseCase {
+	return &CreatePickupAuthorizationUseCase{
+		querier: q,
+	}
+}
+
+var (
+	ErrInvalidAuthorizationPeriod = errors.New("authorization must end after it starts and in the future")
+	ErrInvalidAuthorizedDocument  = errors.New("authorized person document is required")
+	ErrPackageNotFromApartment    = errors.New("package does not belong to this apartment")
+)
+
+func (uc *CreatePickupAuthorizationUseCase) Exec(ctx context.Context, req CreatePickupAuthorizationReq) (pgstore.PackagePickupAuthorization, error) {
+	document := utils.NormalizeDocument(req.Document)
+	if document == "" {
+		return pgstore.PackagePickupAuthorization{}, ErrInvalidAuthorizedDocument
+	}
+
+	now := time.Now()
+	validFrom := now
+	if req.ValidFrom != nil {
+		validFrom = *req.ValidFrom
+	}
+
+	if !req.ValidUntil.After(validFrom) || req.ValidUntil.Before(now) {
+		return pgstore.PackagePickupAuthorization{}, ErrInvalidAuthorizationPeriod
+	}
+
+	apartment, err := uc.querier.GetApartmentById(ctx, req.ApartmentID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pgstore.PackagePickupAuthorization{}, ErrApartmentNotFound
+		}
+		return pgstore.PackagePickupAuthorization{}, fmt.Errorf("failed to find apartment: %w", err)
+	}
+
+	isResident, err := uc.querier.CheckIsResident(ctx, pgstore.CheckIsResidentParams{
+		UserID:      req.UserID,
+		ApartmentID: apartment.ID,
+	})
+	if err != nil {
+		return pgstore.PackagePickupAuthorization{}, fmt.Errorf("failed to check resident: %w", err)
+	}
+
+	if !isResident {
+		return pgstore.PackagePickupAuthorization{}, ErrNoPermission
+	}
+
+	if req.PackageID != nil {
+		pkg, err := uc.querier.GetPackageById(ctx, *req.PackageID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return pgstore.PackagePickupAuthorization{}, ErrPackageNotFound
+			}
+			return pgstore.PackagePickupAuthorization{}, fmt.Errorf("failed to get package: %w", err)
+		}
+
+		if pkg.ApartmentID != apartment.ID {
+			return pgstore.PackagePickupAuthorization{}, ErrPackageNotFromApartment
+		}
+
+		if pkg.Status != "pending" {
+			return pgstore.PackagePickupAuthorization{}, ErrPackageAlreadyWithdrawn
+		}
+	}
+
+	authorization, err := uc.querier.CreatePackagePickupAuthorization(ctx, pgstore.CreatePackagePickupAuthorizationParams{
+		CondominiumID:      apartment.CondominiumID,
+		ApartmentID:        apartment.ID,
+		PackageID:          req.PackageID,
+		AuthorizedName:     strings.TrimSpace(req.Name),
+		AuthorizedDocument: document,
+		ValidFrom:          validFrom,
+		ValidUntil:         req.ValidUntil,
+		CreatedBy:          req.UserID,
+	})
+	if err != nil {
+		return pgstore.PackagePickupAuthorization{}, fmt.Errorf("failed to create pickup authorization: %w", err)
+	}
+
+	return authorization, nil
+}
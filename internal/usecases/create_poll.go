@@ -0,0 +1,164 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type CreatePollUC interface {
+	Exec(ctx context.Context, req CreatePollReq) (PollDetails, error)
+}
+
+type CreatePollReq struct {
+	CondominiumID     uuid.UUID
+	UserID            uuid.UUID
+	Title             string
+	Description       *string
+	ChoiceType        string
+	IsAnonymous       bool
+	IsWeighted        bool
+	ResultsVisibility string
+	OpensAt           *time.Time
+	ClosesAt          time.Time
+	Options           []string
+}
+
+type CreatePollUseCase struct {
+	pool *pgxpool.Pool
+}
+
+func NewCreatePollUseCase(pool *pgxpool.Pool) *CreatePollUseCase {
+	return &CreatePollUseCase{
+		pool: pool,
+	}
+}
+
+var (
+	ErrPollTitleRequired        = errors.New("poll title is required")
+	ErrPollNotEnoughOptions     = errors.New("poll must have at least two options")
+	ErrPollDuplicatedOption     = errors.New("poll options must be unique")
+	ErrPollInvalidPeriod        = errors.New("poll must close after it opens and in the future")
+	ErrInvalidPollChoiceType    = errors.New("Invalid poll choice type")
+	ErrInvalidResultsVisibility = errors.New("Invalid poll results visibility")
+)
+
+func (uc *CreatePollUseCase) Exec(ctx context.Context, req CreatePollReq) (PollDetails, error) {
+	if strings.TrimSpace(req.Title) == "" {
+		return PollDetails{}, ErrPollTitleRequired
+	}
+
+	if req.ChoiceType == "" {
+		req.ChoiceType = "single"
+	}
+	if req.ChoiceType != "single" && req.ChoiceType != "multiple" {
+		return PollDetails{}, ErrInvalidPollChoiceType
+	}
+
+	if req.ResultsVisibility == "" {
+		req.ResultsVisibility = "after_close"
+	}
+	if req.ResultsVisibility != "live" && req.ResultsVisibility != "after_close" {
+		return PollDetails{}, ErrInvalidResultsVisibility
+	}
+
+	options := make([]string, 0, len(req.Options))
+	seen := make(map[string]bool, len(req.Options))
+	for _, opt := range req.Options {
+		label := strings.TrimSpace(opt)
+		if label == "" {
+			continue
+		}
+
+		key := strings.ToLower(label)
+		if seen[key] {
+			return PollDetails{}, ErrPollDuplicatedOption
+		}
+		seen[key] = true
+		options = append(options, label)
+	}
+
+	if len(options) < 2 {
+		return PollDetails{}, ErrPollNotEnoughOptions
+	}
+
+	now := time.Now()
+	opensAt := now
+	if req.OpensAt != nil {
+		opensAt = *req.OpensAt
+	}
+
+	if !req.ClosesAt.After(opensAt) || req.ClosesAt.Before(now) {
+		return PollDetails{}, ErrPollInvalidPeriod
+	}
+
+	tx, err := uc.pool.Begin(ctx)
+	if err != nil {
+		return PollDetails{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pgstore.New(tx)
+
+	role, err := qtx.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
+		CondominiumID: req.CondominiumID,
+		UserID:        req.UserID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return PollDetails{}, ErrNoPermission
+		}
+		return PollDetails{}, fmt.Errorf("failed to check permission: %w", err)
+	}
+
+	if role != "admin" && role != "syndic" {
+		return PollDetails{}, ErrNoPermission
+	}
+
+	poll, err := qtx.CreatePoll(ctx, pgstore.CreatePollParams{
+		CondominiumID:     req.CondominiumID,
+		CreatedBy:         utils.ToPtr(req.UserID),
+		Title:             strings.TrimSpace(req.Title),
+		Description:       req.Description,
+		ChoiceType:        req.ChoiceType,
+		IsAnonymous:       req.IsAnonymous,
+		IsWeighted:        req.IsWeighted,
+		ResultsVisibility: req.ResultsVisibility,
+		OpensAt:           opensAt,
+		ClosesAt:          req.ClosesAt,
+	})
+	if err != nil {
+		return PollDetails{}, fmt.Errorf("failed to create poll: %w", err)
+	}
+
+	createdOptions := make([]pgstore.PollOption, 0, len(options))
+	for i, label := range options {
+		option, err := qtx.CreatePollOption(ctx, pgstore.CreatePollOptionParams{
+			PollID:   poll.ID,
+			Label:    label,
+			Position: int32(i + 1),
+		})
+		if err != nil {
+			return PollDetails{}, fmt.Errorf("failed to create poll option: %w", err)
+		}
+		createdOptions = append(createdOptions, option)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return PollDetails{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return PollDetails{
+		Poll:    poll,
+		Status:  pollStatus(poll, now),
+		Options: createdOptions,
+	}, nil
+}
@@ -0,0 +1,97 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type CreateVehicleUC interface {
+	Exec(ctx context.Context, req CreateVehicleReq) (pgstore.Vehicle, error)
+}
+
+type CreateVehicleReq struct {
+	UserID      uuid.UUID
+	ApartmentID uuid.UUID
+	Plate       string
+	Model       string
+	Color       string
+}
+
+type CreateVehicleUseCase struct {
+	querier pgstore.Querier
+}
+
+func NewCreateVehicleUseCase(q pgstore.Querier) *CreateVehicleUseCase {
+	return &CreateVehicleUseCase{
+		querier: q,
+	}
+}
+
+var (
+	ErrInvalidPlate             = utils.ErrInvalidPlate
+	ErrVehicleModelRequired     = errors.New("vehicle model and color are required")
+	ErrVehicleAlreadyRegistered = errors.New("a vehicle with this plate is already registered in the condominium")
+)
+
+func (uc *CreateVehicleUseCase) Exec(ctx context.Context, req CreateVehicleReq) (pgstore.Vehicle, error) {
+	plate, err := utils.NormalizePlate(req.Plate)
+	if err != nil {
+		return pgstore.Vehicle{}, ErrInvalidPlate
+	}
+
+	model := strings.TrimSpace(req.Model)
+	color := strings.TrimSpace(req.Color)
+	if model == "" || color == "" {
+		return pgstore.Vehicle{}, ErrVehicleModelRequired
+	}
+
+	apartment, err := uc.querier.GetApartmentById(ctx, req.ApartmentID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pgstore.Vehicle{}, ErrApartmentNotFound
+		}
+		return pgstore.Vehicle{}, fmt.Errorf("failed to find apartment: %w", err)
+	}
+
+	if err := checkApartmentAccess(ctx, uc.querier, req.UserID, apartment); err != nil {
+		return pgstore.Vehicle{}, err
+	}
+
+	// The old and Mercosul forms of a plate are the same car.
+	_, err = uc.querier.FindVehicleByPlate(ctx, pgstore.FindVehicleByPlateParams{
+		CondominiumID: apartment.CondominiumID,
+		Plates:        utils.PlateVariants(plate),
+	})
+	if err == nil {
+		return pgstore.Vehicle{}, ErrVehicleAlreadyRegistered
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return pgstore.Vehicle{}, fmt.Errorf("failed to check plate: %w", err)
+	}
+
+	vehicle, err := uc.querier.CreateVehicle(ctx, pgstore.CreateVehicleParams{
+		CondominiumID: apartment.CondominiumID,
+		ApartmentID:   apartment.ID,
+		Plate:         plate,
+		Model:         model,
+		Color:         color,
+		CreatedBy:     req.UserID,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return pgstore.Vehicle{}, ErrVehicleAlreadyRegistered
+		}
+		return pgstore.Vehicle{}, fmt.Errorf("failed to create vehicle: %w", err)
+	}
+
+	return vehicle, nil
+}
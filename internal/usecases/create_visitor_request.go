@@ -0,0 +1,164 @@
+package usecases
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/services"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type CreateVisitorRequestUC interface {
+	Exec(ctx context.Context, req CreateVisitorRequestReq) (VisitorRequestDetails, error)
+}
+
+type CreateVisitorRequestReq struct {
+	UserID          uuid.UUID
+	ApartmentID     uuid.UUID
+	VisitorName     string
+	VisitorDocument *string
+	// Photo is optional; when present it must be a JPEG or PNG image.
+	Photo []byte
+}
+
+type CreateVisitorRequestUseCase struct {
+	querier  pgstore.Querier
+	storage  services.StorageService
+	notifier services.NotificationService
+	events   services.VisitorEventBroker
+	timeout  time.Duration
+}
+
+// NewCreateVisitorRequestUseCase gives residents timeout to answer before the request expires.
+func NewCreateVisitorRequestUseCase(
+	q pgstore.Querier,
+	s services.StorageService,
+	n services.NotificationService,
+	e services.VisitorEventBroker,
+	timeout time.Duration,
+) *CreateVisitorRequestUseCase {
+	return &CreateVisitorRequestUseCase{
+		querier:  q,
+		storage:  s,
+		notifier: n,
+		events:   e,
+		timeout:  timeout,
+	}
+}
+
+var ErrVisitorNameRequired = errors.New("visitor name is required")
+
+func (uc *CreateVisitorRequestUseCase) Exec(ctx context.Context, req CreateVisitorRequestReq) (VisitorRequestDetails, error) {
+	name := strings.TrimSpace(req.VisitorName)
+	if name == "" {
+		return VisitorRequestDetails{}, ErrVisitorNameRequired
+	}
+
+	var document *string
+	if req.VisitorDocument != nil {
+		document = utils.ToNullString(utils.NormalizeDocument(*req.VisitorDocument))
+	}
+
+	apartment, err := uc.querier.GetApartmentById(ctx, req.ApartmentID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return VisitorRequestDetails{}, ErrApartmentNotFound
+		}
+		return VisitorRequestDetails{}, fmt.Errorf("failed to find apartment: %w", err)
+	}
+
+	_, err = uc.querier.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
+		CondominiumID: apartment.CondominiumID,
+		UserID:        req.UserID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return VisitorRequestDetails{}, ErrNoPermission
+		}
+		return VisitorRequestDetails{}, err
+	}
+
+	if err := checkVisitorRequestBlocklist(ctx, uc.querier, uc.notifier, apartment.CondominiumID, name, document); err != nil {
+		return VisitorRequestDetails{}, err
+	}
+
+	var photoKey *string
+	if len(req.Photo) > 0 {
+		contentType := http.DetectContentType(req.Photo)
+		ext, ok := packagePhotoExtensions[contentType]
+		if !ok {
+			return VisitorRequestDetails{}, ErrUnsupportedFileType
+		}
+
+		key := fmt.Sprintf("visitors/%s/%s.%s", apartment.CondominiumID, uuid.New(), ext)
+		if err := uc.storage.Put(ctx, key, bytes.NewReader(req.Photo), int64(len(req.Photo)), contentType); err != nil {
+			return VisitorRequestDetails{}, fmt.Errorf("failed to store visitor photo: %w", err)
+		}
+		photoKey = &key
+	}
+
+	visitor, err := uc.querier.CreateVisitorRequest(ctx, pgstore.CreateVisitorRequestParams{
+		CondominiumID:   apartment.CondominiumID,
+		ApartmentID:     apartment.ID,
+		VisitorName:     name,
+		VisitorDocument: document,
+		PhotoKey:        photoKey,
+		RequestedBy:     req.UserID,
+		ExpiresAt:       time.Now().Add(uc.timeout),
+	})
+	if err != nil {
+		if photoKey != nil {
+			uc.storage.Delete(ctx, *photoKey)
+		}
+		return VisitorRequestDetails{}, fmt.Errorf("failed to create visitor request: %w", err)
+	}
+
+	uc.events.Publish(services.VisitorEvent{Type: services.VisitorRequestCreated, Request: visitor})
+
+	go func() {
+		bgCtx := context.Background()
+
+		title := "🔔 Visitante na portaria"
+		body := fmt.Sprintf("%s está na portaria aguardando sua autorização para entrar.", name)
+
+		err := uc.notifier.SendToApartmentResidents(bgCtx, apartment.ID, title, body, map[string]string{
+			"type":             "VISITOR_ARRIVED",
+			"visitorRequestId": visitor.ID.String(),
+			"actions":          "APPROVE,DENY",
+			"expiresAt":        visitor.ExpiresAt.Format(time.RFC3339),
+		})
+		if err != nil {
+			slog.Error("Failed to notify residents about visitor", "visitor_request_id", visitor.ID, "error", err)
+		}
+	}()
+
+	return signVisitorPhoto(ctx, uc.storage, visitor)
+}
+
+// checkVisitorRequestBlocklist refuses walk-in visitors whose name or document is on the
+// blocklist of the condominium and warns the gate staff about them.
+func checkVisitorRequestBlocklist(ctx context.Context, q pgstore.Querier, notifier services.NotificationService, condoID uuid.UUID, name string, document *string) error {
+	blocked, err := findBlockedVisitors(ctx, q, condoID, visitorIdentity{
+		Name:     name,
+		Document: document,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(blocked) > 0 {
+		go alertBlockedVisitor(notifier, blocked[0], name)
+		return ErrVisitorBlocked
+	}
+
+	return nil
+}
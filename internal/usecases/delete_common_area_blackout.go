@@ -0,0 +1,68 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type DeleteCommonAreaBlackoutUC interface {
+	Exec(ctx context.Context, req DeleteCommonAreaBlackoutReq) error
+}
+
+type DeleteCommonAreaBlackoutReq struct {
+	UserID       uuid.UUID
+	CommonAreaID uuid.UUID
+	BlackoutID   uuid.UUID
+}
+
+type DeleteCommonAreaBlackoutUseCase struct {
+	pool *pgxpool.Pool
+}
+
+func NewDeleteCommonAreaBlackoutUseCase(pool *pgxpool.Pool) *DeleteCommonAreaBlackoutUseCase {
+	return &DeleteCommonAreaBlackoutUseCase{
+		pool: pool,
+	}
+}
+
+var ErrBlackoutNotFound = errors.New("blackout not found")
+
+// Exec reopens an area for bookings during a blackout. Bookings cancelled by the blackout stay
+// cancelled.
+func (uc *DeleteCommonAreaBlackoutUseCase) Exec(ctx context.Context, req DeleteCommonAreaBlackoutReq) error {
+	tx, err := uc.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pgstore.New(tx)
+
+	area, err := lockManagedArea(ctx, qtx, req.CommonAreaID, req.UserID)
+	if err != nil {
+		return err
+	}
+
+	_, err = qtx.DeleteCommonAreaBlackout(ctx, pgstore.DeleteCommonAreaBlackoutParams{
+		ID:           req.BlackoutID,
+		CommonAreaID: area.ID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrBlackoutNotFound
+		}
+		return fmt.Errorf("failed to delete blackout: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
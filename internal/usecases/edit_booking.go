@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Bellorico323/vizen/internal/services"
 	"github.com/Bellorico323/vizen/internal/store/pgstore"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type EditBookingtUC interface {
@@ -22,13 +24,13 @@ type EditBookingReq struct {
 }
 
 type EditBookingUseCase struct {
-	querier  pgstore.Querier
+	pool     *pgxpool.Pool
 	notifier services.NotificationService
 }
 
-func NewEditBookingUseCase(q pgstore.Querier, n services.NotificationService) *EditBookingUseCase {
+func NewEditBookingUseCase(pool *pgxpool.Pool, n services.NotificationService) *EditBookingUseCase {
 	return &EditBookingUseCase{
-		querier:  q,
+		pool:     pool,
 		notifier: n,
 	}
 }
@@ -39,8 +41,18 @@ var (
 	ErrInvalidBookingStatus = errors.New("Invalid booking status")
 )
 
+// Exec approves or denies a pending booking. Approving it bills the area price and deposit to
+// the apartment, denying it offers the freed range to the waitlist.
 func (uc *EditBookingUseCase) Exec(ctx context.Context, req EditBookingReq) error {
-	booking, err := uc.querier.GetBookingById(ctx, req.BookingID)
+	tx, err := uc.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pgstore.New(tx)
+
+	booking, err := qtx.GetBookingById(ctx, req.BookingID)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return ErrBookingNotFound
@@ -58,7 +70,7 @@ func (uc *EditBookingUseCase) Exec(ctx context.Context, req EditBookingReq) erro
 		return ErrBookingNotPending
 	}
 
-	role, err := uc.querier.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
+	role, err := qtx.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
 		CondominiumID: booking.CondominiumID,
 		UserID:        req.UserID,
 	})
@@ -73,16 +85,48 @@ func (uc *EditBookingUseCase) Exec(ctx context.Context, req EditBookingReq) erro
 		return ErrNoPermission
 	}
 
+	area, err := qtx.GetCommonAreaIdForUpdate(ctx, booking.CommonAreaID)
+	if err != nil {
+		return fmt.Errorf("failed to lock common area: %w", err)
+	}
+
 	params := pgstore.UpdateBookingStatusParams{
 		Status: req.Status,
 		ID:     booking.ID,
 	}
 
-	_, err = uc.querier.UpdateBookingStatus(ctx, params)
+	// The status is checked again by the update: the pending booking expiry may have denied it
+	// while this transaction waited for the area lock.
+	updated, err := qtx.UpdateBookingStatus(ctx, params)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrBookingNotPending
+		}
 		return err
 	}
 
+	var feeCents int64
+	var offers []pgstore.BookingWaitlistEntry
+	switch updated.Status {
+	case "confirmed":
+		if _, err := billBookingCharges(ctx, qtx, area, updated); err != nil {
+			return err
+		}
+		feeCents = bookingFeeCents(area, updated.StartsAt, updated.EndsAt)
+
+	case "denied", "cancelled":
+		offers, err = offerFreedRange(ctx, qtx, area, updated.StartsAt, updated.EndsAt, time.Now())
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	notifyWaitlistOffers(uc.notifier, area.Name, offers)
+
 	go func() {
 		bgCtx := context.Background()
 
@@ -92,6 +136,9 @@ func (uc *EditBookingUseCase) Exec(ctx context.Context, req EditBookingReq) erro
 		case "confirmed":
 			title = "✅ Agendamento Aprovado"
 			body = fmt.Sprintf("Seu agendamento para '%s' foi confirmado!", booking.CommonAreaName)
+			if feeCents > 0 {
+				body += fmt.Sprintf(" A taxa de uso de R$ %.2f foi lançada para o apartamento.", float64(feeCents)/100)
+			}
 		case "denied":
 			title = "❌ Agendamento Recusado"
 			body = fmt.Sprintf("Seu agendamento para '%s' foi recusado.", booking.CommonAreaName)
@@ -0,0 +1,148 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/services"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ExpirePendingBookingsUseCase is run by the scheduler. Once a booking still waiting for approval
+// reaches the pending expiry of its area, it is denied and its slot offered to the waitlist, or,
+// for areas escalating instead, the admins are warned and the booking is denied only if it is
+// still pending when it starts.
+type ExpirePendingBookingsUseCase struct {
+	pool     *pgxpool.Pool
+	notifier services.NotificationService
+}
+
+func NewExpirePendingBookingsUseCase(pool *pgxpool.Pool, n services.NotificationService) *ExpirePendingBookingsUseCase {
+	return &ExpirePendingBookingsUseCase{
+		pool:     pool,
+		notifier: n,
+	}
+}
+
+func (uc *ExpirePendingBookingsUseCase) Exec(ctx context.Context) error {
+	bookings, err := pgstore.New(uc.pool).ListExpiredPendingBookings(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list expired pending bookings: %w", err)
+	}
+
+	now := time.Now()
+	for _, booking := range bookings {
+		if err := uc.expire(ctx, booking, now); err != nil {
+			slog.Error("Failed to expire pending booking", "booking_id", booking.ID, "error", err)
+		}
+	}
+
+	if len(bookings) > 0 {
+		slog.Info("Expired pending bookings", "count", len(bookings))
+	}
+
+	return nil
+}
+
+func (uc *ExpirePendingBookingsUseCase) expire(ctx context.Context, booking pgstore.Booking, now time.Time) error {
+	tx, err := uc.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pgstore.New(tx)
+
+	// Same lock as EditBookingUseCase. Both only change the booking while it is still pending, so
+	// when an admin decides at the same time, exactly one of them does.
+	area, err := qtx.GetCommonAreaIdForUpdate(ctx, booking.CommonAreaID)
+	if err != nil {
+		return fmt.Errorf("failed to lock common area: %w", err)
+	}
+
+	condo, err := qtx.GetCondominiumById(ctx, booking.CondominiumID)
+	if err != nil {
+		return fmt.Errorf("failed to get condominium: %w", err)
+	}
+
+	loc, err := time.LoadLocation(condo.Timezone)
+	if err != nil {
+		return fmt.Errorf("invalid condominium timezone %q: %w", condo.Timezone, err)
+	}
+
+	if area.PendingExpiryAction == PendingExpiryEscalate && booking.StartsAt.After(now) {
+		escalated, err := qtx.EscalatePendingBooking(ctx, booking.ID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil
+			}
+			return fmt.Errorf("failed to escalate booking: %w", err)
+		}
+
+		if err := tx.Commit(ctx); err != nil {
+			return fmt.Errorf("failed to commit transaction: %w", err)
+		}
+
+		uc.notifyEscalated(area.Name, loc, escalated)
+		return nil
+	}
+
+	denied, err := qtx.DenyPendingBooking(ctx, booking.ID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("failed to deny booking: %w", err)
+	}
+
+	var offers []pgstore.BookingWaitlistEntry
+	if denied.StartsAt.After(now) {
+		offers, err = offerFreedRange(ctx, qtx, area, denied.StartsAt, denied.EndsAt, now)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	notifyWaitlistOffers(uc.notifier, area.Name, offers)
+
+	go func() {
+		title := "❌ Agendamento Recusado"
+		body := fmt.Sprintf("Seu agendamento de '%s' para %s não foi aprovado a tempo e foi recusado automaticamente. O horário foi liberado.",
+			area.Name, denied.StartsAt.In(loc).Format("02/01 15:04"))
+
+		if err := uc.notifier.SendToUser(context.Background(), denied.UserID, title, body); err != nil {
+			slog.Error("Failed to send async notification", "booking_id", denied.ID, "error", err)
+		}
+	}()
+
+	return nil
+}
+
+func (uc *ExpirePendingBookingsUseCase) notifyEscalated(areaName string, loc *time.Location, booking pgstore.Booking) {
+	startsAt := booking.StartsAt.In(loc).Format("02/01 15:04")
+
+	go func() {
+		bgCtx := context.Background()
+
+		title := "⏰ Agendamento aguardando aprovação"
+		body := fmt.Sprintf("O agendamento de '%s' para %s ainda aguarda aprovação. Sem uma decisão, ele será recusado automaticamente no horário de início.", areaName, startsAt)
+		if err := uc.notifier.SendToCondoAdmins(bgCtx, booking.CondominiumID, title, body); err != nil {
+			slog.Error("Failed to send async notification", "booking_id", booking.ID, "error", err)
+		}
+
+		title = "⏳ Agendamento em análise"
+		body = fmt.Sprintf("Seu agendamento de '%s' para %s ainda aguarda aprovação. A administração foi avisada.", areaName, startsAt)
+		if err := uc.notifier.SendToUser(bgCtx, booking.UserID, title, body); err != nil {
+			slog.Error("Failed to send async notification", "booking_id", booking.ID, "error", err)
+		}
+	}()
+}
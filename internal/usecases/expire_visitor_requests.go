@@ -0,0 +1,41 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/Bellorico323/vizen/internal/services"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+)
+
+// ExpireVisitorRequestsUseCase is run by the scheduler. It closes walk-in requests nobody answered
+// in time and tells the front desk the visitor was not authorized.
+type ExpireVisitorRequestsUseCase struct {
+	querier pgstore.Querier
+	events  services.VisitorEventBroker
+}
+
+func NewExpireVisitorRequestsUseCase(q pgstore.Querier, e services.VisitorEventBroker) *ExpireVisitorRequestsUseCase {
+	return &ExpireVisitorRequestsUseCase{
+		querier: q,
+		events:  e,
+	}
+}
+
+func (uc *ExpireVisitorRequestsUseCase) Exec(ctx context.Context) error {
+	expired, err := uc.querier.ExpireVisitorRequests(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to expire visitor requests: %w", err)
+	}
+
+	for _, visitor := range expired {
+		uc.events.Publish(services.VisitorEvent{Type: services.VisitorRequestUpdated, Request: visitor})
+	}
+
+	if len(expired) > 0 {
+		slog.Info("Expired unanswered visitor requests", "count", len(expired))
+	}
+
+	return nil
+}
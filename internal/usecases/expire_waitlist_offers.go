@@ -0,0 +1,79 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/services"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ExpireWaitlistOffersUseCase is run by the scheduler. It expires offers nobody accepted in time
+// and entries whose range already started, then offers each released range to the next entries
+// in line.
+type ExpireWaitlistOffersUseCase struct {
+	pool     *pgxpool.Pool
+	notifier services.NotificationService
+}
+
+func NewExpireWaitlistOffersUseCase(pool *pgxpool.Pool, n services.NotificationService) *ExpireWaitlistOffersUseCase {
+	return &ExpireWaitlistOffersUseCase{
+		pool:     pool,
+		notifier: n,
+	}
+}
+
+func (uc *ExpireWaitlistOffersUseCase) Exec(ctx context.Context) error {
+	expired, err := pgstore.New(uc.pool).ExpireWaitlistEntries(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to expire waitlist entries: %w", err)
+	}
+
+	now := time.Now()
+	for _, entry := range expired {
+		if entry.OfferedAt == nil || !entry.StartsAt.After(now) {
+			continue
+		}
+
+		if err := uc.reoffer(ctx, entry, now); err != nil {
+			slog.Error("Failed to offer expired waitlist range", "waitlist_entry_id", entry.ID, "error", err)
+		}
+	}
+
+	if len(expired) > 0 {
+		slog.Info("Expired waitlist entries", "count", len(expired))
+	}
+
+	return nil
+}
+
+func (uc *ExpireWaitlistOffersUseCase) reoffer(ctx context.Context, entry pgstore.BookingWaitlistEntry, now time.Time) error {
+	tx, err := uc.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pgstore.New(tx)
+
+	area, err := qtx.GetCommonAreaIdForUpdate(ctx, entry.CommonAreaID)
+	if err != nil {
+		return fmt.Errorf("failed to lock common area: %w", err)
+	}
+
+	offers, err := offerFreedRange(ctx, qtx, area, entry.StartsAt, entry.EndsAt, now)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	notifyWaitlistOffers(uc.notifier, area.Name, offers)
+
+	return nil
+}
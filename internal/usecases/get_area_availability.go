@@ -2,20 +2,59 @@ package usecases
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/Bellorico323/vizen/internal/store/pgstore"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
+// DefaultSlotMinutes is the granularity used when the caller does not pick one.
+const DefaultSlotMinutes = 30
+
+// maxAvailabilityRange bounds the window a single availability request can cover.
+const maxAvailabilityRange = 31 * 24 * time.Hour
+
 type GetAreaAvailabilityUC interface {
-	Exec(ctx context.Context, req GetAreaAvailabilityReq) ([]pgstore.GetAreaAvailabilityRow, error)
+	Exec(ctx context.Context, req GetAreaAvailabilityReq) (AreaAvailability, error)
 }
 
 type GetAreaAvailabilityReq struct {
+	UserID       uuid.UUID
 	CommonAreaID uuid.UUID
 	FromDate     time.Time
 	ToDate       time.Time
+	// SlotMinutes is the granularity of the free slots. Zero means DefaultSlotMinutes.
+	SlotMinutes int32
+	// Headcount is how many people the slots must have room for. Zero means 1.
+	Headcount int32
+}
+
+// AreaAvailability holds the bookings and blackouts overlapping the requested window and the free
+// slots a new booking can use. Consecutive free slots can be combined into a single booking.
+type AreaAvailability struct {
+	Timezone    string
+	SlotMinutes int32
+	Bookings    []pgstore.GetAreaAvailabilityRow
+	Blackouts   []pgstore.CommonAreaBlackout
+	FreeSlots   []TimeSlot
+}
+
+type TimeSlot struct {
+	StartsAt time.Time
+	EndsAt   time.Time
+	// RemainingCapacity is how many more people fit the slot, for areas allowing overlapping
+	// bookings.
+	RemainingCapacity *int32
+}
+
+// occupiedPeriod is a booking widened by the area cool-down, or a blackout.
+type occupiedPeriod struct {
+	StartsAt  time.Time
+	EndsAt    time.Time
+	Headcount int32
 }
 
 type GetAreaAvailabilityUseCase struct {
@@ -26,14 +65,231 @@ func NewGetAreaAvailabilityUseCase(q pgstore.Querier) *GetAreaAvailabilityUseCas
 	return &GetAreaAvailabilityUseCase{querier: q}
 }
 
-func (uc *GetAreaAvailabilityUseCase) Exec(ctx context.Context, req GetAreaAvailabilityReq) ([]pgstore.GetAreaAvailabilityRow, error) {
-	slots, err := uc.querier.GetAreaAvailability(ctx, pgstore.GetAreaAvailabilityParams{
-		CommonAreaID: req.CommonAreaID,
-		StartsAt:     req.FromDate,
-		EndsAt:       req.ToDate,
+var (
+	ErrInvalidAvailabilityRange = errors.New("to must be after from and at most 31 days later")
+	ErrInvalidSlotSize          = errors.New("slot size must be between 5 minutes and the maximum booking duration of the area (24 hours at most)")
+)
+
+// Exec computes the free slots of an area within the window. A slot is free when it fits the
+// opening hours of its day, is not in the past nor beyond the advance booking limit, and keeps the
+// area cool-down away from every pending or confirmed booking, including bookings that straddle
+// the window edges. For areas allowing overlapping bookings, the slot only needs room left for
+// the headcount. Slots overlapping a blackout are never free.
+func (uc *GetAreaAvailabilityUseCase) Exec(ctx context.Context, req GetAreaAvailabilityReq) (AreaAvailability, error) {
+	if !req.ToDate.After(req.FromDate) || req.ToDate.Sub(req.FromDate) > maxAvailabilityRange {
+		return AreaAvailability{}, ErrInvalidAvailabilityRange
+	}
+
+	area, err := uc.querier.GetCommonAreaById(ctx, req.CommonAreaID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return AreaAvailability{}, ErrCommonAreaNotFound
+		}
+		return AreaAvailability{}, fmt.Errorf("failed to get common area: %w", err)
+	}
+	if area.ArchivedAt != nil {
+		return AreaAvailability{}, ErrCommonAreaNotFound
+	}
+
+	_, err = uc.querier.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
+		CondominiumID: area.CondominiumID,
+		UserID:        req.UserID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return AreaAvailability{}, ErrNoPermission
+		}
+		return AreaAvailability{}, err
+	}
+
+	slotMinutes := req.SlotMinutes
+	if slotMinutes == 0 {
+		slotMinutes = DefaultSlotMinutes
+		if area.MaxDurationMinutes != nil && *area.MaxDurationMinutes < slotMinutes {
+			slotMinutes = *area.MaxDurationMinutes
+		}
+	}
+	if slotMinutes < 5 || slotMinutes > 24*60 ||
+		(area.MaxDurationMinutes != nil && slotMinutes > *area.MaxDurationMinutes) {
+		return AreaAvailability{}, ErrInvalidSlotSize
+	}
+
+	headcount := req.Headcount
+	if headcount == 0 {
+		headcount = 1
+	}
+	if headcount < 0 || (area.Capacity != nil && headcount > *area.Capacity) {
+		return AreaAvailability{}, ErrInvalidHeadcount
+	}
+
+	condo, err := uc.querier.GetCondominiumById(ctx, area.CondominiumID)
+	if err != nil {
+		return AreaAvailability{}, fmt.Errorf("failed to get condominium: %w", err)
+	}
+
+	loc, err := time.LoadLocation(condo.Timezone)
+	if err != nil {
+		return AreaAvailability{}, fmt.Errorf("invalid condominium timezone %q: %w", condo.Timezone, err)
+	}
+
+	hours, err := uc.querier.ListCommonAreaOpeningHours(ctx, area.ID)
+	if err != nil {
+		return AreaAvailability{}, fmt.Errorf("failed to list opening hours: %w", err)
+	}
+
+	// Bookings just outside the window still block the slots at its edges through the cool-down.
+	cooldown := time.Duration(area.CooldownMinutes) * time.Minute
+	rows, err := uc.querier.GetAreaAvailability(ctx, pgstore.GetAreaAvailabilityParams{
+		CommonAreaID: area.ID,
+		StartsAt:     req.FromDate.Add(-cooldown),
+		EndsAt:       req.ToDate.Add(cooldown),
 	})
 	if err != nil {
-		return nil, err
+		return AreaAvailability{}, fmt.Errorf("failed to list bookings: %w", err)
+	}
+
+	blackouts, err := uc.querier.ListCommonAreaBlackouts(ctx, pgstore.ListCommonAreaBlackoutsParams{
+		CommonAreaID: area.ID,
+		FromDate:     req.FromDate,
+		ToDate:       &req.ToDate,
+	})
+	if err != nil {
+		return AreaAvailability{}, fmt.Errorf("failed to list blackouts: %w", err)
+	}
+
+	availability := AreaAvailability{
+		Timezone:    condo.Timezone,
+		SlotMinutes: slotMinutes,
+		Bookings:    []pgstore.GetAreaAvailabilityRow{},
+		Blackouts:   []pgstore.CommonAreaBlackout{},
+	}
+
+	closed := make([]occupiedPeriod, 0, len(blackouts))
+	for _, blackout := range blackouts {
+		closed = append(closed, occupiedPeriod{StartsAt: blackout.StartsAt, EndsAt: blackout.EndsAt})
+		availability.Blackouts = append(availability.Blackouts, blackout)
+	}
+
+	busy := make([]occupiedPeriod, 0, len(rows))
+	for _, row := range rows {
+		busy = append(busy, occupiedPeriod{
+			StartsAt:  row.StartsAt.Add(-cooldown),
+			EndsAt:    row.EndsAt.Add(cooldown),
+			Headcount: row.Headcount,
+		})
+		if row.EndsAt.After(req.FromDate) && row.StartsAt.Before(req.ToDate) {
+			availability.Bookings = append(availability.Bookings, row)
+		}
+	}
+
+	notBefore := time.Now()
+	if req.FromDate.After(notBefore) {
+		notBefore = req.FromDate
+	}
+
+	var lastStart time.Time
+	if area.MaxAdvanceDays != nil {
+		lastStart = time.Now().AddDate(0, 0, int(*area.MaxAdvanceDays))
+	}
+
+	var capacity *int32
+	if area.AllowOverlappingBookings {
+		capacity = area.Capacity
+	}
+
+	availability.FreeSlots = freeSlots(hours, busy, closed, notBefore, req.ToDate, lastStart, time.Duration(slotMinutes)*time.Minute, loc, capacity, headcount)
+
+	return availability, nil
+}
+
+// freeSlots walks the days of the window in the condominium timezone and splits the opening
+// hours of each day into slots aligned to the opening time, keeping the ones clear of busy
+// periods, or with room left for headcount when capacity is set, and never overlapping a closed
+// period. A zero lastStart means there is no advance booking limit.
+func freeSlots(hours []pgstore.CommonAreaOpeningHour, busy, closed []occupiedPeriod, from, to, lastStart time.Time, slot time.Duration, loc *time.Location, capacity *int32, headcount int32) []TimeSlot {
+	slots := []TimeSlot{}
+
+	local := from.In(loc)
+	for day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc); day.Before(to); day = day.AddDate(0, 0, 1) {
+		opens, closes := day, day.AddDate(0, 0, 1)
+		if len(hours) > 0 {
+			h, ok := openingHoursOf(hours, day.Weekday())
+			if !ok {
+				continue
+			}
+			opens = time.Date(day.Year(), day.Month(), day.Day(), 0, int(h.OpensMinute), 0, 0, loc)
+			closes = time.Date(day.Year(), day.Month(), day.Day(), 0, int(h.ClosesMinute), 0, 0, loc)
+		}
+
+		for start := opens; !start.Add(slot).After(closes); start = start.Add(slot) {
+			end := start.Add(slot)
+			if start.Before(from) || end.After(to) {
+				continue
+			}
+			if !lastStart.IsZero() && start.After(lastStart) {
+				return slots
+			}
+			if overlapsAny(closed, start, end) {
+				continue
+			}
+			if capacity == nil {
+				if overlapsAny(busy, start, end) {
+					continue
+				}
+				slots = append(slots, TimeSlot{StartsAt: start, EndsAt: end})
+				continue
+			}
+
+			remaining := *capacity - peakHeadcount(busy, start, end)
+			if remaining < headcount {
+				continue
+			}
+			slots = append(slots, TimeSlot{StartsAt: start, EndsAt: end, RemainingCapacity: &remaining})
+		}
+	}
+
+	return slots
+}
+
+func openingHoursOf(hours []pgstore.CommonAreaOpeningHour, weekday time.Weekday) (pgstore.CommonAreaOpeningHour, bool) {
+	for _, h := range hours {
+		if h.Weekday == int16(weekday) {
+			return h, true
+		}
+	}
+	return pgstore.CommonAreaOpeningHour{}, false
+}
+
+func overlapsAny(periods []occupiedPeriod, start, end time.Time) bool {
+	for _, p := range periods {
+		if p.StartsAt.Before(end) && p.EndsAt.After(start) {
+			return true
+		}
+	}
+	return false
+}
+
+// peakHeadcount is the highest occupancy between start and end, the in-memory counterpart of
+// GetPeakBookedHeadcount.
+func peakHeadcount(periods []occupiedPeriod, start, end time.Time) int32 {
+	var peak int32
+	for _, p := range periods {
+		if !p.StartsAt.Before(end) || !p.EndsAt.After(start) {
+			continue
+		}
+
+		at := p.StartsAt
+		if at.Before(start) {
+			at = start
+		}
+
+		var occupancy int32
+		for _, o := range periods {
+			if !o.StartsAt.After(at) && o.EndsAt.After(at) {
+				occupancy += o.Headcount
+			}
+		}
+		peak = max(peak, occupancy)
 	}
-	return slots, nil
+	return peak
 }
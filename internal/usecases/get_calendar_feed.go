@@ -0,0 +1,149 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/utils"
+	"github.com/jackc/pgx/v5"
+)
+
+// calendarFeedHistory is how far back a feed goes. Older bookings drop out of subscribed
+// calendars.
+const calendarFeedHistory = 90 * 24 * time.Hour
+
+type GetCalendarFeedUC interface {
+	Exec(ctx context.Context, req GetCalendarFeedReq) (CalendarFeedDocument, error)
+}
+
+type GetCalendarFeedReq struct {
+	Code string
+}
+
+// CalendarFeedDocument is the content of a feed, ready to be rendered as iCalendar.
+type CalendarFeedDocument struct {
+	Name     string
+	Timezone string
+	Events   []utils.ICalEvent
+}
+
+type GetCalendarFeedUseCase struct {
+	querier pgstore.Querier
+	signer  *auth.URLSigner
+}
+
+func NewGetCalendarFeedUseCase(q pgstore.Querier, signer *auth.URLSigner) *GetCalendarFeedUseCase {
+	return &GetCalendarFeedUseCase{
+		querier: q,
+		signer:  signer,
+	}
+}
+
+var calendarEventStatus = map[string]string{
+	"pending":   "TENTATIVE",
+	"confirmed": "CONFIRMED",
+	"cancelled": "CANCELLED",
+	"denied":    "CANCELLED",
+}
+
+// Exec resolves a feed URL code into the bookings it covers. The URL is the only credential, so
+// revoked feeds, feeds of users who no longer live or work in the condominium and condominium
+// feeds of users who are no longer admins or syndics are all reported as not found. Cancelled
+// and denied bookings stay in the feed as cancelled events, so calendar apps remove them.
+func (uc *GetCalendarFeedUseCase) Exec(ctx context.Context, req GetCalendarFeedReq) (CalendarFeedDocument, error) {
+	token, ok := parseSignedTokenCode(uc.signer, "calendar", req.Code)
+	if !ok {
+		return CalendarFeedDocument{}, ErrCalendarFeedNotFound
+	}
+
+	feed, err := uc.querier.GetCalendarFeedByToken(ctx, token)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return CalendarFeedDocument{}, ErrCalendarFeedNotFound
+		}
+		return CalendarFeedDocument{}, fmt.Errorf("failed to get calendar feed: %w", err)
+	}
+	if feed.RevokedAt != nil {
+		return CalendarFeedDocument{}, ErrCalendarFeedNotFound
+	}
+
+	if err := checkCalendarFeedAccess(ctx, uc.querier, feed.CondominiumID, feed.UserID, feed.Scope); err != nil {
+		if errors.Is(err, ErrNoPermission) {
+			return CalendarFeedDocument{}, ErrCalendarFeedNotFound
+		}
+		return CalendarFeedDocument{}, err
+	}
+
+	condo, err := uc.querier.GetCondominiumById(ctx, feed.CondominiumID)
+	if err != nil {
+		return CalendarFeedDocument{}, fmt.Errorf("failed to get condominium: %w", err)
+	}
+
+	params := pgstore.ListBookingsParams{
+		CondominiumID: feed.CondominiumID,
+		FromDate:      utils.ToPtr(time.Now().Add(-calendarFeedHistory)),
+	}
+	name := "Reservas - " + condo.Name
+	if feed.Scope == CalendarFeedUser {
+		params.UserID = &feed.UserID
+		name = "Minhas reservas - " + condo.Name
+	}
+
+	bookings, err := uc.querier.ListBookings(ctx, params)
+	if err != nil {
+		return CalendarFeedDocument{}, fmt.Errorf("failed to list bookings: %w", err)
+	}
+
+	doc := CalendarFeedDocument{
+		Name:     name,
+		Timezone: condo.Timezone,
+		Events:   make([]utils.ICalEvent, 0, len(bookings)),
+	}
+	for _, b := range bookings {
+		if b.DeletedAt != nil {
+			continue
+		}
+
+		apartment := "Apto " + b.ApartmentNumber
+		if b.ApartmentBlock != nil && *b.ApartmentBlock != "" {
+			apartment = fmt.Sprintf("Bloco %s, %s", *b.ApartmentBlock, apartment)
+		}
+
+		summary := b.CommonAreaName
+		if feed.Scope == CalendarFeedCondominium {
+			summary = fmt.Sprintf("%s - %s", b.CommonAreaName, apartment)
+		}
+
+		var description strings.Builder
+		fmt.Fprintf(&description, "Reservado por %s (%s).", b.UserName, apartment)
+		if b.Headcount > 1 {
+			fmt.Fprintf(&description, "\nPessoas: %d.", b.Headcount)
+		}
+		if b.Status == "pending" {
+			description.WriteString("\nAguardando aprovação.")
+		}
+
+		updatedAt := b.CreatedAt
+		if b.UpdatedAt != nil {
+			updatedAt = *b.UpdatedAt
+		}
+
+		doc.Events = append(doc.Events, utils.ICalEvent{
+			UID:         b.ID.String() + "@vizen",
+			Summary:     summary,
+			Description: description.String(),
+			Location:    fmt.Sprintf("%s, %s", b.CommonAreaName, condo.Name),
+			Status:      calendarEventStatus[b.Status],
+			StartsAt:    b.StartsAt,
+			EndsAt:      b.EndsAt,
+			UpdatedAt:   updatedAt,
+		})
+	}
+
+	return doc, nil
+}
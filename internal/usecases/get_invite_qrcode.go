@@ -0,0 +1,133 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type GetInviteQRCodeUC interface {
+	Exec(ctx context.Context, req GetInviteQRCodeReq) (InviteQRCode, error)
+}
+
+type GetInviteQRCodeReq struct {
+	UserID   uuid.UUID
+	InviteID uuid.UUID
+	// Format is "png" or "svg".
+	Format string
+	Size   int
+	// Payload is "token" (the bare token, validated online) or "signed" (the offline token gate
+	// devices verify without the API).
+	Payload string
+}
+
+type InviteQRCode struct {
+	Content     []byte
+	ContentType string
+}
+
+type GetInviteQRCodeUseCase struct {
+	querier pgstore.Querier
+	signer  *auth.InviteTokenSigner
+}
+
+func NewGetInviteQRCodeUseCase(q pgstore.Querier, signer *auth.InviteTokenSigner) *GetInviteQRCodeUseCase {
+	return &GetInviteQRCodeUseCase{
+		querier: q,
+		signer:  signer,
+	}
+}
+
+const (
+	DefaultQRCodeSize = 512
+	minQRCodeSize     = 128
+	maxQRCodeSize     = 2048
+)
+
+var (
+	ErrInvalidQRCodeFormat  = errors.New("qr code format must be png or svg")
+	ErrInvalidQRCodePayload = errors.New("qr code payload must be token or signed")
+)
+
+func (uc *GetInviteQRCodeUseCase) Exec(ctx context.Context, req GetInviteQRCodeReq) (InviteQRCode, error) {
+	size := req.Size
+	if size == 0 {
+		size = DefaultQRCodeSize
+	}
+	size = min(max(size, minQRCodeSize), maxQRCodeSize)
+
+	invite, err := getVisibleInvite(ctx, uc.querier, req.UserID, req.InviteID)
+	if err != nil {
+		return InviteQRCode{}, err
+	}
+
+	switch req.Payload {
+	case "", "token":
+		return renderInviteQRCode(invite.Token.String(), req.Format, size)
+
+	case "signed":
+		token, err := signOfflineInviteToken(ctx, uc.querier, uc.signer, invite)
+		if err != nil {
+			return InviteQRCode{}, err
+		}
+		return renderInviteQRCode(token, req.Format, size)
+
+	default:
+		return InviteQRCode{}, ErrInvalidQRCodePayload
+	}
+}
+
+// renderInviteQRCode encodes the content as is: the bare token is exactly what POST /invites/validate
+// expects.
+func renderInviteQRCode(content string, format string, size int) (InviteQRCode, error) {
+	switch format {
+	case "", "png":
+		png, err := utils.QRCodePNG(content, size)
+		if err != nil {
+			return InviteQRCode{}, err
+		}
+		return InviteQRCode{Content: png, ContentType: "image/png"}, nil
+
+	case "svg":
+		svg, err := utils.QRCodeSVG(content, size)
+		if err != nil {
+			return InviteQRCode{}, err
+		}
+		return InviteQRCode{Content: svg, ContentType: "image/svg+xml"}, nil
+
+	default:
+		return InviteQRCode{}, ErrInvalidQRCodeFormat
+	}
+}
+
+// getVisibleInvite returns the invite when the user issued it, lives in its apartment or is condominium staff.
+func getVisibleInvite(ctx context.Context, q pgstore.Querier, userID, inviteID uuid.UUID) (pgstore.Invite, error) {
+	invite, err := q.GetInviteById(ctx, inviteID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pgstore.Invite{}, ErrInviteNotFound
+		}
+		return pgstore.Invite{}, fmt.Errorf("failed to get invite: %w", err)
+	}
+
+	if invite.IssuedBy == userID {
+		return invite, nil
+	}
+
+	apartment, err := q.GetApartmentById(ctx, invite.ApartmentID)
+	if err != nil {
+		return pgstore.Invite{}, fmt.Errorf("failed to find apartment: %w", err)
+	}
+
+	if err := checkApartmentAccess(ctx, q, userID, apartment); err != nil {
+		return pgstore.Invite{}, err
+	}
+
+	return invite, nil
+}
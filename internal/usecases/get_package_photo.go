@@ -0,0 +1,62 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Bellorico323/vizen/internal/services"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type GetPackagePhotoUC interface {
+	Exec(ctx context.Context, req GetPackagePhotoReq) (PackagePhoto, error)
+}
+
+type GetPackagePhotoReq struct {
+	UserID    uuid.UUID
+	PackageID uuid.UUID
+}
+
+type GetPackagePhotoUseCase struct {
+	querier pgstore.Querier
+	storage services.StorageService
+}
+
+func NewGetPackagePhotoUseCase(q pgstore.Querier, s services.StorageService) *GetPackagePhotoUseCase {
+	return &GetPackagePhotoUseCase{
+		querier: q,
+		storage: s,
+	}
+}
+
+var ErrPackageHasNoPhoto = errors.New("package has no photo")
+
+// Exec signs the photo URLs of a package for the staff of its condominium and the residents of
+// its apartment.
+func (uc *GetPackagePhotoUseCase) Exec(ctx context.Context, req GetPackagePhotoReq) (PackagePhoto, error) {
+	pkg, err := uc.querier.GetPackageById(ctx, req.PackageID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return PackagePhoto{}, ErrPackageNotFound
+		}
+		return PackagePhoto{}, fmt.Errorf("failed to get package: %w", err)
+	}
+
+	apartment, err := uc.querier.GetApartmentById(ctx, pkg.ApartmentID)
+	if err != nil {
+		return PackagePhoto{}, fmt.Errorf("failed to get apartment: %w", err)
+	}
+
+	if err := checkApartmentAccess(ctx, uc.querier, req.UserID, apartment); err != nil {
+		return PackagePhoto{}, err
+	}
+
+	if pkg.PhotoKey == nil || pkg.ThumbnailKey == nil {
+		return PackagePhoto{}, ErrPackageHasNoPhoto
+	}
+
+	return signPackagePhoto(ctx, uc.storage, *pkg.PhotoKey, *pkg.ThumbnailKey)
+}
@@ -0,0 +1,124 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type PollDetails struct {
+	Poll           pgstore.Poll                `json:"poll"`
+	Status         string                      `json:"status"`
+	Options        []pgstore.PollOption        `json:"options"`
+	ResultsVisible bool                        `json:"resultsVisible"`
+	TotalBallots   int64                       `json:"totalBallots"`
+	Results        []pgstore.GetPollResultsRow `json:"results,omitempty"`
+	Voters         []pgstore.ListPollVotersRow `json:"voters,omitempty"`
+}
+
+type GetPollUC interface {
+	Exec(ctx context.Context, req GetPollReq) (PollDetails, error)
+}
+
+type GetPollReq struct {
+	PollID uuid.UUID
+	UserID uuid.UUID
+}
+
+type GetPollUseCase struct {
+	querier pgstore.Querier
+}
+
+func NewGetPollUseCase(q pgstore.Querier) *GetPollUseCase {
+	return &GetPollUseCase{
+		querier: q,
+	}
+}
+
+var ErrPollNotFound = errors.New("poll not found")
+
+func (uc *GetPollUseCase) Exec(ctx context.Context, req GetPollReq) (PollDetails, error) {
+	poll, err := uc.querier.GetPollById(ctx, req.PollID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return PollDetails{}, ErrPollNotFound
+		}
+		return PollDetails{}, fmt.Errorf("failed to fetch poll: %w", err)
+	}
+
+	hasAccess, err := uc.querier.CheckUserAccessToCondo(ctx, pgstore.CheckUserAccessToCondoParams{
+		UserID:        req.UserID,
+		CondominiumID: poll.CondominiumID,
+	})
+	if err != nil {
+		return PollDetails{}, err
+	}
+
+	if !hasAccess {
+		return PollDetails{}, ErrNoPermission
+	}
+
+	role, err := uc.querier.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
+		CondominiumID: poll.CondominiumID,
+		UserID:        req.UserID,
+	})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return PollDetails{}, err
+	}
+
+	isAdmin := role == "admin" || role == "syndic"
+
+	options, err := uc.querier.ListPollOptions(ctx, poll.ID)
+	if err != nil {
+		return PollDetails{}, fmt.Errorf("failed to list poll options: %w", err)
+	}
+
+	totalBallots, err := uc.querier.CountPollBallots(ctx, poll.ID)
+	if err != nil {
+		return PollDetails{}, fmt.Errorf("failed to count ballots: %w", err)
+	}
+
+	status := pollStatus(poll, time.Now())
+
+	details := PollDetails{
+		Poll:           poll,
+		Status:         status,
+		Options:        options,
+		TotalBallots:   totalBallots,
+		ResultsVisible: isAdmin || poll.ResultsVisibility == "live" || status == "closed",
+	}
+
+	if !details.ResultsVisible {
+		return details, nil
+	}
+
+	details.Results, err = uc.querier.GetPollResults(ctx, poll.ID)
+	if err != nil {
+		return PollDetails{}, fmt.Errorf("failed to fetch poll results: %w", err)
+	}
+
+	if !poll.IsAnonymous {
+		details.Voters, err = uc.querier.ListPollVoters(ctx, poll.ID)
+		if err != nil {
+			return PollDetails{}, fmt.Errorf("failed to list poll voters: %w", err)
+		}
+	}
+
+	return details, nil
+}
+
+func pollStatus(poll pgstore.Poll, now time.Time) string {
+	switch {
+	case poll.ClosedAt != nil || !now.Before(poll.ClosesAt):
+		return "closed"
+	case now.Before(poll.OpensAt):
+		return "scheduled"
+	default:
+		return "open"
+	}
+}
@@ -0,0 +1,95 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type GetPublicInviteUC interface {
+	Exec(ctx context.Context, code string) (PublicInvite, error)
+}
+
+// PublicInvite is what a guest sees through a shared link. It deliberately leaves out the resident's data.
+type PublicInvite struct {
+	Token           uuid.UUID
+	GuestName       string
+	GuestType       string
+	Block           *string
+	ApartmentNumber string
+	StartsAt        time.Time
+	EndsAt          time.Time
+	Weekdays        []int16
+	DailyStartTime  *string
+	DailyEndTime    *string
+	Timezone        string
+	// Status is one of active, scheduled, expired or revoked.
+	Status string
+}
+
+type GetPublicInviteUseCase struct {
+	querier pgstore.Querier
+	signer  *auth.URLSigner
+}
+
+func NewGetPublicInviteUseCase(q pgstore.Querier, signer *auth.URLSigner) *GetPublicInviteUseCase {
+	return &GetPublicInviteUseCase{
+		querier: q,
+		signer:  signer,
+	}
+}
+
+func (uc *GetPublicInviteUseCase) Exec(ctx context.Context, code string) (PublicInvite, error) {
+	token, err := parseInviteShareCode(uc.signer, code)
+	if err != nil {
+		return PublicInvite{}, err
+	}
+
+	invite, err := uc.querier.GetInviteByToken(ctx, token)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return PublicInvite{}, ErrInviteNotFound
+		}
+		return PublicInvite{}, fmt.Errorf("failed to get invite: %w", err)
+	}
+
+	now := time.Now()
+	status := "active"
+	switch {
+	case invite.RevokedAt != nil:
+		status = "revoked"
+	case now.After(invite.EndsAt):
+		status = "expired"
+	case now.Before(invite.StartsAt):
+		status = "scheduled"
+	}
+
+	public := PublicInvite{
+		Token:           invite.Token,
+		GuestName:       invite.GuestName,
+		GuestType:       invite.GuestType,
+		Block:           invite.Block,
+		ApartmentNumber: invite.ApartmentNumber,
+		StartsAt:        invite.StartsAt,
+		EndsAt:          invite.EndsAt,
+		Weekdays:        invite.RecurrenceWeekdays,
+		Timezone:        invite.CondominiumTimezone,
+		Status:          status,
+	}
+
+	if invite.DailyStartMinute != nil {
+		public.DailyStartTime = utils.ToPtr(utils.FormatClock(*invite.DailyStartMinute))
+	}
+	if invite.DailyEndMinute != nil {
+		public.DailyEndTime = utils.ToPtr(utils.FormatClock(*invite.DailyEndMinute))
+	}
+
+	return public, nil
+}
@@ -0,0 +1,78 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Bellorico323/vizen/internal/services"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const VisitorPhotoURLTTL = PackagePhotoURLTTL
+
+type GetVisitorRequestUC interface {
+	Exec(ctx context.Context, req GetVisitorRequestReq) (VisitorRequestDetails, error)
+}
+
+type GetVisitorRequestReq struct {
+	VisitorRequestID uuid.UUID
+	UserID           uuid.UUID
+}
+
+// VisitorRequestDetails carries a short-lived signed URL for the visitor photo, when there is one.
+type VisitorRequestDetails struct {
+	pgstore.VisitorRequest
+	PhotoURL *string
+}
+
+type GetVisitorRequestUseCase struct {
+	querier pgstore.Querier
+	storage services.StorageService
+}
+
+func NewGetVisitorRequestUseCase(q pgstore.Querier, s services.StorageService) *GetVisitorRequestUseCase {
+	return &GetVisitorRequestUseCase{
+		querier: q,
+		storage: s,
+	}
+}
+
+var ErrVisitorRequestNotFound = errors.New("visitor request not found")
+
+func (uc *GetVisitorRequestUseCase) Exec(ctx context.Context, req GetVisitorRequestReq) (VisitorRequestDetails, error) {
+	visitor, err := uc.querier.GetVisitorRequestById(ctx, req.VisitorRequestID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return VisitorRequestDetails{}, ErrVisitorRequestNotFound
+		}
+		return VisitorRequestDetails{}, fmt.Errorf("failed to get visitor request: %w", err)
+	}
+
+	err = checkApartmentAccess(ctx, uc.querier, req.UserID, pgstore.Apartment{
+		ID:            visitor.ApartmentID,
+		CondominiumID: visitor.CondominiumID,
+	})
+	if err != nil {
+		return VisitorRequestDetails{}, err
+	}
+
+	return signVisitorPhoto(ctx, uc.storage, visitor)
+}
+
+func signVisitorPhoto(ctx context.Context, storage services.StorageService, visitor pgstore.VisitorRequest) (VisitorRequestDetails, error) {
+	details := VisitorRequestDetails{VisitorRequest: visitor}
+	if visitor.PhotoKey == nil {
+		return details, nil
+	}
+
+	photoURL, err := storage.SignedURL(ctx, *visitor.PhotoKey, VisitorPhotoURLTTL)
+	if err != nil {
+		return VisitorRequestDetails{}, fmt.Errorf("failed to sign visitor photo url: %w", err)
+	}
+	details.PhotoURL = &photoURL
+
+	return details, nil
+}
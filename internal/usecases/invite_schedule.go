@@ -0,0 +1,76 @@
+package usecases
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/utils"
+)
+
+var (
+	ErrInvalidRecurrence     = errors.New("recurring invites need weekdays (0-6) and a daily window with start before end")
+	ErrInviteOutsideSchedule = errors.New("Invite is not valid at this day or time")
+)
+
+// inviteRecurrence is the normalized weekly schedule of a recurring invite.
+type inviteRecurrence struct {
+	weekdays    []int16
+	startMinute *int16
+	endMinute   *int16
+}
+
+// parseInviteRecurrence validates the weekday set and "HH:MM" window. A request without any of them is a
+// one-off invite and returns an empty recurrence.
+func parseInviteRecurrence(weekdays []int16, dailyStart, dailyEnd *string) (inviteRecurrence, error) {
+	if len(weekdays) == 0 && dailyStart == nil && dailyEnd == nil {
+		return inviteRecurrence{}, nil
+	}
+
+	if len(weekdays) == 0 || dailyStart == nil || dailyEnd == nil {
+		return inviteRecurrence{}, ErrInvalidRecurrence
+	}
+
+	days := slices.Clone(weekdays)
+	slices.Sort(days)
+	days = slices.Compact(days)
+	if days[0] < 0 || days[len(days)-1] > 6 {
+		return inviteRecurrence{}, ErrInvalidRecurrence
+	}
+
+	start, err := utils.ParseClock(*dailyStart)
+	if err != nil {
+		return inviteRecurrence{}, ErrInvalidRecurrence
+	}
+	end, err := utils.ParseClock(*dailyEnd)
+	if err != nil || end <= start {
+		return inviteRecurrence{}, ErrInvalidRecurrence
+	}
+
+	return inviteRecurrence{
+		weekdays:    days,
+		startMinute: &start,
+		endMinute:   &end,
+	}, nil
+}
+
+// inviteScheduleAllows reports whether the local time falls on one of the weekdays and inside the daily window.
+func inviteScheduleAllows(weekdays []int16, startMinute, endMinute *int16, local time.Time) bool {
+	if len(weekdays) == 0 {
+		return true
+	}
+
+	if !slices.Contains(weekdays, int16(local.Weekday())) {
+		return false
+	}
+
+	minute := int16(local.Hour()*60 + local.Minute())
+	if startMinute != nil && minute < *startMinute {
+		return false
+	}
+	if endMinute != nil && minute >= *endMinute {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,108 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type JoinBookingWaitlistUC interface {
+	Exec(ctx context.Context, req JoinBookingWaitlistReq) (pgstore.BookingWaitlistEntry, error)
+}
+
+type JoinBookingWaitlistReq struct {
+	CondominiumID uuid.UUID
+	UserID        uuid.UUID
+	ApartmentID   uuid.UUID
+	CommonAreaID  uuid.UUID
+	StartsAt      time.Time
+	EndsAt        time.Time
+	// Headcount is how many people the booking would cover. Zero means 1.
+	Headcount int32
+}
+
+type JoinBookingWaitlistUseCase struct {
+	querier pgstore.Querier
+}
+
+func NewJoinBookingWaitlistUseCase(q pgstore.Querier) *JoinBookingWaitlistUseCase {
+	return &JoinBookingWaitlistUseCase{
+		querier: q,
+	}
+}
+
+// Exec puts the apartment in line for a time range that is taken right now. When the range is
+// freed it is offered to the entries in the order they joined.
+func (uc *JoinBookingWaitlistUseCase) Exec(ctx context.Context, req JoinBookingWaitlistReq) (pgstore.BookingWaitlistEntry, error) {
+	if !req.EndsAt.After(req.StartsAt) || !req.StartsAt.After(time.Now()) {
+		return pgstore.BookingWaitlistEntry{}, ErrInvalidBookingDate
+	}
+
+	isResident, err := uc.querier.CheckIsResident(ctx, pgstore.CheckIsResidentParams{
+		UserID:      req.UserID,
+		ApartmentID: req.ApartmentID,
+	})
+	if err != nil {
+		return pgstore.BookingWaitlistEntry{}, fmt.Errorf("error checking residency: %w", err)
+	}
+	if !isResident {
+		return pgstore.BookingWaitlistEntry{}, ErrNoPermission
+	}
+
+	area, err := uc.querier.GetCommonAreaById(ctx, req.CommonAreaID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pgstore.BookingWaitlistEntry{}, ErrCommonAreaNotFound
+		}
+		return pgstore.BookingWaitlistEntry{}, fmt.Errorf("failed to get common area: %w", err)
+	}
+	if area.CondominiumID != req.CondominiumID {
+		return pgstore.BookingWaitlistEntry{}, ErrCommonAreaNotFound
+	}
+
+	// A blacked-out range is never freed, so waiting for it is pointless.
+	if err := checkAreaOpen(ctx, uc.querier, area, req.StartsAt, req.EndsAt); err != nil {
+		return pgstore.BookingWaitlistEntry{}, err
+	}
+
+	headcount := req.Headcount
+	if headcount == 0 {
+		headcount = 1
+	}
+	if headcount < 0 || (area.Capacity != nil && headcount > *area.Capacity) {
+		return pgstore.BookingWaitlistEntry{}, ErrInvalidHeadcount
+	}
+
+	hasRoom, err := areaHasRoom(ctx, uc.querier, area, req.StartsAt, req.EndsAt, headcount)
+	if err != nil {
+		return pgstore.BookingWaitlistEntry{}, err
+	}
+	if hasRoom {
+		return pgstore.BookingWaitlistEntry{}, ErrSlotAvailable
+	}
+
+	entry, err := uc.querier.CreateWaitlistEntry(ctx, pgstore.CreateWaitlistEntryParams{
+		CondominiumID: area.CondominiumID,
+		CommonAreaID:  area.ID,
+		ApartmentID:   req.ApartmentID,
+		UserID:        req.UserID,
+		StartsAt:      req.StartsAt,
+		EndsAt:        req.EndsAt,
+		Headcount:     headcount,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return pgstore.BookingWaitlistEntry{}, ErrAlreadyWaitlisted
+		}
+		return pgstore.BookingWaitlistEntry{}, fmt.Errorf("failed to join waitlist: %w", err)
+	}
+
+	return entry, nil
+}
@@ -0,0 +1,94 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/services"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type LeaveBookingWaitlistUC interface {
+	Exec(ctx context.Context, req LeaveBookingWaitlistReq) error
+}
+
+type LeaveBookingWaitlistReq struct {
+	UserID  uuid.UUID
+	EntryID uuid.UUID
+}
+
+type LeaveBookingWaitlistUseCase struct {
+	pool     *pgxpool.Pool
+	notifier services.NotificationService
+}
+
+func NewLeaveBookingWaitlistUseCase(pool *pgxpool.Pool, n services.NotificationService) *LeaveBookingWaitlistUseCase {
+	return &LeaveBookingWaitlistUseCase{
+		pool:     pool,
+		notifier: n,
+	}
+}
+
+// Exec takes the apartment out of the waitlist. Declining an open offer releases the held range
+// to the next entries in line.
+func (uc *LeaveBookingWaitlistUseCase) Exec(ctx context.Context, req LeaveBookingWaitlistReq) error {
+	tx, err := uc.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pgstore.New(tx)
+
+	entry, err := qtx.GetWaitlistEntryById(ctx, req.EntryID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrWaitlistEntryNotFound
+		}
+		return fmt.Errorf("failed to get waitlist entry: %w", err)
+	}
+
+	isResident, err := qtx.CheckIsResident(ctx, pgstore.CheckIsResidentParams{
+		UserID:      req.UserID,
+		ApartmentID: entry.ApartmentID,
+	})
+	if err != nil {
+		return fmt.Errorf("error checking residency: %w", err)
+	}
+	if !isResident {
+		return ErrNoPermission
+	}
+
+	area, err := qtx.GetCommonAreaIdForUpdate(ctx, entry.CommonAreaID)
+	if err != nil {
+		return fmt.Errorf("failed to lock common area: %w", err)
+	}
+
+	if _, err := qtx.CancelWaitlistEntry(ctx, entry.ID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrWaitlistEntryInactive
+		}
+		return fmt.Errorf("failed to leave waitlist: %w", err)
+	}
+
+	var offers []pgstore.BookingWaitlistEntry
+	if entry.Status == "offered" {
+		offers, err = offerFreedRange(ctx, qtx, area, entry.StartsAt, entry.EndsAt, time.Now())
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	notifyWaitlistOffers(uc.notifier, area.Name, offers)
+
+	return nil
+}
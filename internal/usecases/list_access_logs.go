@@ -0,0 +1,84 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type ListAccessLogsUC interface {
+	Exec(ctx context.Context, req ListAccessLogsReq) ([]pgstore.ListAccessLogsRow, error)
+}
+
+type ListAccessLogsReq struct {
+	CondominiumID uuid.UUID
+	UserID        uuid.UUID
+	ApartmentID   *uuid.UUID
+	InviteID      *uuid.UUID
+	GuestType     *string
+	From          *time.Time
+	To            *time.Time
+	Limit         int32
+	Offset        int32
+}
+
+type ListAccessLogsUseCase struct {
+	querier pgstore.Querier
+}
+
+func NewListAccessLogsUseCase(q pgstore.Querier) *ListAccessLogsUseCase {
+	return &ListAccessLogsUseCase{querier: q}
+}
+
+var ErrInvalidDateRange = errors.New("from must be before to")
+
+func (uc *ListAccessLogsUseCase) Exec(ctx context.Context, req ListAccessLogsReq) ([]pgstore.ListAccessLogsRow, error) {
+	if req.From != nil && req.To != nil && !req.From.Before(*req.To) {
+		return nil, ErrInvalidDateRange
+	}
+
+	_, err := uc.querier.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
+		CondominiumID: req.CondominiumID,
+		UserID:        req.UserID,
+	})
+
+	isStaff := false
+	if err == nil {
+		isStaff = true
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	// Residents only see the entries of their own unit.
+	if !isStaff {
+		if req.ApartmentID == nil {
+			return nil, ErrNoPermission
+		}
+
+		isResident, err := uc.querier.CheckIsResident(ctx, pgstore.CheckIsResidentParams{
+			UserID:      req.UserID,
+			ApartmentID: *req.ApartmentID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !isResident {
+			return nil, ErrNoPermission
+		}
+	}
+
+	return uc.querier.ListAccessLogs(ctx, pgstore.ListAccessLogsParams{
+		CondominiumID: req.CondominiumID,
+		ApartmentID:   req.ApartmentID,
+		InviteID:      req.InviteID,
+		GuestType:     req.GuestType,
+		From:          req.From,
+		To:            req.To,
+		Limit:         req.Limit,
+		Offset:        req.Offset,
+	})
+}
@@ -0,0 +1,51 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type ListBlockedVisitorsUC interface {
+	Exec(ctx context.Context, req ListBlockedVisitorsReq) ([]pgstore.BlockedVisitor, error)
+}
+
+type ListBlockedVisitorsReq struct {
+	CondominiumID uuid.UUID
+	UserID        uuid.UUID
+}
+
+type ListBlockedVisitorsUseCase struct {
+	querier pgstore.Querier
+}
+
+func NewListBlockedVisitorsUseCase(q pgstore.Querier) *ListBlockedVisitorsUseCase {
+	return &ListBlockedVisitorsUseCase{
+		querier: q,
+	}
+}
+
+// Exec lists the active blocklist entries. Only staff can see the blocklist.
+func (uc *ListBlockedVisitorsUseCase) Exec(ctx context.Context, req ListBlockedVisitorsReq) ([]pgstore.BlockedVisitor, error) {
+	_, err := uc.querier.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
+		CondominiumID: req.CondominiumID,
+		UserID:        req.UserID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNoPermission
+		}
+		return nil, err
+	}
+
+	entries, err := uc.querier.ListBlockedVisitors(ctx, req.CondominiumID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list blocked visitors: %w", err)
+	}
+
+	return entries, nil
+}
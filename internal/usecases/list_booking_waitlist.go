@@ -0,0 +1,40 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+)
+
+type ListBookingWaitlistUC interface {
+	Exec(ctx context.Context, req ListBookingWaitlistReq) ([]pgstore.ListUserWaitlistEntriesRow, error)
+}
+
+type ListBookingWaitlistReq struct {
+	CondominiumID uuid.UUID
+	UserID        uuid.UUID
+}
+
+type ListBookingWaitlistUseCase struct {
+	querier pgstore.Querier
+}
+
+func NewListBookingWaitlistUseCase(q pgstore.Querier) *ListBookingWaitlistUseCase {
+	return &ListBookingWaitlistUseCase{
+		querier: q,
+	}
+}
+
+// Exec lists the entries the user is still waiting on, including the offers they can accept.
+func (uc *ListBookingWaitlistUseCase) Exec(ctx context.Context, req ListBookingWaitlistReq) ([]pgstore.ListUserWaitlistEntriesRow, error) {
+	entries, err := uc.querier.ListUserWaitlistEntries(ctx, pgstore.ListUserWaitlistEntriesParams{
+		CondominiumID: req.CondominiumID,
+		UserID:        req.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
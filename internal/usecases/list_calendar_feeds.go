@@ -0,0 +1,55 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+)
+
+type ListCalendarFeedsUC interface {
+	Exec(ctx context.Context, req ListCalendarFeedsReq) ([]CalendarFeedLink, error)
+}
+
+type ListCalendarFeedsReq struct {
+	UserID        uuid.UUID
+	CondominiumID uuid.UUID
+}
+
+type ListCalendarFeedsUseCase struct {
+	querier pgstore.Querier
+	signer  *auth.URLSigner
+	baseURL string
+}
+
+func NewListCalendarFeedsUseCase(q pgstore.Querier, signer *auth.URLSigner, baseURL string) *ListCalendarFeedsUseCase {
+	return &ListCalendarFeedsUseCase{
+		querier: q,
+		signer:  signer,
+		baseURL: strings.TrimRight(baseURL, "/"),
+	}
+}
+
+// Exec lists the user's active feeds in the condominium with their URLs.
+func (uc *ListCalendarFeedsUseCase) Exec(ctx context.Context, req ListCalendarFeedsReq) ([]CalendarFeedLink, error) {
+	feeds, err := uc.querier.ListUserCalendarFeeds(ctx, pgstore.ListUserCalendarFeedsParams{
+		UserID:        req.UserID,
+		CondominiumID: req.CondominiumID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list calendar feeds: %w", err)
+	}
+
+	links := make([]CalendarFeedLink, len(feeds))
+	for i, feed := range feeds {
+		links[i] = CalendarFeedLink{
+			Feed: feed,
+			URL:  calendarFeedURL(uc.signer, uc.baseURL, feed.Token),
+		}
+	}
+
+	return links, nil
+}
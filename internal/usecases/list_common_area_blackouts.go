@@ -0,0 +1,66 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type ListCommonAreaBlackoutsUC interface {
+	Exec(ctx context.Context, req ListCommonAreaBlackoutsReq) ([]pgstore.CommonAreaBlackout, error)
+}
+
+type ListCommonAreaBlackoutsReq struct {
+	UserID       uuid.UUID
+	CommonAreaID uuid.UUID
+}
+
+type ListCommonAreaBlackoutsUseCase struct {
+	querier pgstore.Querier
+}
+
+func NewListCommonAreaBlackoutsUseCase(q pgstore.Querier) *ListCommonAreaBlackoutsUseCase {
+	return &ListCommonAreaBlackoutsUseCase{
+		querier: q,
+	}
+}
+
+// Exec lists the current and upcoming blackouts of an area. Open to all members.
+func (uc *ListCommonAreaBlackoutsUseCase) Exec(ctx context.Context, req ListCommonAreaBlackoutsReq) ([]pgstore.CommonAreaBlackout, error) {
+	area, err := uc.querier.GetCommonAreaById(ctx, req.CommonAreaID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCommonAreaNotFound
+		}
+		return nil, fmt.Errorf("failed to get common area: %w", err)
+	}
+	if area.ArchivedAt != nil {
+		return nil, ErrCommonAreaNotFound
+	}
+
+	_, err = uc.querier.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
+		CondominiumID: area.CondominiumID,
+		UserID:        req.UserID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNoPermission
+		}
+		return nil, err
+	}
+
+	blackouts, err := uc.querier.ListCommonAreaBlackouts(ctx, pgstore.ListCommonAreaBlackoutsParams{
+		CommonAreaID: area.ID,
+		FromDate:     time.Now(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list blackouts: %w", err)
+	}
+
+	return blackouts, nil
+}
@@ -10,7 +10,7 @@ import (
 )
 
 type ListCommonAreasUC interface {
-	Exec(ctx context.Context, req ListCommonAreasReq) ([]pgstore.CommonArea, error)
+	Exec(ctx context.Context, req ListCommonAreasReq) ([]CommonAreaDetails, error)
 }
 
 type ListCommonAreasReq struct {
@@ -28,7 +28,7 @@ func NewListCommonAreasUseCase(q pgstore.Querier) *ListCommonAreasUseCase {
 	}
 }
 
-func (uc *ListCommonAreasUseCase) Exec(ctx context.Context, req ListCommonAreasReq) ([]pgstore.CommonArea, error) {
+func (uc *ListCommonAreasUseCase) Exec(ctx context.Context, req ListCommonAreasReq) ([]CommonAreaDetails, error) {
 	_, err := uc.querier.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
 		CondominiumID: req.CondominiumID,
 		UserID:        req.UserID,
@@ -55,5 +55,23 @@ func (uc *ListCommonAreasUseCase) Exec(ctx context.Context, req ListCommonAreasR
 		return nil, fmt.Errorf("failed to list common areas: %w", err)
 	}
 
-	return areas, nil
+	hours, err := uc.querier.ListCondominiumOpeningHours(ctx, req.CondominiumID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list opening hours: %w", err)
+	}
+
+	hoursByArea := make(map[uuid.UUID][]pgstore.CommonAreaOpeningHour)
+	for _, h := range hours {
+		hoursByArea[h.CommonAreaID] = append(hoursByArea[h.CommonAreaID], h)
+	}
+
+	result := make([]CommonAreaDetails, len(areas))
+	for i, area := range areas {
+		result[i] = CommonAreaDetails{
+			CommonArea:   area,
+			OpeningHours: hoursByArea[area.ID],
+		}
+	}
+
+	return result, nil
 }
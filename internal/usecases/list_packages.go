@@ -20,6 +20,14 @@ type PackageListItem struct {
 	ApartmentNumber *string   `json:"apartmentNumber,omitempty"`
 	ReceivedByName  *string   `json:"receivedByName,omitempty"`
 	PhotoUrl        *string   `json:"photoUrl,omitempty"`
+	PickupCode      *string   `json:"pickupCode,omitempty"`
+	Carrier         *string   `json:"carrier,omitempty"`
+	TrackingCode    *string   `json:"trackingCode,omitempty"`
+	SizeCategory    *string   `json:"sizeCategory,omitempty"`
+	IsPerishable    *bool     `json:"isPerishable,omitempty"`
+	IsFragile       *bool     `json:"isFragile,omitempty"`
+	StorageLocation *string   `json:"storageLocation,omitempty"`
+	DaysWaiting     int32     `json:"daysWaiting"`
 }
 
 type ListPackagesUC interface {
@@ -31,6 +39,15 @@ type ListPackagesReq struct {
 	CondominiumID uuid.UUID
 	ApartmentID   *uuid.UUID
 	Status        *string
+	Sort          *string
+
+	// Filters below only apply to the condominium-wide (staff) listing
+	Carrier         *string
+	TrackingCode    *string
+	SizeCategory    *string
+	IsPerishable    *bool
+	IsFragile       *bool
+	StorageLocation *string
 }
 
 type ListPackagesUseCase struct {
@@ -42,25 +59,22 @@ func NewListPackagesUseCase(q pgstore.Querier) *ListPackagesUseCase {
 }
 
 func (uc *ListPackagesUseCase) Exec(ctx context.Context, req ListPackagesReq) ([]PackageListItem, error) {
-	role, err := uc.querier.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
+	_, err := uc.querier.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
 		CondominiumID: req.CondominiumID,
 		UserID:        req.UserID,
 	})
 
-	var isAdmin bool
-
+	// Every condominium member (admin, syndic, doorman, manager) works the front desk
+	isStaff := true
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			isAdmin = false
-		} else {
+		if !errors.Is(err, pgx.ErrNoRows) {
 			return nil, err
 		}
+		isStaff = false
 	}
 
-	isAdmin = role == "admin" || role == "syndic"
-
 	if req.ApartmentID != nil {
-		if !isAdmin {
+		if !isStaff {
 			userApts, err := uc.querier.GetApartmentsByUserId(ctx, pgstore.GetApartmentsByUserIdParams{
 				UserID:        req.UserID,
 				CondominiumID: utils.ToPtr(req.CondominiumID),
@@ -84,6 +98,7 @@ func (uc *ListPackagesUseCase) Exec(ctx context.Context, req ListPackagesReq) ([
 		rows, err := uc.querier.ListPackagesByApartment(ctx, pgstore.ListPackagesByApartmentParams{
 			ApartmentID: *req.ApartmentID,
 			Status:      req.Status,
+			Sort:        req.Sort,
 		})
 		if err != nil {
 			return nil, err
@@ -98,18 +113,34 @@ func (uc *ListPackagesUseCase) Exec(ctx context.Context, req ListPackagesReq) ([
 				ReceivedAt:     r.ReceivedAt,
 				PhotoUrl:       r.PhotoUrl,
 				ReceivedByName: &r.ReceivedByName,
+				Carrier:        r.Carrier,
+				TrackingCode:   r.TrackingCode,
+				SizeCategory:   r.SizeCategory,
+				DaysWaiting:    r.DaysWaiting,
+			}
+
+			// The pickup code proves the resident is collecting, so staff must not see it
+			if !isStaff && r.Status == "pending" {
+				items[i].PickupCode = r.PickupCode
 			}
 		}
 		return items, nil
 	}
 
-	if !isAdmin {
+	if !isStaff {
 		return nil, ErrNoPermission
 	}
 
 	rows, err := uc.querier.ListPackagesByCondominium(ctx, pgstore.ListPackagesByCondominiumParams{
-		CondominiumID: req.CondominiumID,
-		Status:        req.Status,
+		CondominiumID:   req.CondominiumID,
+		Status:          req.Status,
+		Carrier:         req.Carrier,
+		TrackingCode:    NormalizeTrackingCode(req.TrackingCode),
+		SizeCategory:    req.SizeCategory,
+		IsPerishable:    req.IsPerishable,
+		IsFragile:       req.IsFragile,
+		StorageLocation: req.StorageLocation,
+		Sort:            req.Sort,
 	})
 	if err != nil {
 		return nil, err
@@ -124,6 +155,13 @@ func (uc *ListPackagesUseCase) Exec(ctx context.Context, req ListPackagesReq) ([
 			ReceivedAt:      r.ReceivedAt,
 			Block:           r.Block,
 			ApartmentNumber: &r.ApartmentNumber,
+			Carrier:         r.Carrier,
+			TrackingCode:    r.TrackingCode,
+			SizeCategory:    r.SizeCategory,
+			IsPerishable:    &r.IsPerishable,
+			IsFragile:       &r.IsFragile,
+			StorageLocation: r.StorageLocation,
+			DaysWaiting:     r.DaysWaiting,
 		}
 	}
 
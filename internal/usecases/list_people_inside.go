@@ -0,0 +1,52 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type ListPeopleInsideUC interface {
+	Exec(ctx context.Context, req ListPeopleInsideReq) ([]pgstore.ListPeopleInsideRow, error)
+}
+
+type ListPeopleInsideReq struct {
+	CondominiumID uuid.UUID
+	UserID        uuid.UUID
+}
+
+type ListPeopleInsideUseCase struct {
+	querier  pgstore.Querier
+	lookback time.Duration
+}
+
+// NewListPeopleInsideUseCase ignores entries older than lookback, so visitors whose exit was never
+// registered do not stay "inside" forever.
+func NewListPeopleInsideUseCase(q pgstore.Querier, lookback time.Duration) *ListPeopleInsideUseCase {
+	return &ListPeopleInsideUseCase{
+		querier:  q,
+		lookback: lookback,
+	}
+}
+
+func (uc *ListPeopleInsideUseCase) Exec(ctx context.Context, req ListPeopleInsideReq) ([]pgstore.ListPeopleInsideRow, error) {
+	_, err := uc.querier.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
+		CondominiumID: req.CondominiumID,
+		UserID:        req.UserID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNoPermission
+		}
+		return nil, err
+	}
+
+	return uc.querier.ListPeopleInside(ctx, pgstore.ListPeopleInsideParams{
+		CondominiumID: req.CondominiumID,
+		EnteredAt:     time.Now().Add(-uc.lookback),
+	})
+}
@@ -0,0 +1,78 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type ListPickupAuthorizationsUC interface {
+	Exec(ctx context.Context, req ListPickupAuthorizationsReq) ([]pgstore.PackagePickupAuthorization, error)
+}
+
+type ListPickupAuthorizationsReq struct {
+	UserID      uuid.UUID
+	ApartmentID uuid.UUID
+	OnlyActive  bool
+}
+
+type ListPickupAuthorizationsUseCase struct {
+	querier pgstore.Querier
+}
+
+func NewListPickupAuthorizationsUseCase(q pgstore.Querier) *ListPickupAuthorizationsUseCase {
+	return &ListPickupAuthorizationsUseCase{
+		querier: q,
+	}
+}
+
+func (uc *ListPickupAuthorizationsUseCase) Exec(ctx context.Context, req ListPickupAuthorizationsReq) ([]pgstore.PackagePickupAuthorization, error) {
+	apartment, err := uc.querier.GetApartmentById(ctx, req.ApartmentID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrApartmentNotFound
+		}
+		return nil, fmt.Errorf("failed to find apartment: %w", err)
+	}
+
+	if err := checkApartmentAccess(ctx, uc.querier, req.UserID, apartment); err != nil {
+		return nil, err
+	}
+
+	return uc.querier.ListPackagePickupAuthorizations(ctx, pgstore.ListPackagePickupAuthorizationsParams{
+		ApartmentID: apartment.ID,
+		OnlyActive:  &req.OnlyActive,
+	})
+}
+
+// checkApartmentAccess allows condominium staff and residents of the apartment.
+func checkApartmentAccess(ctx context.Context, q pgstore.Querier, userID uuid.UUID, apartment pgstore.Apartment) error {
+	_, err := q.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
+		CondominiumID: apartment.CondominiumID,
+		UserID:        userID,
+	})
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+
+	isResident, err := q.CheckIsResident(ctx, pgstore.CheckIsResidentParams{
+		UserID:      userID,
+		ApartmentID: apartment.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to check resident: %w", err)
+	}
+
+	if !isResident {
+		return ErrNoPermission
+	}
+
+	return nil
+}
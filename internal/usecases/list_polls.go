@@ -0,0 +1,79 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/utils"
+	"github.com/google/uuid"
+)
+
+type PollListItem struct {
+	pgstore.Poll
+	Status string `json:"status"`
+}
+
+type ListPollsUC interface {
+	Exec(ctx context.Context, req ListPollsReq) ([]PollListItem, error)
+}
+
+type ListPollsReq struct {
+	CondominiumID uuid.UUID
+	UserID        uuid.UUID
+	OnlyOpen      bool
+	Page          int32
+	Limit         int32
+}
+
+type ListPollsUseCase struct {
+	querier pgstore.Querier
+}
+
+func NewListPollsUseCase(q pgstore.Querier) *ListPollsUseCase {
+	return &ListPollsUseCase{
+		querier: q,
+	}
+}
+
+func (uc *ListPollsUseCase) Exec(ctx context.Context, req ListPollsReq) ([]PollListItem, error) {
+	hasAccess, err := uc.querier.CheckUserAccessToCondo(ctx, pgstore.CheckUserAccessToCondoParams{
+		UserID:        req.UserID,
+		CondominiumID: req.CondominiumID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasAccess {
+		return nil, ErrNoPermission
+	}
+
+	if req.Limit <= 0 {
+		req.Limit = 20
+	}
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+
+	polls, err := uc.querier.ListPollsByCondominium(ctx, pgstore.ListPollsByCondominiumParams{
+		CondominiumID: req.CondominiumID,
+		Limit:         req.Limit,
+		Offset:        (req.Page - 1) * req.Limit,
+		OnlyOpen:      utils.ToPtr(req.OnlyOpen),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	items := make([]PollListItem, len(polls))
+	for i, p := range polls {
+		items[i] = PollListItem{
+			Poll:   p,
+			Status: pollStatus(p, now),
+		}
+	}
+
+	return items, nil
+}
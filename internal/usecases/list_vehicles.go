@@ -0,0 +1,46 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type ListVehiclesUC interface {
+	Exec(ctx context.Context, req ListVehiclesReq) ([]pgstore.Vehicle, error)
+}
+
+type ListVehiclesReq struct {
+	UserID      uuid.UUID
+	ApartmentID uuid.UUID
+}
+
+type ListVehiclesUseCase struct {
+	querier pgstore.Querier
+}
+
+func NewListVehiclesUseCase(q pgstore.Querier) *ListVehiclesUseCase {
+	return &ListVehiclesUseCase{
+		querier: q,
+	}
+}
+
+func (uc *ListVehiclesUseCase) Exec(ctx context.Context, req ListVehiclesReq) ([]pgstore.Vehicle, error) {
+	apartment, err := uc.querier.GetApartmentById(ctx, req.ApartmentID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrApartmentNotFound
+		}
+		return nil, fmt.Errorf("failed to find apartment: %w", err)
+	}
+
+	if err := checkApartmentAccess(ctx, uc.querier, req.UserID, apartment); err != nil {
+		return nil, err
+	}
+
+	return uc.querier.ListVehiclesByApartment(ctx, apartment.ID)
+}
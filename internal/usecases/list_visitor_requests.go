@@ -0,0 +1,70 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/services"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// visitorRequestsWindow bounds the front desk list to the current shift-ish period.
+const visitorRequestsWindow = 24 * time.Hour
+
+type ListVisitorRequestsUC interface {
+	Exec(ctx context.Context, req ListVisitorRequestsReq) ([]VisitorRequestDetails, error)
+}
+
+type ListVisitorRequestsReq struct {
+	CondominiumID uuid.UUID
+	UserID        uuid.UUID
+	Status        *string
+}
+
+type ListVisitorRequestsUseCase struct {
+	querier pgstore.Querier
+	storage services.StorageService
+}
+
+func NewListVisitorRequestsUseCase(q pgstore.Querier, s services.StorageService) *ListVisitorRequestsUseCase {
+	return &ListVisitorRequestsUseCase{
+		querier: q,
+		storage: s,
+	}
+}
+
+func (uc *ListVisitorRequestsUseCase) Exec(ctx context.Context, req ListVisitorRequestsReq) ([]VisitorRequestDetails, error) {
+	_, err := uc.querier.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
+		CondominiumID: req.CondominiumID,
+		UserID:        req.UserID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNoPermission
+		}
+		return nil, err
+	}
+
+	rows, err := uc.querier.ListVisitorRequests(ctx, pgstore.ListVisitorRequestsParams{
+		CondominiumID: req.CondominiumID,
+		CreatedAt:     time.Now().Add(-visitorRequestsWindow),
+		Status:        req.Status,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list visitor requests: %w", err)
+	}
+
+	result := make([]VisitorRequestDetails, len(rows))
+	for i, row := range rows {
+		result[i], err = signVisitorPhoto(ctx, uc.storage, row)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
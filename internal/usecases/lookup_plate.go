@@ -0,0 +1,99 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type LookupPlateUC interface {
+	Exec(ctx context.Context, req LookupPlateReq) (PlateLookup, error)
+}
+
+type LookupPlateReq struct {
+	CondominiumID uuid.UUID
+	UserID        uuid.UUID
+	Plate         string
+}
+
+type PlateLookup struct {
+	Plate string
+	// Vehicle is the resident vehicle registered with the plate, if any.
+	Vehicle *pgstore.FindVehicleByPlateRow
+	Invites []PlateInvite
+}
+
+type PlateInvite struct {
+	Invite pgstore.GetInviteByTokenRow
+	// AllowedNow tells whether the invite schedule lets the guest in at this moment. Entry limits
+	// are only checked when the entry is registered.
+	AllowedNow bool
+}
+
+type LookupPlateUseCase struct {
+	querier pgstore.Querier
+}
+
+func NewLookupPlateUseCase(q pgstore.Querier) *LookupPlateUseCase {
+	return &LookupPlateUseCase{
+		querier: q,
+	}
+}
+
+func (uc *LookupPlateUseCase) Exec(ctx context.Context, req LookupPlateReq) (PlateLookup, error) {
+	plate, err := utils.NormalizePlate(req.Plate)
+	if err != nil {
+		return PlateLookup{}, ErrInvalidPlate
+	}
+
+	_, err = uc.querier.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
+		CondominiumID: req.CondominiumID,
+		UserID:        req.UserID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return PlateLookup{}, ErrNoPermission
+		}
+		return PlateLookup{}, err
+	}
+
+	result := PlateLookup{Plate: plate}
+	plates := utils.PlateVariants(plate)
+
+	vehicle, err := uc.querier.FindVehicleByPlate(ctx, pgstore.FindVehicleByPlateParams{
+		CondominiumID: req.CondominiumID,
+		Plates:        plates,
+	})
+	switch {
+	case err == nil:
+		result.Vehicle = &vehicle
+	case !errors.Is(err, pgx.ErrNoRows):
+		return PlateLookup{}, fmt.Errorf("failed to find vehicle: %w", err)
+	}
+
+	invites, err := uc.querier.ListActiveInvitesByPlate(ctx, pgstore.ListActiveInvitesByPlateParams{
+		CondominiumID: req.CondominiumID,
+		Plates:        plates,
+	})
+	if err != nil {
+		return PlateLookup{}, fmt.Errorf("failed to list invites by plate: %w", err)
+	}
+
+	now := time.Now()
+	result.Invites = make([]PlateInvite, len(invites))
+	for i, row := range invites {
+		invite := pgstore.GetInviteByTokenRow(row)
+		result.Invites[i] = PlateInvite{
+			Invite:     invite,
+			AllowedNow: checkInviteWindow(invite, now) == nil,
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,123 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/services"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+)
+
+// NotifyPendingPackagesUseCase is run by the scheduler. It reminds residents about packages still
+// waiting at the desk and warns admins about packages past the storage limit.
+type NotifyPendingPackagesUseCase struct {
+	querier          pgstore.Querier
+	notifier         services.NotificationService
+	reminderDays     []int32
+	storageLimitDays int32
+}
+
+func NewNotifyPendingPackagesUseCase(q pgstore.Querier, n services.NotificationService, reminderDays []int32, storageLimitDays int32) *NotifyPendingPackagesUseCase {
+	return &NotifyPendingPackagesUseCase{
+		querier:          q,
+		notifier:         n,
+		reminderDays:     reminderDays,
+		storageLimitDays: storageLimitDays,
+	}
+}
+
+func (uc *NotifyPendingPackagesUseCase) Exec(ctx context.Context) error {
+	if err := uc.sendReminders(ctx); err != nil {
+		return err
+	}
+
+	return uc.sendOverdueAlerts(ctx)
+}
+
+func (uc *NotifyPendingPackagesUseCase) sendReminders(ctx context.Context) error {
+	if len(uc.reminderDays) == 0 {
+		return nil
+	}
+
+	packages, err := uc.querier.ListPackagesDueForReminder(ctx, uc.reminderDays)
+	if err != nil {
+		return fmt.Errorf("failed to list packages due for reminder: %w", err)
+	}
+
+	now := time.Now()
+	for _, pkg := range packages {
+		daysWaiting := int32(now.Sub(pkg.ReceivedAt).Hours() / 24)
+
+		// Packages that crossed several thresholds while the job was down get a single reminder
+		sent := pkg.RemindersSent
+		for sent < int32(len(uc.reminderDays)) && uc.reminderDays[sent] <= daysWaiting {
+			sent++
+		}
+
+		title := "📦 Encomenda aguardando retirada"
+		body := fmt.Sprintf("Há uma encomenda esperando por você na portaria há %d dias.", daysWaiting)
+		if pkg.RecipientName != nil {
+			body = fmt.Sprintf("A encomenda para %s está na portaria há %d dias.", *pkg.RecipientName, daysWaiting)
+		}
+
+		err := uc.notifier.SendToApartmentResidents(ctx, pkg.ApartmentID, title, body, map[string]string{
+			"type":      "PACKAGE_REMINDER",
+			"packageId": pkg.ID.String(),
+		})
+		if err != nil {
+			slog.Error("Failed to send package reminder", "package_id", pkg.ID, "error", err)
+			continue
+		}
+
+		err = uc.querier.MarkPackageReminderSent(ctx, pgstore.MarkPackageReminderSentParams{
+			ID:            pkg.ID,
+			RemindersSent: sent,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to mark package reminder: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (uc *NotifyPendingPackagesUseCase) sendOverdueAlerts(ctx context.Context) error {
+	if uc.storageLimitDays <= 0 {
+		return nil
+	}
+
+	packages, err := uc.querier.ListOverduePackages(ctx, uc.storageLimitDays)
+	if err != nil {
+		return fmt.Errorf("failed to list overdue packages: %w", err)
+	}
+
+	byCondo := make(map[uuid.UUID][]uuid.UUID)
+	var condoOrder []uuid.UUID
+	for _, pkg := range packages {
+		if _, ok := byCondo[pkg.CondominiumID]; !ok {
+			condoOrder = append(condoOrder, pkg.CondominiumID)
+		}
+		byCondo[pkg.CondominiumID] = append(byCondo[pkg.CondominiumID], pkg.ID)
+	}
+
+	for _, condoID := range condoOrder {
+		ids := byCondo[condoID]
+
+		title := "⚠️ Encomendas fora do prazo"
+		body := fmt.Sprintf("%d encomenda(s) estão na portaria há mais de %d dias. Considere devolvê-las ao remetente.", len(ids), uc.storageLimitDays)
+
+		if err := uc.notifier.SendToCondoAdmins(ctx, condoID, title, body); err != nil {
+			slog.Error("Failed to send overdue packages alert", "condo_id", condoID, "error", err)
+			continue
+		}
+
+		if err := uc.querier.MarkPackagesOverdueNotified(ctx, ids); err != nil {
+			return fmt.Errorf("failed to mark overdue packages: %w", err)
+		}
+	}
+
+	return nil
+}
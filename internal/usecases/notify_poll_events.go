@@ -0,0 +1,75 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/services"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+)
+
+// NotifyPollEventsUseCase is run periodically by the scheduler. It announces
+// polls that have just opened and warns residents when a poll is about to close.
+type NotifyPollEventsUseCase struct {
+	querier       pgstore.Querier
+	notifier      services.NotificationService
+	closingWindow time.Duration
+}
+
+func NewNotifyPollEventsUseCase(q pgstore.Querier, n services.NotificationService, closingWindow time.Duration) *NotifyPollEventsUseCase {
+	return &NotifyPollEventsUseCase{
+		querier:       q,
+		notifier:      n,
+		closingWindow: closingWindow,
+	}
+}
+
+func (uc *NotifyPollEventsUseCase) Exec(ctx context.Context) error {
+	opened, err := uc.querier.ListPollsToAnnounceOpening(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list opened polls: %w", err)
+	}
+
+	for _, poll := range opened {
+		err := uc.notifier.SendToCondoResidents(
+			ctx,
+			poll.CondominiumID,
+			"🗳️ Nova votação aberta",
+			fmt.Sprintf("A votação '%s' está aberta até %s.", poll.Title, poll.ClosesAt.Format("02/01 15:04")),
+		)
+		if err != nil {
+			slog.Error("Failed to notify residents about opened poll", "poll_id", poll.ID, "error", err)
+			continue
+		}
+
+		if err := uc.querier.MarkPollOpenNotified(ctx, poll.ID); err != nil {
+			return fmt.Errorf("failed to mark poll as announced: %w", err)
+		}
+	}
+
+	closing, err := uc.querier.ListPollsClosingSoon(ctx, time.Now().Add(uc.closingWindow))
+	if err != nil {
+		return fmt.Errorf("failed to list polls closing soon: %w", err)
+	}
+
+	for _, poll := range closing {
+		err := uc.notifier.SendToCondoResidents(
+			ctx,
+			poll.CondominiumID,
+			"⏳ Votação encerrando",
+			fmt.Sprintf("A votação '%s' encerra em breve. Ainda dá tempo de votar!", poll.Title),
+		)
+		if err != nil {
+			slog.Error("Failed to notify residents about closing poll", "poll_id", poll.ID, "error", err)
+			continue
+		}
+
+		if err := uc.querier.MarkPollClosingNotified(ctx, poll.ID); err != nil {
+			return fmt.Errorf("failed to mark poll closing as notified: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type RegisterAccessExitUC interface {
+	Exec(ctx context.Context, req RegisterAccessExitReq) (pgstore.AccessLog, error)
+}
+
+type RegisterAccessExitReq struct {
+	AccessLogID uuid.UUID
+	UserID      uuid.UUID
+}
+
+type RegisterAccessExitUseCase struct {
+	querier pgstore.Querier
+}
+
+func NewRegisterAccessExitUseCase(q pgstore.Querier) *RegisterAccessExitUseCase {
+	return &RegisterAccessExitUseCase{querier: q}
+}
+
+var (
+	ErrAccessLogNotFound   = errors.New("access log not found")
+	ErrAccessAlreadyExited = errors.New("exit already registered for this access")
+)
+
+func (uc *RegisterAccessExitUseCase) Exec(ctx context.Context, req RegisterAccessExitReq) (pgstore.AccessLog, error) {
+	entry, err := uc.querier.GetAccessLogById(ctx, req.AccessLogID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pgstore.AccessLog{}, ErrAccessLogNotFound
+		}
+		return pgstore.AccessLog{}, fmt.Errorf("failed to get access log: %w", err)
+	}
+
+	_, err = uc.querier.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
+		CondominiumID: entry.CondominiumID,
+		UserID:        req.UserID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pgstore.AccessLog{}, ErrNoPermission
+		}
+		return pgstore.AccessLog{}, err
+	}
+
+	if entry.ExitedAt != nil {
+		return pgstore.AccessLog{}, ErrAccessAlreadyExited
+	}
+
+	updated, err := uc.querier.RegisterAccessExit(ctx, pgstore.RegisterAccessExitParams{
+		ID:               entry.ID,
+		ExitRegisteredBy: utils.ToPtr(req.UserID),
+	})
+	if err != nil {
+		// Another doorman registered the exit in the meantime.
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pgstore.AccessLog{}, ErrAccessAlreadyExited
+		}
+		return pgstore.AccessLog{}, fmt.Errorf("failed to register exit: %w", err)
+	}
+
+	return updated, nil
+}
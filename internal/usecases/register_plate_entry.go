@@ -0,0 +1,153 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/services"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RegisterPlateEntryUC interface {
+	Exec(ctx context.Context, req RegisterPlateEntryReq) (PlateEntry, error)
+}
+
+type RegisterPlateEntryReq struct {
+	CondominiumID uuid.UUID
+	UserID        uuid.UUID
+	Plate         string
+}
+
+// PlateEntry tells what let the plate in: either a resident Vehicle or a guest Invite.
+type PlateEntry struct {
+	Plate            string
+	Vehicle          *pgstore.FindVehicleByPlateRow
+	Invite           *pgstore.GetInviteByTokenRow
+	RemainingEntries *int32
+}
+
+type RegisterPlateEntryUseCase struct {
+	pool     *pgxpool.Pool
+	notifier services.NotificationService
+}
+
+func NewRegisterPlateEntryUseCase(pool *pgxpool.Pool, n services.NotificationService) *RegisterPlateEntryUseCase {
+	return &RegisterPlateEntryUseCase{
+		pool:     pool,
+		notifier: n,
+	}
+}
+
+var ErrPlateNotAuthorized = errors.New("no resident vehicle or active invite authorizes this plate")
+
+// Exec lets a plate in when it belongs to a resident vehicle or to an invite that is usable right
+// now, and records the entry in the access log.
+func (uc *RegisterPlateEntryUseCase) Exec(ctx context.Context, req RegisterPlateEntryReq) (PlateEntry, error) {
+	plate, err := utils.NormalizePlate(req.Plate)
+	if err != nil {
+		return PlateEntry{}, ErrInvalidPlate
+	}
+
+	tx, err := uc.pool.Begin(ctx)
+	if err != nil {
+		return PlateEntry{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pgstore.New(tx)
+
+	_, err = qtx.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
+		CondominiumID: req.CondominiumID,
+		UserID:        req.UserID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return PlateEntry{}, ErrNoPermission
+		}
+		return PlateEntry{}, err
+	}
+
+	plates := utils.PlateVariants(plate)
+	entry := PlateEntry{Plate: plate}
+
+	vehicle, err := qtx.FindVehicleByPlate(ctx, pgstore.FindVehicleByPlateParams{
+		CondominiumID: req.CondominiumID,
+		Plates:        plates,
+	})
+	switch {
+	case err == nil:
+		_, err = qtx.LogAccessEntry(ctx, pgstore.LogAccessEntryParams{
+			CondominiumID: vehicle.CondominiumID,
+			AuthorizedBy:  utils.ToPtr(req.UserID),
+			ApartmentID:   vehicle.ApartmentID,
+			VehicleID:     utils.ToPtr(vehicle.ID),
+			Plate:         &plate,
+		})
+		if err != nil {
+			return PlateEntry{}, fmt.Errorf("failed to create access entry: %w", err)
+		}
+		entry.Vehicle = &vehicle
+
+	case errors.Is(err, pgx.ErrNoRows):
+		invite, remaining, err := uc.enterWithInvite(ctx, qtx, req, plates, plate)
+		if err != nil {
+			return PlateEntry{}, err
+		}
+		entry.Invite = &invite
+		entry.RemainingEntries = remaining
+
+	default:
+		return PlateEntry{}, fmt.Errorf("failed to find vehicle: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return PlateEntry{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	if entry.Invite != nil {
+		go notifyGuestArrival(uc.notifier, *entry.Invite)
+	}
+
+	return entry, nil
+}
+
+// enterWithInvite uses the first invite carrying the plate that is usable right now.
+func (uc *RegisterPlateEntryUseCase) enterWithInvite(ctx context.Context, qtx *pgstore.Queries, req RegisterPlateEntryReq, plates []string, plate string) (pgstore.GetInviteByTokenRow, *int32, error) {
+	invites, err := qtx.ListActiveInvitesByPlate(ctx, pgstore.ListActiveInvitesByPlateParams{
+		CondominiumID: req.CondominiumID,
+		Plates:        plates,
+	})
+	if err != nil {
+		return pgstore.GetInviteByTokenRow{}, nil, fmt.Errorf("failed to list invites by plate: %w", err)
+	}
+
+	now := time.Now()
+	for _, row := range invites {
+		invite := pgstore.GetInviteByTokenRow(row)
+		if checkInviteWindow(invite, now) != nil {
+			continue
+		}
+
+		if err := checkInviteBlocklist(ctx, qtx, uc.notifier, invite); err != nil {
+			return pgstore.GetInviteByTokenRow{}, nil, err
+		}
+
+		remaining, err := logInviteEntry(ctx, qtx, invite, req.UserID, &plate)
+		if errors.Is(err, ErrInviteExhausted) {
+			continue
+		}
+		if err != nil {
+			return pgstore.GetInviteByTokenRow{}, nil, err
+		}
+
+		return invite, remaining, nil
+	}
+
+	return pgstore.GetInviteByTokenRow{}, nil, ErrPlateNotAuthorized
+}
@@ -0,0 +1,164 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/services"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ReleaseBookingDepositUC interface {
+	Exec(ctx context.Context, req ReleaseBookingDepositReq) (pgstore.Booking, error)
+}
+
+type ReleaseBookingDepositReq struct {
+	UserID    uuid.UUID
+	BookingID uuid.UUID
+	// RetainedCents is the part of the deposit kept to cover damages. Reason is required with it.
+	RetainedCents int64
+	Reason        *string
+}
+
+type ReleaseBookingDepositUseCase struct {
+	pool     *pgxpool.Pool
+	notifier services.NotificationService
+}
+
+func NewReleaseBookingDepositUseCase(pool *pgxpool.Pool, n services.NotificationService) *ReleaseBookingDepositUseCase {
+	return &ReleaseBookingDepositUseCase{
+		pool:     pool,
+		notifier: n,
+	}
+}
+
+var (
+	ErrBookingHasNoDeposit     = errors.New("booking has no deposit")
+	ErrDepositNotPaid          = errors.New("the deposit bill was not paid")
+	ErrDepositAlreadyReleased  = errors.New("the deposit was already released")
+	ErrBookingNotFinished      = errors.New("the deposit can only be released after the booking ends or is cancelled")
+	ErrInvalidRetainedAmount   = errors.New("retained amount must be between zero and the deposit value")
+	ErrRetentionReasonRequired = errors.New("a reason is required to retain part of the deposit")
+)
+
+// Exec records that staff gave the deposit of a booking back to the apartment, keeping part of it
+// when there were damages.
+func (uc *ReleaseBookingDepositUseCase) Exec(ctx context.Context, req ReleaseBookingDepositReq) (pgstore.Booking, error) {
+	var reason *string
+	if req.Reason != nil {
+		if trimmed := strings.TrimSpace(*req.Reason); trimmed != "" {
+			reason = &trimmed
+		}
+	}
+	if req.RetainedCents > 0 && reason == nil {
+		return pgstore.Booking{}, ErrRetentionReasonRequired
+	}
+
+	tx, err := uc.pool.Begin(ctx)
+	if err != nil {
+		return pgstore.Booking{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pgstore.New(tx)
+
+	booking, err := qtx.GetBookingById(ctx, req.BookingID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pgstore.Booking{}, ErrBookingNotFound
+		}
+		return pgstore.Booking{}, fmt.Errorf("failed to get booking: %w", err)
+	}
+
+	role, err := qtx.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
+		CondominiumID: booking.CondominiumID,
+		UserID:        req.UserID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pgstore.Booking{}, ErrNoPermission
+		}
+		return pgstore.Booking{}, err
+	}
+
+	if role != "admin" && role != "syndic" {
+		return pgstore.Booking{}, ErrNoPermission
+	}
+
+	if booking.DepositBillID == nil {
+		return pgstore.Booking{}, ErrBookingHasNoDeposit
+	}
+	if booking.DepositReleasedAt != nil {
+		return pgstore.Booking{}, ErrDepositAlreadyReleased
+	}
+
+	finished := booking.Status == "cancelled" || (booking.Status == "confirmed" && !time.Now().Before(booking.EndsAt))
+	if !finished {
+		return pgstore.Booking{}, ErrBookingNotFinished
+	}
+
+	// Cancelled deposit bills are not returned either: there is nothing to give back.
+	deposit, err := qtx.GetBillById(ctx, pgstore.GetBillByIdParams{
+		ID:            *booking.DepositBillID,
+		CondominiumID: booking.CondominiumID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pgstore.Booking{}, ErrDepositNotPaid
+		}
+		return pgstore.Booking{}, fmt.Errorf("failed to get deposit bill: %w", err)
+	}
+	if deposit.Status != "paid" {
+		return pgstore.Booking{}, ErrDepositNotPaid
+	}
+
+	if req.RetainedCents < 0 || req.RetainedCents > deposit.ValueInCents {
+		return pgstore.Booking{}, ErrInvalidRetainedAmount
+	}
+
+	released, err := qtx.ReleaseBookingDeposit(ctx, pgstore.ReleaseBookingDepositParams{
+		ID:                     booking.ID,
+		DepositReleasedBy:      utils.ToPtr(req.UserID),
+		DepositRetainedCents:   utils.ToPtr(req.RetainedCents),
+		DepositRetentionReason: reason,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pgstore.Booking{}, ErrDepositAlreadyReleased
+		}
+		return pgstore.Booking{}, fmt.Errorf("failed to release deposit: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return pgstore.Booking{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	go func() {
+		bgCtx := context.Background()
+
+		returned := float64(deposit.ValueInCents-req.RetainedCents) / 100
+		title := "💰 Caução liberada"
+		body := fmt.Sprintf("A caução do agendamento de '%s' foi liberada: R$ %.2f devolvidos.", booking.CommonAreaName, returned)
+		if req.RetainedCents > 0 {
+			body += fmt.Sprintf(" R$ %.2f retidos: %s", float64(req.RetainedCents)/100, *reason)
+		}
+
+		err := uc.notifier.SendToApartmentResidents(bgCtx, booking.ApartmentID, title, body, map[string]string{
+			"type":      "BOOKING_DEPOSIT_RELEASED",
+			"bookingId": booking.ID.String(),
+		})
+		if err != nil {
+			slog.Error("Failed to send async notification", "booking_id", booking.ID, "error", err)
+		}
+	}()
+
+	return released, nil
+}
@@ -0,0 +1,83 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const PackageReleaseWindow = 5 * time.Minute
+
+type ReleasePackageUC interface {
+	Exec(ctx context.Context, req ReleasePackageReq) (time.Time, error)
+}
+
+type ReleasePackageReq struct {
+	PackageID  uuid.UUID
+	UserID     uuid.UUID
+	PickupCode *string
+}
+
+type ReleasePackageUseCase struct {
+	querier pgstore.Querier
+}
+
+func NewReleasePackageUseCase(q pgstore.Querier) *ReleasePackageUseCase {
+	return &ReleasePackageUseCase{
+		querier: q,
+	}
+}
+
+// Exec is called by the doorman once the resident is at the desk and showed the pickup code.
+// It opens a short window in which the resident can confirm the pickup from the app.
+func (uc *ReleasePackageUseCase) Exec(ctx context.Context, req ReleasePackageReq) (time.Time, error) {
+	packg, err := uc.querier.GetPackageById(ctx, req.PackageID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return time.Time{}, ErrPackageNotFound
+		}
+		return time.Time{}, fmt.Errorf("failed to get package: %w", err)
+	}
+
+	_, err = uc.querier.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
+		CondominiumID: packg.CondominiumID,
+		UserID:        req.UserID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return time.Time{}, ErrNoPermission
+		}
+		return time.Time{}, err
+	}
+
+	if packg.Status == "returned_to_sender" {
+		return time.Time{}, ErrPackageReturned
+	}
+
+	if packg.Status != "pending" {
+		return time.Time{}, ErrPackageAlreadyWithdrawn
+	}
+
+	if err := verifyPickupCode(ctx, uc.querier, packg, req.PickupCode); err != nil {
+		return time.Time{}, err
+	}
+
+	releaseUntil := time.Now().Add(PackageReleaseWindow)
+
+	err = uc.querier.ReleasePackageAtDesk(ctx, pgstore.ReleasePackageAtDeskParams{
+		ID:               packg.ID,
+		DeskReleaseUntil: &releaseUntil,
+		DeskReleasedBy:   utils.ToPtr(req.UserID),
+	})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to release package: %w", err)
+	}
+
+	return releaseUntil, nil
+}
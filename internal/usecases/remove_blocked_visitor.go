@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type RemoveBlockedVisitorUC interface {
+	Exec(ctx context.Context, req RemoveBlockedVisitorReq) error
+}
+
+type RemoveBlockedVisitorReq struct {
+	UserID           uuid.UUID
+	BlockedVisitorID uuid.UUID
+}
+
+type RemoveBlockedVisitorUseCase struct {
+	querier pgstore.Querier
+}
+
+func NewRemoveBlockedVisitorUseCase(q pgstore.Querier) *RemoveBlockedVisitorUseCase {
+	return &RemoveBlockedVisitorUseCase{
+		querier: q,
+	}
+}
+
+var ErrBlockedVisitorNotFound = errors.New("blocked visitor not found")
+
+// Exec lifts a block. Doormen can add entries, but only admins and syndics can remove them.
+func (uc *RemoveBlockedVisitorUseCase) Exec(ctx context.Context, req RemoveBlockedVisitorReq) error {
+	entry, err := uc.querier.GetBlockedVisitorById(ctx, req.BlockedVisitorID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrBlockedVisitorNotFound
+		}
+		return fmt.Errorf("failed to get blocked visitor: %w", err)
+	}
+
+	if entry.RemovedAt != nil {
+		return ErrBlockedVisitorNotFound
+	}
+
+	role, err := uc.querier.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
+		CondominiumID: entry.CondominiumID,
+		UserID:        req.UserID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNoPermission
+		}
+		return err
+	}
+
+	if role != "admin" && role != "syndic" {
+		return ErrNoPermission
+	}
+
+	err = uc.querier.RemoveBlockedVisitor(ctx, pgstore.RemoveBlockedVisitorParams{
+		ID:        entry.ID,
+		RemovedBy: &req.UserID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove blocked visitor: %w", err)
+	}
+
+	return nil
+}
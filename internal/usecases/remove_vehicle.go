@@ -0,0 +1,52 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type RemoveVehicleUC interface {
+	Exec(ctx context.Context, req RemoveVehicleReq) error
+}
+
+type RemoveVehicleReq struct {
+	UserID    uuid.UUID
+	VehicleID uuid.UUID
+}
+
+type RemoveVehicleUseCase struct {
+	querier pgstore.Querier
+}
+
+func NewRemoveVehicleUseCase(q pgstore.Querier) *RemoveVehicleUseCase {
+	return &RemoveVehicleUseCase{
+		querier: q,
+	}
+}
+
+var ErrVehicleNotFound = errors.New("vehicle not found")
+
+func (uc *RemoveVehicleUseCase) Exec(ctx context.Context, req RemoveVehicleReq) error {
+	vehicle, err := uc.querier.GetVehicleById(ctx, req.VehicleID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrVehicleNotFound
+		}
+		return fmt.Errorf("failed to get vehicle: %w", err)
+	}
+
+	err = checkApartmentAccess(ctx, uc.querier, req.UserID, pgstore.Apartment{
+		ID:            vehicle.ApartmentID,
+		CondominiumID: vehicle.CondominiumID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return uc.querier.RemoveVehicle(ctx, vehicle.ID)
+}
@@ -0,0 +1,98 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Bellorico323/vizen/internal/services"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type ResetPackagePickupCodeUC interface {
+	Exec(ctx context.Context, req ResetPackagePickupCodeReq) error
+}
+
+type ResetPackagePickupCodeReq struct {
+	PackageID uuid.UUID
+	UserID    uuid.UUID
+}
+
+type ResetPackagePickupCodeUseCase struct {
+	querier  pgstore.Querier
+	notifier services.NotificationService
+}
+
+func NewResetPackagePickupCodeUseCase(q pgstore.Querier, n services.NotificationService) *ResetPackagePickupCodeUseCase {
+	return &ResetPackagePickupCodeUseCase{
+		querier:  q,
+		notifier: n,
+	}
+}
+
+func (uc *ResetPackagePickupCodeUseCase) Exec(ctx context.Context, req ResetPackagePickupCodeReq) error {
+	packg, err := uc.querier.GetPackageById(ctx, req.PackageID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrPackageNotFound
+		}
+		return fmt.Errorf("failed to get package: %w", err)
+	}
+
+	role, err := uc.querier.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
+		CondominiumID: packg.CondominiumID,
+		UserID:        req.UserID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNoPermission
+		}
+		return err
+	}
+
+	if role != "admin" && role != "syndic" {
+		return ErrNoPermission
+	}
+
+	if packg.Status == "returned_to_sender" {
+		return ErrPackageReturned
+	}
+
+	if packg.Status != "pending" {
+		return ErrPackageAlreadyWithdrawn
+	}
+
+	pickupCode, err := generatePickupCode()
+	if err != nil {
+		return fmt.Errorf("failed to generate pickup code: %w", err)
+	}
+
+	err = uc.querier.ResetPackagePickupCode(ctx, pgstore.ResetPackagePickupCodeParams{
+		ID:         packg.ID,
+		PickupCode: &pickupCode,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reset pickup code: %w", err)
+	}
+
+	title := "🔑 Novo código de retirada"
+	body := fmt.Sprintf("O código de retirada da sua encomenda foi redefinido. Novo código: %s", pickupCode)
+
+	go func() {
+		bgCtx := context.Background()
+
+		err := uc.notifier.SendToApartmentResidents(bgCtx, packg.ApartmentID, title, body, map[string]string{
+			"type":       "PACKAGE_ARRIVED",
+			"packageId":  packg.ID.String(),
+			"pickupCode": pickupCode,
+		})
+		if err != nil {
+			slog.Error("Failed to send async notification", "package_id", packg.ID, "error", err)
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/services"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type RespondVisitorRequestUC interface {
+	Exec(ctx context.Context, req RespondVisitorRequestReq) (pgstore.VisitorRequest, error)
+}
+
+type RespondVisitorRequestReq struct {
+	VisitorRequestID uuid.UUID
+	UserID           uuid.UUID
+	Approve          bool
+}
+
+type RespondVisitorRequestUseCase struct {
+	pool     *pgxpool.Pool
+	notifier services.NotificationService
+	events   services.VisitorEventBroker
+}
+
+func NewRespondVisitorRequestUseCase(pool *pgxpool.Pool, n services.NotificationService, e services.VisitorEventBroker) *RespondVisitorRequestUseCase {
+	return &RespondVisitorRequestUseCase{
+		pool:     pool,
+		notifier: n,
+		events:   e,
+	}
+}
+
+var (
+	ErrVisitorRequestNotPending = errors.New("visitor request was already answered")
+	ErrVisitorRequestExpired    = errors.New("visitor request has expired")
+)
+
+// Exec records the resident's answer. An approval also registers the entry in the access log, so
+// the walk-in shows up alongside invite entries. Visitors blocked after the request was created are
+// refused on approval.
+func (uc *RespondVisitorRequestUseCase) Exec(ctx context.Context, req RespondVisitorRequestReq) (pgstore.VisitorRequest, error) {
+	tx, err := uc.pool.Begin(ctx)
+	if err != nil {
+		return pgstore.VisitorRequest{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pgstore.New(tx)
+
+	visitor, err := qtx.GetVisitorRequestById(ctx, req.VisitorRequestID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pgstore.VisitorRequest{}, ErrVisitorRequestNotFound
+		}
+		return pgstore.VisitorRequest{}, fmt.Errorf("failed to get visitor request: %w", err)
+	}
+
+	isResident, err := qtx.CheckIsResident(ctx, pgstore.CheckIsResidentParams{
+		UserID:      req.UserID,
+		ApartmentID: visitor.ApartmentID,
+	})
+	if err != nil {
+		return pgstore.VisitorRequest{}, fmt.Errorf("failed to check resident: %w", err)
+	}
+
+	if !isResident {
+		return pgstore.VisitorRequest{}, ErrNoPermission
+	}
+
+	if visitor.Status == "expired" || (visitor.Status == "pending" && !time.Now().Before(visitor.ExpiresAt)) {
+		return pgstore.VisitorRequest{}, ErrVisitorRequestExpired
+	}
+
+	if visitor.Status != "pending" {
+		return pgstore.VisitorRequest{}, ErrVisitorRequestNotPending
+	}
+
+	status := "denied"
+	if req.Approve {
+		err := checkVisitorRequestBlocklist(ctx, qtx, uc.notifier, visitor.CondominiumID, visitor.VisitorName, visitor.VisitorDocument)
+		if err != nil {
+			return pgstore.VisitorRequest{}, err
+		}
+		status = "approved"
+	}
+
+	decided, err := qtx.DecideVisitorRequest(ctx, pgstore.DecideVisitorRequestParams{
+		ID:        visitor.ID,
+		Status:    status,
+		DecidedBy: utils.ToPtr(req.UserID),
+	})
+	if err != nil {
+		// Another resident answered, or the request expired, in the meantime.
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pgstore.VisitorRequest{}, ErrVisitorRequestNotPending
+		}
+		return pgstore.VisitorRequest{}, fmt.Errorf("failed to update visitor request: %w", err)
+	}
+
+	if req.Approve {
+		_, err = qtx.LogAccessEntry(ctx, pgstore.LogAccessEntryParams{
+			CondominiumID:    decided.CondominiumID,
+			AuthorizedBy:     utils.ToPtr(req.UserID),
+			ApartmentID:      decided.ApartmentID,
+			VisitorRequestID: utils.ToPtr(decided.ID),
+		})
+		if err != nil {
+			return pgstore.VisitorRequest{}, fmt.Errorf("failed to create access entry: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return pgstore.VisitorRequest{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	uc.events.Publish(services.VisitorEvent{Type: services.VisitorRequestUpdated, Request: decided})
+
+	return decided, nil
+}
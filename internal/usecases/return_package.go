@@ -0,0 +1,96 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/Bellorico323/vizen/internal/services"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type ReturnPackageUC interface {
+	Exec(ctx context.Context, req ReturnPackageReq) error
+}
+
+type ReturnPackageReq struct {
+	PackageID uuid.UUID
+	UserID    uuid.UUID
+}
+
+type ReturnPackageUseCase struct {
+	querier  pgstore.Querier
+	notifier services.NotificationService
+}
+
+func NewReturnPackageUseCase(q pgstore.Querier, n services.NotificationService) *ReturnPackageUseCase {
+	return &ReturnPackageUseCase{
+		querier:  q,
+		notifier: n,
+	}
+}
+
+func (uc *ReturnPackageUseCase) Exec(ctx context.Context, req ReturnPackageReq) error {
+	packg, err := uc.querier.GetPackageById(ctx, req.PackageID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrPackageNotFound
+		}
+		return fmt.Errorf("failed to get package: %w", err)
+	}
+
+	_, err = uc.querier.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
+		CondominiumID: packg.CondominiumID,
+		UserID:        req.UserID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNoPermission
+		}
+		return err
+	}
+
+	if packg.Status == "returned_to_sender" {
+		return ErrPackageReturned
+	}
+
+	if packg.Status != "pending" {
+		return ErrPackageAlreadyWithdrawn
+	}
+
+	// Only pending packages are updated: a concurrent withdrawal may have won the race.
+	_, err = uc.querier.UpdatePackageToReturned(ctx, pgstore.UpdatePackageToReturnedParams{
+		ID:         packg.ID,
+		ReturnedBy: utils.ToPtr(req.UserID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrPackageNotPending
+		}
+		return fmt.Errorf("failed to return package: %w", err)
+	}
+
+	title := "↩️ Encomenda devolvida"
+	body := "Uma encomenda do seu apartamento não foi retirada e foi devolvida ao remetente."
+	if packg.RecipientName != nil {
+		body = fmt.Sprintf("A encomenda para %s não foi retirada e foi devolvida ao remetente.", *packg.RecipientName)
+	}
+
+	go func() {
+		bgCtx := context.Background()
+
+		err := uc.notifier.SendToApartmentResidents(bgCtx, packg.ApartmentID, title, body, map[string]string{
+			"type":      "PACKAGE_RETURNED",
+			"packageId": packg.ID.String(),
+		})
+		if err != nil {
+			slog.Error("Failed to send async notification", "package_id", packg.ID, "error", err)
+		}
+	}()
+
+	return nil
+}
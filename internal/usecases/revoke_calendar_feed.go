@@ -0,0 +1,48 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type RevokeCalendarFeedUC interface {
+	Exec(ctx context.Context, req RevokeCalendarFeedReq) error
+}
+
+type RevokeCalendarFeedReq struct {
+	UserID uuid.UUID
+	FeedID uuid.UUID
+}
+
+type RevokeCalendarFeedUseCase struct {
+	querier pgstore.Querier
+}
+
+func NewRevokeCalendarFeedUseCase(q pgstore.Querier) *RevokeCalendarFeedUseCase {
+	return &RevokeCalendarFeedUseCase{
+		querier: q,
+	}
+}
+
+var ErrCalendarFeedNotFound = errors.New("calendar feed not found")
+
+// Exec revokes one of the user's feeds. Its URL stops working right away.
+func (uc *RevokeCalendarFeedUseCase) Exec(ctx context.Context, req RevokeCalendarFeedReq) error {
+	_, err := uc.querier.RevokeCalendarFeed(ctx, pgstore.RevokeCalendarFeedParams{
+		ID:     req.FeedID,
+		UserID: req.UserID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrCalendarFeedNotFound
+		}
+		return fmt.Errorf("failed to revoke calendar feed: %w", err)
+	}
+
+	return nil
+}
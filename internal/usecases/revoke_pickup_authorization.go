@@ -0,0 +1,80 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type RevokePickupAuthorizationUC interface {
+	Exec(ctx context.Context, req RevokePickupAuthorizationReq) error
+}
+
+type RevokePickupAuthorizationReq struct {
+	UserID          uuid.UUID
+	AuthorizationID uuid.UUID
+}
+
+type RevokePickupAuthorizationUseCase struct {
+	querier pgstore.Querier
+}
+
+func NewRevokePickupAuthorizationUseCase(q pgstore.Querier) *RevokePickupAuthorizationUseCase {
+	return &RevokePickupAuthorizationUseCase{
+		querier: q,
+	}
+}
+
+var (
+	ErrPickupAuthorizationNotFound = errors.New("pickup authorization not found")
+	ErrPickupAuthorizationRevoked  = errors.New("pickup authorization already revoked")
+)
+
+func (uc *RevokePickupAuthorizationUseCase) Exec(ctx context.Context, req RevokePickupAuthorizationReq) error {
+	authorization, err := uc.querier.GetPackagePickupAuthorizationById(ctx, req.AuthorizationID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrPickupAuthorizationNotFound
+		}
+		return fmt.Errorf("failed to get pickup authorization: %w", err)
+	}
+
+	if authorization.RevokedAt != nil {
+		return ErrPickupAuthorizationRevoked
+	}
+
+	isResident, err := uc.querier.CheckIsResident(ctx, pgstore.CheckIsResidentParams{
+		UserID:      req.UserID,
+		ApartmentID: authorization.ApartmentID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to check resident: %w", err)
+	}
+
+	if !isResident {
+		role, err := uc.querier.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
+			CondominiumID: authorization.CondominiumID,
+			UserID:        req.UserID,
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrNoPermission
+			}
+			return err
+		}
+
+		if role != "admin" && role != "syndic" {
+			return ErrNoPermission
+		}
+	}
+
+	if err := uc.querier.RevokePackagePickupAuthorization(ctx, authorization.ID); err != nil {
+		return fmt.Errorf("failed to revoke pickup authorization: %w", err)
+	}
+
+	return nil
+}
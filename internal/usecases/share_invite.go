@@ -0,0 +1,108 @@
+package usecases
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+)
+
+type ShareInviteUC interface {
+	Exec(ctx context.Context, req ShareInviteReq) (InviteShareLink, error)
+}
+
+type ShareInviteReq struct {
+	UserID   uuid.UUID
+	InviteID uuid.UUID
+}
+
+type InviteShareLink struct {
+	URL       string
+	ExpiresAt time.Time
+}
+
+type ShareInviteUseCase struct {
+	querier pgstore.Querier
+	signer  *auth.URLSigner
+	baseURL string
+}
+
+func NewShareInviteUseCase(q pgstore.Querier, signer *auth.URLSigner, baseURL string) *ShareInviteUseCase {
+	return &ShareInviteUseCase{
+		querier: q,
+		signer:  signer,
+		baseURL: strings.TrimRight(baseURL, "/"),
+	}
+}
+
+var ErrInvalidShareLink = errors.New("invalid invite link")
+
+func (uc *ShareInviteUseCase) Exec(ctx context.Context, req ShareInviteReq) (InviteShareLink, error) {
+	invite, err := getVisibleInvite(ctx, uc.querier, req.UserID, req.InviteID)
+	if err != nil {
+		return InviteShareLink{}, err
+	}
+
+	if invite.RevokedAt != nil {
+		return InviteShareLink{}, ErrInviteAlreadyRevoked
+	}
+
+	if time.Now().After(invite.EndsAt) {
+		return InviteShareLink{}, ErrInviteExpired
+	}
+
+	return InviteShareLink{
+		URL:       fmt.Sprintf("%s/api/v1/g/%s", uc.baseURL, inviteShareCode(uc.signer, invite.Token)),
+		ExpiresAt: invite.EndsAt,
+	}, nil
+}
+
+// inviteShareCode packs the token and a compact signature into a short URL segment. The link has no
+// expiry of its own: it stops working together with the invite.
+func inviteShareCode(signer *auth.URLSigner, token uuid.UUID) string {
+	return signedTokenCode(signer, "invite", token)
+}
+
+func parseInviteShareCode(signer *auth.URLSigner, code string) (uuid.UUID, error) {
+	token, ok := parseSignedTokenCode(signer, "invite", code)
+	if !ok {
+		return uuid.Nil, ErrInvalidShareLink
+	}
+	return token, nil
+}
+
+// signedTokenCode encodes a token with a compact signature bound to purpose, so a code made for
+// one kind of link is never accepted by another.
+func signedTokenCode(signer *auth.URLSigner, purpose string, token uuid.UUID) string {
+	encoded := base64.RawURLEncoding.EncodeToString(token[:])
+	return encoded + "." + signer.SignCompact(purpose+":"+encoded)
+}
+
+func parseSignedTokenCode(signer *auth.URLSigner, purpose, code string) (uuid.UUID, bool) {
+	encoded, signature, ok := strings.Cut(code, ".")
+	if !ok {
+		return uuid.Nil, false
+	}
+
+	if err := signer.VerifyCompact(purpose+":"+encoded, signature); err != nil {
+		return uuid.Nil, false
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return uuid.Nil, false
+	}
+
+	token, err := uuid.FromBytes(raw)
+	if err != nil {
+		return uuid.Nil, false
+	}
+
+	return token, true
+}
@@ -0,0 +1,166 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/auth"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// MaxOfflineEntriesPerSync bounds a single upload; devices send bigger backlogs in several batches.
+const MaxOfflineEntriesPerSync = 500
+
+// offlineClockSkew tolerates gate devices whose clock drifted while offline.
+const offlineClockSkew = 5 * time.Minute
+
+const (
+	OfflineEntryAccepted  = "accepted"
+	OfflineEntryDuplicate = "duplicate"
+	OfflineEntryRejected  = "rejected"
+)
+
+type SyncOfflineEntriesUC interface {
+	Exec(ctx context.Context, req SyncOfflineEntriesReq) ([]OfflineEntryResult, error)
+}
+
+type SyncOfflineEntriesReq struct {
+	CondominiumID uuid.UUID
+	UserID        uuid.UUID
+	Entries       []OfflineEntry
+}
+
+// OfflineEntry is an entry a gate device let in while offline. ID is generated by the device and
+// identifies the entry across uploads.
+type OfflineEntry struct {
+	ID        uuid.UUID
+	Token     string
+	EnteredAt time.Time
+}
+
+type OfflineEntryResult struct {
+	ID     uuid.UUID
+	Status string
+	// Reason explains why the entry was rejected.
+	Reason      *string
+	AccessLogID *uuid.UUID
+}
+
+type SyncOfflineEntriesUseCase struct {
+	querier pgstore.Querier
+	signer  *auth.InviteTokenSigner
+}
+
+func NewSyncOfflineEntriesUseCase(q pgstore.Querier, signer *auth.InviteTokenSigner) *SyncOfflineEntriesUseCase {
+	return &SyncOfflineEntriesUseCase{
+		querier: q,
+		signer:  signer,
+	}
+}
+
+var (
+	ErrTooManyOfflineEntries       = fmt.Errorf("at most %d entries can be synced at once", MaxOfflineEntriesPerSync)
+	ErrOfflineEntryWrongCondo      = errors.New("invite token belongs to another condominium")
+	ErrOfflineEntryOutsideValidity = errors.New("entry time is outside the invite validity window")
+)
+
+// Exec records the entries gate devices let in while offline. Each entry is checked on its own:
+// the token must carry a valid signature and the entry must fall inside the invite validity
+// window. Entries already uploaded are reported as duplicates, so devices can retry a batch safely.
+func (uc *SyncOfflineEntriesUseCase) Exec(ctx context.Context, req SyncOfflineEntriesReq) ([]OfflineEntryResult, error) {
+	if len(req.Entries) > MaxOfflineEntriesPerSync {
+		return nil, ErrTooManyOfflineEntries
+	}
+
+	_, err := uc.querier.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
+		CondominiumID: req.CondominiumID,
+		UserID:        req.UserID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNoPermission
+		}
+		return nil, err
+	}
+
+	results := make([]OfflineEntryResult, len(req.Entries))
+	for i, entry := range req.Entries {
+		result := OfflineEntryResult{ID: entry.ID}
+
+		accessLog, err := uc.syncEntry(ctx, req, entry)
+		switch {
+		case err == nil:
+			result.Status = OfflineEntryAccepted
+			result.AccessLogID = &accessLog.ID
+		case errors.Is(err, pgx.ErrNoRows):
+			result.Status = OfflineEntryDuplicate
+		case errors.Is(err, auth.ErrInvalidInviteToken),
+			errors.Is(err, ErrOfflineEntryWrongCondo),
+			errors.Is(err, ErrOfflineEntryOutsideValidity),
+			errors.Is(err, ErrInviteNotFound):
+			result.Status = OfflineEntryRejected
+			result.Reason = utils.ToPtr(err.Error())
+		default:
+			return nil, err
+		}
+
+		results[i] = result
+	}
+
+	return results, nil
+}
+
+// syncEntry returns pgx.ErrNoRows when the entry was already uploaded.
+func (uc *SyncOfflineEntriesUseCase) syncEntry(ctx context.Context, req SyncOfflineEntriesReq, entry OfflineEntry) (pgstore.AccessLog, error) {
+	claims, err := uc.signer.Parse(entry.Token)
+	if err != nil {
+		return pgstore.AccessLog{}, err
+	}
+
+	if claims.CondominiumID != req.CondominiumID {
+		return pgstore.AccessLog{}, ErrOfflineEntryWrongCondo
+	}
+
+	if entry.EnteredAt.Before(claims.NotBefore.Add(-offlineClockSkew)) ||
+		entry.EnteredAt.After(claims.ExpiresAt.Add(offlineClockSkew)) ||
+		entry.EnteredAt.After(time.Now().Add(offlineClockSkew)) {
+		return pgstore.AccessLog{}, ErrOfflineEntryOutsideValidity
+	}
+
+	inviteID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return pgstore.AccessLog{}, auth.ErrInvalidInviteToken
+	}
+
+	invite, err := uc.querier.GetInviteById(ctx, inviteID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pgstore.AccessLog{}, ErrInviteNotFound
+		}
+		return pgstore.AccessLog{}, fmt.Errorf("failed to get invite: %w", err)
+	}
+
+	// The guest is already in: entries are recorded even when the invite was revoked or ran out of
+	// entries in the meantime, so the log matches what happened at the gate.
+	accessLog, err := uc.querier.LogOfflineAccessEntry(ctx, pgstore.LogOfflineAccessEntryParams{
+		OfflineEntryID: &entry.ID,
+		InviteID:       &invite.ID,
+		CondominiumID:  invite.CondominiumID,
+		AuthorizedBy:   &req.UserID,
+		ApartmentID:    invite.ApartmentID,
+		EnteredAt:      entry.EnteredAt,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return pgstore.AccessLog{}, err
+		}
+		return pgstore.AccessLog{}, fmt.Errorf("failed to log offline entry: %w", err)
+	}
+
+	return accessLog, nil
+}
@@ -0,0 +1,77 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type UpdateCommonAreaUC interface {
+	Exec(ctx context.Context, req UpdateCommonAreaReq) (CommonAreaDetails, error)
+}
+
+type UpdateCommonAreaReq struct {
+	UserID           uuid.UUID
+	CommonAreaID     uuid.UUID
+	Name             string
+	Capacity         *int32
+	RequiresApproval bool
+}
+
+type UpdateCommonAreaUseCase struct {
+	pool *pgxpool.Pool
+}
+
+func NewUpdateCommonAreaUseCase(pool *pgxpool.Pool) *UpdateCommonAreaUseCase {
+	return &UpdateCommonAreaUseCase{
+		pool: pool,
+	}
+}
+
+// Exec replaces the name, capacity and approval flag of an area. Like the rules, they only apply
+// to new bookings: pending bookings stay pending and a lower capacity keeps existing bookings.
+func (uc *UpdateCommonAreaUseCase) Exec(ctx context.Context, req UpdateCommonAreaReq) (CommonAreaDetails, error) {
+	tx, err := uc.pool.Begin(ctx)
+	if err != nil {
+		return CommonAreaDetails{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pgstore.New(tx)
+
+	area, err := lockManagedArea(ctx, qtx, req.CommonAreaID, req.UserID)
+	if err != nil {
+		return CommonAreaDetails{}, err
+	}
+
+	if area.AllowOverlappingBookings && req.Capacity == nil {
+		return CommonAreaDetails{}, ErrOverlappingNeedsCapacity
+	}
+
+	area, err = qtx.UpdateCommonArea(ctx, pgstore.UpdateCommonAreaParams{
+		ID:               area.ID,
+		Name:             req.Name,
+		Capacity:         req.Capacity,
+		RequiresApproval: req.RequiresApproval,
+	})
+	if err != nil {
+		return CommonAreaDetails{}, fmt.Errorf("failed to update common area: %w", err)
+	}
+
+	openingHours, err := qtx.ListCommonAreaOpeningHours(ctx, area.ID)
+	if err != nil {
+		return CommonAreaDetails{}, fmt.Errorf("failed to list opening hours: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return CommonAreaDetails{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return CommonAreaDetails{
+		CommonArea:   area,
+		OpeningHours: openingHours,
+	}, nil
+}
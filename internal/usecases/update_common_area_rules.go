@@ -0,0 +1,98 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type UpdateCommonAreaRulesUC interface {
+	Exec(ctx context.Context, req UpdateCommonAreaRulesReq) (CommonAreaDetails, error)
+}
+
+type UpdateCommonAreaRulesReq struct {
+	UserID       uuid.UUID
+	CommonAreaID uuid.UUID
+	Rules        BookingRules
+}
+
+type UpdateCommonAreaRulesUseCase struct {
+	pool *pgxpool.Pool
+}
+
+func NewUpdateCommonAreaRulesUseCase(pool *pgxpool.Pool) *UpdateCommonAreaRulesUseCase {
+	return &UpdateCommonAreaRulesUseCase{
+		pool: pool,
+	}
+}
+
+var ErrCommonAreaNotFound = errors.New("common area not found")
+
+// Exec replaces the whole rule set of an area. Existing bookings are kept even if they no longer
+// comply; the rules only apply to new bookings.
+func (uc *UpdateCommonAreaRulesUseCase) Exec(ctx context.Context, req UpdateCommonAreaRulesReq) (CommonAreaDetails, error) {
+	hours, err := parseBookingRules(req.Rules)
+	if err != nil {
+		return CommonAreaDetails{}, err
+	}
+
+	tx, err := uc.pool.Begin(ctx)
+	if err != nil {
+		return CommonAreaDetails{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pgstore.New(tx)
+
+	// Same lock as CreateBookingUseCase, so no booking is checked against half-updated rules.
+	area, err := lockManagedArea(ctx, qtx, req.CommonAreaID, req.UserID)
+	if err != nil {
+		return CommonAreaDetails{}, err
+	}
+
+	if req.Rules.AllowOverlappingBookings && area.Capacity == nil {
+		return CommonAreaDetails{}, ErrOverlappingNeedsCapacity
+	}
+
+	area, err = qtx.UpdateCommonAreaRules(ctx, pgstore.UpdateCommonAreaRulesParams{
+		ID:                        area.ID,
+		MinDurationMinutes:        req.Rules.MinDurationMinutes,
+		MaxDurationMinutes:        req.Rules.MaxDurationMinutes,
+		MaxAdvanceDays:            req.Rules.MaxAdvanceDays,
+		CooldownMinutes:           req.Rules.CooldownMinutes,
+		MaxBookingsPerMonth:       req.Rules.MaxBookingsPerMonth,
+		CancellationDeadlineHours: req.Rules.CancellationDeadlineHours,
+		LateCancellationFeeCents:  req.Rules.LateCancellationFeeCents,
+		PriceCents:                req.Rules.PriceCents,
+		PricingUnit:               req.Rules.pricingUnit(),
+		DepositCents:              req.Rules.DepositCents,
+		AllowOverlappingBookings:  req.Rules.AllowOverlappingBookings,
+		PendingExpiryHours:        req.Rules.PendingExpiryHours,
+		PendingExpiryAction:       req.Rules.pendingExpiryAction(),
+	})
+	if err != nil {
+		return CommonAreaDetails{}, fmt.Errorf("failed to update rules: %w", err)
+	}
+
+	if err := replaceOpeningHours(ctx, qtx, area.ID, hours); err != nil {
+		return CommonAreaDetails{}, err
+	}
+
+	openingHours, err := qtx.ListCommonAreaOpeningHours(ctx, area.ID)
+	if err != nil {
+		return CommonAreaDetails{}, fmt.Errorf("failed to list opening hours: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return CommonAreaDetails{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return CommonAreaDetails{
+		CommonArea:   area,
+		OpeningHours: openingHours,
+	}, nil
+}
@@ -0,0 +1,148 @@
+package usecases
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/services"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	PackagePhotoURLTTL     = 15 * time.Minute
+	packageThumbnailMaxPix = 320
+)
+
+type UploadPackagePhotoUC interface {
+	Exec(ctx context.Context, req UploadPackagePhotoReq) (PackagePhoto, error)
+}
+
+type UploadPackagePhotoReq struct {
+	PackageID uuid.UUID
+	UserID    uuid.UUID
+	Data      []byte
+}
+
+type PackagePhoto struct {
+	PhotoURL     string    `json:"photoUrl"`
+	ThumbnailURL string    `json:"thumbnailUrl"`
+	ExpiresAt    time.Time `json:"expiresAt"`
+}
+
+type UploadPackagePhotoUseCase struct {
+	querier pgstore.Querier
+	storage services.StorageService
+}
+
+func NewUploadPackagePhotoUseCase(q pgstore.Querier, s services.StorageService) *UploadPackagePhotoUseCase {
+	return &UploadPackagePhotoUseCase{
+		querier: q,
+		storage: s,
+	}
+}
+
+var (
+	ErrUnsupportedFileType = errors.New("file type not supported, send a JPEG or PNG image")
+	ErrInvalidImage        = errors.New("file is not a valid image")
+)
+
+var packagePhotoExtensions = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+}
+
+func (uc *UploadPackagePhotoUseCase) Exec(ctx context.Context, req UploadPackagePhotoReq) (PackagePhoto, error) {
+	pkg, err := uc.querier.GetPackageById(ctx, req.PackageID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return PackagePhoto{}, ErrPackageNotFound
+		}
+		return PackagePhoto{}, fmt.Errorf("failed to get package: %w", err)
+	}
+
+	_, err = uc.querier.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
+		CondominiumID: pkg.CondominiumID,
+		UserID:        req.UserID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return PackagePhoto{}, ErrNoPermission
+		}
+		return PackagePhoto{}, err
+	}
+
+	contentType := http.DetectContentType(req.Data)
+	ext, ok := packagePhotoExtensions[contentType]
+	if !ok {
+		return PackagePhoto{}, ErrUnsupportedFileType
+	}
+
+	thumbnail, err := utils.GenerateThumbnail(req.Data, packageThumbnailMaxPix)
+	if err != nil {
+		return PackagePhoto{}, ErrInvalidImage
+	}
+
+	objectID := uuid.New()
+	prefix := fmt.Sprintf("packages/%s/%s/%s", pkg.CondominiumID, pkg.ID, objectID)
+	photoKey := fmt.Sprintf("%s.%s", prefix, ext)
+	thumbnailKey := prefix + "_thumb.jpg"
+
+	if err := uc.storage.Put(ctx, photoKey, bytes.NewReader(req.Data), int64(len(req.Data)), contentType); err != nil {
+		return PackagePhoto{}, fmt.Errorf("failed to store photo: %w", err)
+	}
+
+	if err := uc.storage.Put(ctx, thumbnailKey, bytes.NewReader(thumbnail), int64(len(thumbnail)), "image/jpeg"); err != nil {
+		uc.storage.Delete(ctx, photoKey)
+		return PackagePhoto{}, fmt.Errorf("failed to store thumbnail: %w", err)
+	}
+
+	err = uc.querier.UpdatePackagePhoto(ctx, pgstore.UpdatePackagePhotoParams{
+		ID:           pkg.ID,
+		PhotoKey:     &photoKey,
+		ThumbnailKey: &thumbnailKey,
+	})
+	if err != nil {
+		uc.storage.Delete(ctx, photoKey)
+		uc.storage.Delete(ctx, thumbnailKey)
+		return PackagePhoto{}, fmt.Errorf("failed to update package photo: %w", err)
+	}
+
+	for _, old := range []*string{pkg.PhotoKey, pkg.ThumbnailKey} {
+		if old == nil {
+			continue
+		}
+		if err := uc.storage.Delete(ctx, *old); err != nil {
+			slog.Warn("Failed to delete previous package photo", "key", *old, "error", err)
+		}
+	}
+
+	return signPackagePhoto(ctx, uc.storage, photoKey, thumbnailKey)
+}
+
+func signPackagePhoto(ctx context.Context, storage services.StorageService, photoKey, thumbnailKey string) (PackagePhoto, error) {
+	expiresAt := time.Now().Add(PackagePhotoURLTTL)
+
+	photoURL, err := storage.SignedURL(ctx, photoKey, PackagePhotoURLTTL)
+	if err != nil {
+		return PackagePhoto{}, fmt.Errorf("failed to sign photo url: %w", err)
+	}
+
+	thumbnailURL, err := storage.SignedURL(ctx, thumbnailKey, PackagePhotoURLTTL)
+	if err != nil {
+		return PackagePhoto{}, fmt.Errorf("failed to sign thumbnail url: %w", err)
+	}
+
+	return PackagePhoto{
+		PhotoURL:     photoURL,
+		ThumbnailURL: thumbnailURL,
+		ExpiresAt:    expiresAt,
+	}, nil
+}
@@ -15,7 +15,7 @@ import (
 )
 
 type ValidateInviteUC interface {
-	Exec(ctx context.Context, req ValidateInviteReq) (pgstore.GetInviteByTokenRow, error)
+	Exec(ctx context.Context, req ValidateInviteReq) (ValidateInviteResult, error)
 }
 
 type ValidateInviteReq struct {
@@ -24,6 +24,12 @@ type ValidateInviteReq struct {
 	Token         uuid.UUID
 }
 
+type ValidateInviteResult struct {
+	Invite pgstore.GetInviteByTokenRow
+	// RemainingEntries is nil for invites without an entry limit.
+	RemainingEntries *int32
+}
+
 type ValidateInviteUseCase struct {
 	pool     *pgxpool.Pool
 	notifier services.NotificationService
@@ -41,12 +47,13 @@ var (
 	ErrInviteAlreadyRevoked = errors.New("Invite already revoked")
 	ErrInviteNotStarted     = errors.New("Invite start time has not been reached yet")
 	ErrInviteExpired        = errors.New("Invite has expired")
+	ErrInviteExhausted      = errors.New("Invite has no entries left")
 )
 
-func (uc *ValidateInviteUseCase) Exec(ctx context.Context, req ValidateInviteReq) (pgstore.GetInviteByTokenRow, error) {
+func (uc *ValidateInviteUseCase) Exec(ctx context.Context, req ValidateInviteReq) (ValidateInviteResult, error) {
 	tx, err := uc.pool.Begin(ctx)
 	if err != nil {
-		return pgstore.GetInviteByTokenRow{}, fmt.Errorf("failed to begin transaction: %w", err)
+		return ValidateInviteResult{}, fmt.Errorf("failed to begin transaction: %w", err)
 	}
 	defer tx.Rollback(ctx)
 
@@ -58,59 +65,135 @@ func (uc *ValidateInviteUseCase) Exec(ctx context.Context, req ValidateInviteReq
 	})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return pgstore.GetInviteByTokenRow{}, ErrNoPermission
+			return ValidateInviteResult{}, ErrNoPermission
 		}
-		return pgstore.GetInviteByTokenRow{}, err
+		return ValidateInviteResult{}, err
 	}
 
 	invite, err := qtx.GetInviteByToken(ctx, req.Token)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return pgstore.GetInviteByTokenRow{}, ErrInviteNotFound
+			return ValidateInviteResult{}, ErrInviteNotFound
 		}
-		return pgstore.GetInviteByTokenRow{}, fmt.Errorf("Error while fetching invite: %w", err)
+		return ValidateInviteResult{}, fmt.Errorf("Error while fetching invite: %w", err)
 	}
 
 	if req.CondominiumID != invite.CondominiumID {
-		return pgstore.GetInviteByTokenRow{}, ErrNoPermission
+		return ValidateInviteResult{}, ErrNoPermission
+	}
+
+	if err := checkInviteWindow(invite, time.Now()); err != nil {
+		return ValidateInviteResult{}, err
+	}
+
+	if err := checkInviteBlocklist(ctx, qtx, uc.notifier, invite); err != nil {
+		return ValidateInviteResult{}, err
 	}
 
+	remaining, err := logInviteEntry(ctx, qtx, invite, req.UserID, nil)
+	if err != nil {
+		return ValidateInviteResult{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return ValidateInviteResult{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	go notifyGuestArrival(uc.notifier, invite)
+
+	return ValidateInviteResult{
+		Invite:           invite,
+		RemainingEntries: remaining,
+	}, nil
+}
+
+// checkInviteWindow applies the validity rules shared by token and plate entries.
+func checkInviteWindow(invite pgstore.GetInviteByTokenRow, now time.Time) error {
 	if invite.RevokedAt != nil {
-		return pgstore.GetInviteByTokenRow{}, ErrInviteAlreadyRevoked
+		return ErrInviteAlreadyRevoked
 	}
 
-	now := time.Now()
 	if now.Before(invite.StartsAt) {
-		return pgstore.GetInviteByTokenRow{}, ErrInviteNotStarted
+		return ErrInviteNotStarted
 	}
 
 	if now.After(invite.EndsAt) {
-		return pgstore.GetInviteByTokenRow{}, ErrInviteExpired
+		return ErrInviteExpired
 	}
 
-	_, err = qtx.LogAccessEntry(ctx, pgstore.LogAccessEntryParams{
-		InviteID:      invite.ID,
-		CondominiumID: invite.CondominiumID,
-		AuthorizedBy:  utils.ToPtr(req.UserID),
+	if len(invite.RecurrenceWeekdays) > 0 {
+		loc, err := time.LoadLocation(invite.CondominiumTimezone)
+		if err != nil {
+			return fmt.Errorf("invalid condominium timezone %q: %w", invite.CondominiumTimezone, err)
+		}
+
+		if !inviteScheduleAllows(invite.RecurrenceWeekdays, invite.DailyStartMinute, invite.DailyEndMinute, now.In(loc)) {
+			return ErrInviteOutsideSchedule
+		}
+	}
+
+	return nil
+}
+
+// checkInviteBlocklist refuses guests on the condominium blocklist and alerts the admins.
+func checkInviteBlocklist(ctx context.Context, q pgstore.Querier, notifier services.NotificationService, invite pgstore.GetInviteByTokenRow) error {
+	blocked, err := findBlockedVisitors(ctx, q, invite.CondominiumID, visitorIdentity{
+		Name:  invite.GuestName,
+		Plate: invite.GuestPlate,
 	})
 	if err != nil {
-		return pgstore.GetInviteByTokenRow{}, fmt.Errorf("failed to create access entry: %w", err)
+		return err
 	}
 
-	if err := tx.Commit(ctx); err != nil {
-		return pgstore.GetInviteByTokenRow{}, fmt.Errorf("failed to commit transaction: %w", err)
+	if len(blocked) > 0 {
+		go alertBlockedVisitor(notifier, blocked[0], invite.GuestName)
+		return ErrVisitorBlocked
+	}
+
+	return nil
+}
+
+// logInviteEntry enforces the entry limit and writes the access log. It must run inside a
+// transaction: the invite row lock serializes concurrent entries with the same invite. Entries
+// are counted after the lock is granted, so the count sees the entry of the gate that held it.
+func logInviteEntry(ctx context.Context, qtx *pgstore.Queries, invite pgstore.GetInviteByTokenRow, authorizedBy uuid.UUID, plate *string) (*int32, error) {
+	if _, err := qtx.LockInviteForEntry(ctx, invite.ID); err != nil {
+		return nil, fmt.Errorf("failed to lock invite: %w", err)
+	}
+
+	entries, err := qtx.CountInviteEntries(ctx, &invite.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count invite entries: %w", err)
 	}
 
-	go func() {
-		bgCtx := context.Background()
+	var remaining *int32
+	if invite.MaxEntries != nil {
+		if entries >= *invite.MaxEntries {
+			return nil, ErrInviteExhausted
+		}
+		remaining = utils.ToPtr(*invite.MaxEntries - entries - 1)
+	}
+
+	_, err = qtx.LogAccessEntry(ctx, pgstore.LogAccessEntryParams{
+		InviteID:      utils.ToPtr(invite.ID),
+		CondominiumID: invite.CondominiumID,
+		AuthorizedBy:  utils.ToPtr(authorizedBy),
+		ApartmentID:   invite.ApartmentID,
+		Plate:         plate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create access entry: %w", err)
+	}
 
-		var title, body string
-		title = "Visitante chegou!"
-		body = fmt.Sprintf("%s acabou de passar pela portaria.", invite.GuestName)
+	return remaining, nil
+}
 
-		_ = uc.notifier.SendToUser(bgCtx, invite.IssuedBy, title, body)
+func notifyGuestArrival(notifier services.NotificationService, invite pgstore.GetInviteByTokenRow) {
+	bgCtx := context.Background()
 
-	}()
+	var title, body string
+	title = "Visitante chegou!"
+	body = fmt.Sprintf("%s acabou de passar pela portaria.", invite.GuestName)
 
-	return invite, nil
+	_ = notifier.SendToUser(bgCtx, invite.IssuedBy, title, body)
 }
@@ -0,0 +1,154 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/Bellorico323/vizen/internal/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type VotePollUC interface {
+	Exec(ctx context.Context, req VotePollReq) error
+}
+
+type VotePollReq struct {
+	PollID      uuid.UUID
+	UserID      uuid.UUID
+	ApartmentID uuid.UUID
+	OptionIDs   []uuid.UUID
+}
+
+type VotePollUseCase struct {
+	pool *pgxpool.Pool
+}
+
+func NewVotePollUseCase(pool *pgxpool.Pool) *VotePollUseCase {
+	return &VotePollUseCase{
+		pool: pool,
+	}
+}
+
+var (
+	ErrPollNotOpen       = errors.New("poll is not open for voting")
+	ErrPollAlreadyVoted  = errors.New("this apartment has already voted in this poll")
+	ErrInvalidPollChoice = errors.New("invalid poll options selected")
+	ErrPollSingleChoice  = errors.New("this poll accepts a single option")
+)
+
+func (uc *VotePollUseCase) Exec(ctx context.Context, req VotePollReq) error {
+	if len(req.OptionIDs) == 0 {
+		return ErrInvalidPollChoice
+	}
+
+	tx, err := uc.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := pgstore.New(tx)
+
+	poll, err := qtx.GetPollById(ctx, req.PollID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrPollNotFound
+		}
+		return fmt.Errorf("failed to fetch poll: %w", err)
+	}
+
+	if pollStatus(poll, time.Now()) != "open" {
+		return ErrPollNotOpen
+	}
+
+	apartment, err := qtx.GetApartmentById(ctx, req.ApartmentID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrApartmentNotFound
+		}
+		return fmt.Errorf("failed to fetch apartment: %w", err)
+	}
+
+	if apartment.CondominiumID != poll.CondominiumID {
+		return ErrApartmentIsNotFromCondominium
+	}
+
+	isResident, err := qtx.CheckIsResident(ctx, pgstore.CheckIsResidentParams{
+		UserID:      req.UserID,
+		ApartmentID: req.ApartmentID,
+	})
+	if err != nil {
+		return fmt.Errorf("error checking residency: %w", err)
+	}
+	if !isResident {
+		return ErrNoPermission
+	}
+
+	if poll.ChoiceType == "single" && len(req.OptionIDs) > 1 {
+		return ErrPollSingleChoice
+	}
+
+	options, err := qtx.ListPollOptions(ctx, poll.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list poll options: %w", err)
+	}
+
+	validOptions := make(map[uuid.UUID]bool, len(options))
+	for _, opt := range options {
+		validOptions[opt.ID] = true
+	}
+
+	chosen := make(map[uuid.UUID]bool, len(req.OptionIDs))
+	for _, id := range req.OptionIDs {
+		if !validOptions[id] || chosen[id] {
+			return ErrInvalidPollChoice
+		}
+		chosen[id] = true
+	}
+
+	weight := 1.0
+	if poll.IsWeighted {
+		weight = apartment.VotingWeight
+	}
+
+	var voterID *uuid.UUID
+	if !poll.IsAnonymous {
+		voterID = utils.ToPtr(req.UserID)
+	}
+
+	ballot, err := qtx.CreatePollBallot(ctx, pgstore.CreatePollBallotParams{
+		PollID:      poll.ID,
+		ApartmentID: apartment.ID,
+		UserID:      voterID,
+		Weight:      weight,
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return ErrPollAlreadyVoted
+		}
+		return fmt.Errorf("failed to create ballot: %w", err)
+	}
+
+	for _, optionID := range req.OptionIDs {
+		err = qtx.CreatePollBallotChoice(ctx, pgstore.CreatePollBallotChoiceParams{
+			BallotID: ballot.ID,
+			OptionID: optionID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to register ballot choice: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Bellorico323/vizen/internal/services"
+	"github.com/Bellorico323/vizen/internal/store/pgstore"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type WatchVisitorRequestsUC interface {
+	Exec(ctx context.Context, req WatchVisitorRequestsReq) (<-chan services.VisitorEvent, func(), error)
+}
+
+type WatchVisitorRequestsReq struct {
+	CondominiumID uuid.UUID
+	UserID        uuid.UUID
+}
+
+type WatchVisitorRequestsUseCase struct {
+	querier pgstore.Querier
+	events  services.VisitorEventBroker
+}
+
+func NewWatchVisitorRequestsUseCase(q pgstore.Querier, e services.VisitorEventBroker) *WatchVisitorRequestsUseCase {
+	return &WatchVisitorRequestsUseCase{
+		querier: q,
+		events:  e,
+	}
+}
+
+// Exec subscribes staff to the walk-in changes of a condominium. The caller must call the returned
+// function once it stops reading.
+func (uc *WatchVisitorRequestsUseCase) Exec(ctx context.Context, req WatchVisitorRequestsReq) (<-chan services.VisitorEvent, func(), error) {
+	_, err := uc.querier.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
+		CondominiumID: req.CondominiumID,
+		UserID:        req.UserID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil, ErrNoPermission
+		}
+		return nil, nil, err
+	}
+
+	events, unsubscribe := uc.events.Subscribe(req.CondominiumID)
+	return events, unsubscribe, nil
+}
@@ -2,8 +2,10 @@ package usecases
 
 import (
 	"context"
+	"crypto/subtle"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Bellorico323/vizen/internal/store/pgstore"
 	"github.com/Bellorico323/vizen/internal/utils"
@@ -19,6 +21,12 @@ type WithdrawPackageReq struct {
 	PackageID     uuid.UUID
 	UserID        uuid.UUID
 	CondominiumID uuid.UUID
+	PickupCode    *string
+
+	// Collector identifies who physically took the package when it is not the resident themselves.
+	// A collector document without a pickup code must match an active pickup authorization.
+	CollectorName     *string
+	CollectorDocument *string
 }
 
 type WithdrawPackageUseCase struct {
@@ -31,7 +39,18 @@ func NewWithdrawPackageUseCase(q pgstore.Querier) *WithdrawPackageUseCase {
 	}
 }
 
-var ErrPackageAlreadyWithdrawn = errors.New("package is already withdrawn")
+var (
+	ErrPackageAlreadyWithdrawn = errors.New("package is already withdrawn")
+	ErrPackageReturned         = errors.New("package was returned to sender")
+	ErrPackageNotPending       = errors.New("package is no longer pending")
+	ErrPickupCodeRequired      = errors.New("pickup code is required")
+	ErrInvalidPickupCode       = errors.New("invalid pickup code")
+	ErrPickupCodeLocked        = errors.New("too many invalid pickup code attempts, ask an admin to reset the code")
+	ErrPackageNotReleased      = errors.New("package must be released at the desk before the resident confirms the pickup")
+	ErrNoPickupAuthorization   = errors.New("no active pickup authorization for this document")
+)
+
+const maxPickupAttempts = 5
 
 func (uc *WithdrawPackageUseCase) Exec(ctx context.Context, req WithdrawPackageReq) error {
 	packg, err := uc.querier.GetPackageById(ctx, req.PackageID)
@@ -51,6 +70,10 @@ func (uc *WithdrawPackageUseCase) Exec(ctx context.Context, req WithdrawPackageR
 		return ErrPackageAlreadyWithdrawn
 	}
 
+	if packg.Status == "returned_to_sender" {
+		return ErrPackageReturned
+	}
+
 	_, err = uc.querier.GetCondominiumMemberRole(ctx, pgstore.GetCondominiumMemberRoleParams{
 		CondominiumID: req.CondominiumID,
 		UserID:        req.UserID,
@@ -84,15 +107,94 @@ func (uc *WithdrawPackageUseCase) Exec(ctx context.Context, req WithdrawPackageR
 			return ErrNoPermission
 		}
 
+		if packg.DeskReleaseUntil == nil || time.Now().After(*packg.DeskReleaseUntil) {
+			return ErrPackageNotReleased
+		}
 	}
 
-	err = uc.querier.UpdatePackageToWithdrawn(ctx, pgstore.UpdatePackageToWithdrawnParams{
+	params := pgstore.UpdatePackageToWithdrawnParams{
 		ID:          packg.ID,
 		WithdrawnBy: utils.ToPtr(req.UserID),
-	})
+	}
+
+	if isStaff {
+		if req.CollectorName != nil {
+			params.CollectedByName = utils.ToNullString(*req.CollectorName)
+		}
+		if req.CollectorDocument != nil {
+			params.CollectedByDocument = utils.ToNullString(utils.NormalizeDocument(*req.CollectorDocument))
+		}
+
+		released := packg.DeskReleaseUntil != nil && time.Now().Before(*packg.DeskReleaseUntil)
+
+		switch {
+		case released:
+		case req.PickupCode == nil && params.CollectedByDocument != nil:
+			authorization, err := uc.querier.FindActivePickupAuthorization(ctx, pgstore.FindActivePickupAuthorizationParams{
+				ApartmentID:        packg.ApartmentID,
+				AuthorizedDocument: *params.CollectedByDocument,
+				PackageID:          utils.ToPtr(packg.ID),
+			})
+			if err != nil {
+				if errors.Is(err, pgx.ErrNoRows) {
+					return ErrNoPickupAuthorization
+				}
+				return fmt.Errorf("failed to check pickup authorization: %w", err)
+			}
+
+			params.PickupAuthorizationID = utils.ToPtr(authorization.ID)
+			if params.CollectedByName == nil {
+				params.CollectedByName = utils.ToPtr(authorization.AuthorizedName)
+			}
+		default:
+			if err := verifyPickupCode(ctx, uc.querier, packg, req.PickupCode); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Only pending packages are updated: a concurrent withdrawal or return may have won the race.
+	_, err = uc.querier.UpdatePackageToWithdrawn(ctx, params)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrPackageNotPending
+		}
 		return fmt.Errorf("Error while updating package: %w", err)
 	}
 
 	return nil
 }
+
+// verifyPickupCode checks the code informed at the desk, counting failures and locking the package after maxPickupAttempts.
+// Packages registered before pickup codes existed have no code and are not checked.
+func verifyPickupCode(ctx context.Context, q pgstore.Querier, packg pgstore.GetPackageByIdRow, code *string) error {
+	if packg.PickupCode == nil {
+		return nil
+	}
+
+	if packg.PickupLockedAt != nil {
+		return ErrPickupCodeLocked
+	}
+
+	if code == nil || *code == "" {
+		return ErrPickupCodeRequired
+	}
+
+	if subtle.ConstantTimeCompare([]byte(*code), []byte(*packg.PickupCode)) == 1 {
+		return nil
+	}
+
+	attempts, err := q.RegisterPackagePickupAttempt(ctx, pgstore.RegisterPackagePickupAttemptParams{
+		ID:          packg.ID,
+		MaxAttempts: maxPickupAttempts,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register pickup attempt: %w", err)
+	}
+
+	if attempts >= maxPickupAttempts {
+		return ErrPickupCodeLocked
+	}
+
+	return ErrInvalidPickupCode
+}
@@ -0,0 +1,28 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidClock = errors.New("time of day must be in HH:MM format")
+
+// ParseClock converts "HH:MM" into minutes since midnight. "24:00" is accepted as the end of the day.
+func ParseClock(s string) (int16, error) {
+	var h, m int
+	if len(s) != 5 || s[2] != ':' {
+		return 0, ErrInvalidClock
+	}
+	if _, err := fmt.Sscanf(s, "%02d:%02d", &h, &m); err != nil {
+		return 0, ErrInvalidClock
+	}
+	if m < 0 || m > 59 || h < 0 || h > 24 || (h == 24 && m != 0) {
+		return 0, ErrInvalidClock
+	}
+	return int16(h*60 + m), nil
+}
+
+// FormatClock is the inverse of ParseClock.
+func FormatClock(minutes int16) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
@@ -0,0 +1,105 @@
+package utils
+
+import (
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// icalTimeLayout is the UTC DATE-TIME form of RFC 5545.
+const icalTimeLayout = "20060102T150405Z"
+
+// icalLineOctets is the longest content line RFC 5545 allows before folding.
+const icalLineOctets = 75
+
+// ICalEvent is a single VEVENT of an iCalendar feed.
+type ICalEvent struct {
+	UID         string
+	Summary     string
+	Description string
+	Location    string
+	// Status is TENTATIVE, CONFIRMED or CANCELLED.
+	Status    string
+	StartsAt  time.Time
+	EndsAt    time.Time
+	UpdatedAt time.Time
+}
+
+// ICalendar renders an RFC 5545 calendar. Event times are written in UTC, which every client
+// converts to the viewer's zone; timezone (an IANA name) is advertised through X-WR-TIMEZONE so
+// apps that honor it show the feed in the condominium's local time.
+func ICalendar(name, timezone string, refresh time.Duration, events []ICalEvent) []byte {
+	var b strings.Builder
+
+	line := func(content string) {
+		writeFoldedLine(&b, content)
+	}
+
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//Vizen//Reservas//PT-BR")
+	line("CALSCALE:GREGORIAN")
+	line("METHOD:PUBLISH")
+	line("X-WR-CALNAME:" + icalText(name))
+	if timezone != "" {
+		line("X-WR-TIMEZONE:" + timezone)
+	}
+	if refresh > 0 {
+		minutes := int(refresh / time.Minute)
+		line("REFRESH-INTERVAL;VALUE=DURATION:PT" + strconv.Itoa(minutes) + "M")
+		line("X-PUBLISHED-TTL:PT" + strconv.Itoa(minutes) + "M")
+	}
+
+	for _, e := range events {
+		line("BEGIN:VEVENT")
+		line("UID:" + e.UID)
+		line("DTSTAMP:" + e.UpdatedAt.UTC().Format(icalTimeLayout))
+		line("DTSTART:" + e.StartsAt.UTC().Format(icalTimeLayout))
+		line("DTEND:" + e.EndsAt.UTC().Format(icalTimeLayout))
+		line("SUMMARY:" + icalText(e.Summary))
+		if e.Description != "" {
+			line("DESCRIPTION:" + icalText(e.Description))
+		}
+		if e.Location != "" {
+			line("LOCATION:" + icalText(e.Location))
+		}
+		if e.Status != "" {
+			line("STATUS:" + e.Status)
+		}
+		line("END:VEVENT")
+	}
+
+	line("END:VCALENDAR")
+
+	return []byte(b.String())
+}
+
+// icalText escapes a TEXT value.
+func icalText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(s)
+}
+
+// writeFoldedLine writes a CRLF-terminated content line, folding it every 75 octets without
+// splitting a UTF-8 sequence. Continuation lines start with a space, which counts as an octet.
+func writeFoldedLine(b *strings.Builder, content string) {
+	limit := icalLineOctets
+	for len(content) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(content[cut]) {
+			cut--
+		}
+		b.WriteString(content[:cut])
+		b.WriteString("\r\n ")
+		content = content[cut:]
+		limit = icalLineOctets - 1
+	}
+	b.WriteString(content)
+	b.WriteString("\r\n")
+}
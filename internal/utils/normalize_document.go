@@ -0,0 +1,17 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+)
+
+// NormalizeDocument keeps only letters and digits, upper-cased, so "123.456.789-09" and "12345678909" match.
+func NormalizeDocument(doc string) string {
+	var b strings.Builder
+	for _, r := range doc {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(unicode.ToUpper(r))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+var ErrInvalidPlate = errors.New("plate must be in the legacy (ABC1234) or Mercosul (ABC1D23) format")
+
+var (
+	legacyPlateRe   = regexp.MustCompile(`^[A-Z]{3}[0-9]{4}$`)
+	mercosulPlateRe = regexp.MustCompile(`^[A-Z]{3}[0-9][A-Z][0-9]{2}$`)
+)
+
+// NormalizePlate upper-cases a Brazilian plate and drops separators, so "abc-1234" becomes "ABC1234".
+func NormalizePlate(plate string) (string, error) {
+	var b strings.Builder
+	for _, r := range strings.ToUpper(plate) {
+		if r == '-' || r == ' ' || r == '.' {
+			continue
+		}
+		b.WriteRune(r)
+	}
+
+	normalized := b.String()
+	if !legacyPlateRe.MatchString(normalized) && !mercosulPlateRe.MatchString(normalized) {
+		return "", ErrInvalidPlate
+	}
+	return normalized, nil
+}
+
+// PlateVariants returns a normalized plate and its counterpart in the other format. Cars moved to
+// the Mercosul standard keep their plate with the fifth digit swapped for a letter (0 → A, ..., 9 → J),
+// so a vehicle registered as ABC1234 may show up at the gate as ABC1C34.
+func PlateVariants(plate string) []string {
+	switch {
+	case legacyPlateRe.MatchString(plate):
+		return []string{plate, plate[:4] + string(rune('A'+plate[4]-'0')) + plate[5:]}
+	case mercosulPlateRe.MatchString(plate) && plate[4] <= 'J':
+		return []string{plate, plate[:4] + string(rune('0'+plate[4]-'A')) + plate[5:]}
+	default:
+		return []string{plate}
+	}
+}
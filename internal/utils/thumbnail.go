@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	_ "image/png"
+)
+
+// maxSourcePixels bounds the size of a decoded image. A small compressed file can declare huge
+// dimensions, and decoding allocates memory for every pixel.
+const maxSourcePixels = 40_000_000
+
+// GenerateThumbnail decodes a JPEG or PNG image and re-encodes it as a JPEG whose longest side is at most maxSide.
+// Images above maxSourcePixels are refused before being decoded.
+func GenerateThumbnail(data []byte, maxSide int) ([]byte, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image header: %w", err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width > maxSourcePixels/cfg.Height {
+		return nil, fmt.Errorf("image dimensions %dx%d are not supported", cfg.Width, cfg.Height)
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width == 0 || height == 0 {
+		return nil, fmt.Errorf("image has no pixels")
+	}
+
+	dstW, dstH := width, height
+	if width > maxSide || height > maxSide {
+		if width >= height {
+			dstW = maxSide
+			dstH = max(1, height*maxSide/width)
+		} else {
+			dstH = maxSide
+			dstW = max(1, width*maxSide/height)
+		}
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstW, dstH))
+	for y := range dstH {
+		for x := range dstW {
+			dst.Set(x, y, averageArea(src,
+				bounds.Min.X+x*width/dstW,
+				bounds.Min.Y+y*height/dstH,
+				bounds.Min.X+max((x+1)*width/dstW, x*width/dstW+1),
+				bounds.Min.Y+max((y+1)*height/dstH, y*height/dstH+1),
+			))
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: 80}); err != nil {
+		return nil, fmt.Errorf("failed to encode thumbnail: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// averageArea box-filters the source pixels that map onto a single thumbnail pixel.
+func averageArea(src image.Image, x0, y0, x1, y1 int) color.Color {
+	var r, g, b, a, n uint64
+	for y := y0; y < y1; y++ {
+		for x := x0; x < x1; x++ {
+			cr, cg, cb, ca := src.At(x, y).RGBA()
+			r += uint64(cr)
+			g += uint64(cg)
+			b += uint64(cb)
+			a += uint64(ca)
+			n++
+		}
+	}
+
+	return color.RGBA64{
+		R: uint16(r / n),
+		G: uint16(g / n),
+		B: uint16(b / n),
+		A: uint16(a / n),
+	}
+}